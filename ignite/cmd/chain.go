@@ -89,6 +89,20 @@ to send token from any other account that exists on chain.
 
 The "simulate" command helps you start a simulation testing process for your
 chain.
+
+The "check" command checks the state machine code of the modules for code that
+can break the consensus of the chain, like non-deterministic code.
+
+The "bench" command starts the chain in a temporary directory and measures its
+transaction throughput under load.
+
+The "state" command lets you save the state of your chain using a name and
+restore it later to go back to a known state.
+
+The "config" command validates the config file and exports its JSON Schema.
+
+The "logs" command displays the logs of the nodes started by "serve". Nodes that
+crash are restarted, and "serve" waits for a fix when a node keeps crashing.
 `,
 		Aliases:           []string{"c"},
 		Args:              cobra.ExactArgs(1),
@@ -107,6 +121,12 @@ chain.
 		NewChainSimulate(),
 		NewChainDebug(),
 		NewChainLint(),
+		NewChainCheck(),
+		NewChainState(),
+		NewChainConfig(),
+		NewChainLogs(),
+		NewChainUpgradeTest(),
+		NewChainBench(),
 	)
 
 	return c
@@ -0,0 +1,153 @@
+package ignitecmd
+
+import (
+	"encoding/json"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/ignite/cli/v29/ignite/pkg/chainbench"
+	"github.com/ignite/cli/v29/ignite/pkg/chaincmd"
+	"github.com/ignite/cli/v29/ignite/pkg/cliui"
+	"github.com/ignite/cli/v29/ignite/services/chain"
+)
+
+const (
+	flagBenchDuration = "duration"
+	flagBenchAccounts = "accounts"
+	flagBenchPipeline = "pipeline"
+	flagBenchOutput   = "output"
+)
+
+// NewChainBench creates a new command to benchmark the chain.
+func NewChainBench() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "bench",
+		Short: "Measure the transaction throughput of the chain",
+		Long: `The bench command measures the performance of the chain under load.
+
+The chain is built and initialized like when it's served in a temporary
+directory, with additional accounts funded in genesis. Once the validator nodes
+are started, the accounts broadcast transactions concurrently, each one waiting
+for the inclusion of its previous transaction before sending a new one:
+
+	ignite chain bench --accounts 50 --duration 1m
+
+Waiting for the inclusion limits the load to one transaction per account and
+block. Use the "--pipeline" flag to allow each account to have more than one
+transaction waiting for its inclusion, which are signed using the account
+sequence tracked by the benchmark:
+
+	ignite chain bench --accounts 50 --pipeline 10
+
+By default the accounts send tokens to each other using bank sends. The number
+of accounts, their coins and the messages to broadcast instead of bank sends can
+be defined in the "bench" section of the config file:
+
+	bench:
+	  accounts: 50
+	  coins: ["1000000000stake"]
+	  messages:
+	    - "@type": /mars.blog.MsgCreatePost
+	      creator: "{{ .Sender }}"
+	      title: "Post {{ .Seq }}"
+
+The benchmark reports the transactions per second, the block times, the gas
+used per block, the mempool size and the latency of the inclusion of the
+transactions. The results can be written as JSON to compare them across
+commits:
+
+	ignite chain bench --output bench.json
+`,
+		Args: cobra.NoArgs,
+		RunE: chainBenchHandler,
+	}
+
+	flagSetPath(c)
+	flagSetClearCache(c)
+	c.Flags().AddFlagSet(flagSetProfile())
+	c.Flags().Duration(flagBenchDuration, chainbench.DefaultDuration, "time during which transactions are broadcasted")
+	c.Flags().Int(flagBenchAccounts, 0, "number of accounts that broadcast transactions concurrently (default: config value or 10)")
+	c.Flags().Int(flagBenchPipeline, chainbench.DefaultPipeline, "number of transactions of each account waiting for their inclusion at the same time")
+	c.Flags().StringP(flagBenchOutput, "o", "", "file to write the results to as JSON")
+	c.Flags().StringSlice(flagBuildTags, []string{}, "parameters to build the chain binary")
+
+	return c
+}
+
+func chainBenchHandler(cmd *cobra.Command, _ []string) error {
+	var (
+		duration, _  = cmd.Flags().GetDuration(flagBenchDuration)
+		accounts, _  = cmd.Flags().GetInt(flagBenchAccounts)
+		pipeline, _  = cmd.Flags().GetInt(flagBenchPipeline)
+		output, _    = cmd.Flags().GetString(flagBenchOutput)
+		buildTags, _ = cmd.Flags().GetStringSlice(flagBuildTags)
+		session      = cliui.New(cliui.WithVerbosity(getVerbosity(cmd)), cliui.StartSpinner())
+		benchOptions = []chain.BenchOption{
+			chain.BenchDuration(duration),
+			chain.BenchAccounts(accounts),
+			chain.BenchPipeline(pipeline),
+			chain.BenchBuildTags(buildTags...),
+		}
+	)
+	defer session.End()
+
+	chainOption := []chain.Option{
+		chain.KeyringBackend(chaincmd.KeyringBackendTest),
+		chain.WithOutputer(session),
+		chain.CollectEvents(session.EventBus()),
+	}
+
+	if profile := getProfile(cmd); profile != "" {
+		chainOption = append(chainOption, chain.ConfigProfile(profile))
+	}
+
+	c, err := chain.New(flagGetPath(cmd), chainOption...)
+	if err != nil {
+		return err
+	}
+
+	cacheStorage, err := newCache(cmd)
+	if err != nil {
+		return err
+	}
+
+	workDir, err := os.MkdirTemp("", "ignite-bench")
+	if err != nil {
+		return err
+	}
+	defer os.RemoveAll(workDir)
+
+	result, err := c.Bench(cmd.Context(), cacheStorage, workDir, benchOptions...)
+	if err != nil {
+		return err
+	}
+
+	session.StopSpinner()
+
+	if output != "" {
+		data, err := json.MarshalIndent(result, "", "  ")
+		if err != nil {
+			return err
+		}
+
+		if err := os.WriteFile(output, data, 0o644); err != nil {
+			return err
+		}
+	}
+
+	return session.Printf(`Transactions: %d sent, %d committed, %d failed
+Throughput:   %.2f TPS in %.1fs
+Blocks:       %d, block time avg %.0fms p95 %.0fms
+Gas/block:    avg %.0f max %.0f
+Mempool size: avg %.1f max %.0f
+Inclusion:    p50 %.0fms p95 %.0fms max %.0fms
+`,
+		result.TxsSent, result.TxsCommitted, result.TxsFailed,
+		result.TPS, result.Duration,
+		result.Blocks, result.BlockTime.Avg, result.BlockTime.P95,
+		result.GasPerBlock.Avg, result.GasPerBlock.Max,
+		result.MempoolSize.Avg, result.MempoolSize.Max,
+		result.InclusionLatency.P50, result.InclusionLatency.P95, result.InclusionLatency.Max,
+	)
+}
@@ -2,6 +2,7 @@ package ignitecmd
 
 import (
 	"path/filepath"
+	"runtime"
 
 	"github.com/spf13/cobra"
 	flag "github.com/spf13/pflag"
@@ -9,6 +10,7 @@ import (
 	"github.com/ignite/cli/v29/ignite/pkg/chaincmd"
 	"github.com/ignite/cli/v29/ignite/pkg/cliui"
 	"github.com/ignite/cli/v29/ignite/pkg/cliui/colors"
+	"github.com/ignite/cli/v29/ignite/pkg/cosmovisor"
 	"github.com/ignite/cli/v29/ignite/services/chain"
 )
 
@@ -20,6 +22,12 @@ const (
 	flagBuildTags         = "build.tags"
 	flagReleasePrefix     = "release.prefix"
 	flagReleaseTargets    = "release.targets"
+	flagReleaseFormat     = "release.format"
+	flagReleaseParallel   = "release.parallel"
+	flagReproducible      = "reproducible"
+	flagSignKey           = "sign-key"
+	flagUpgrade           = "upgrade"
+	flagUpgradeURL        = "upgrade.url"
 )
 
 // NewChainBuild returns a new build command to build a blockchain app.
@@ -78,6 +86,56 @@ tags. If the optional --release.targets is not specified, a binary is created
 for your current environment.
 
 	ignite chain build --release -t linux:amd64 -t darwin:amd64 -t darwin:arm64
+
+The release targets are built in parallel, by default as many targets as CPUs
+are built at the same time. The binaries are archived in ".tar.gz" files by
+default, use the --release.format flag to use "zip", "tar.zst" or "raw" to not
+archive the binaries:
+
+	ignite chain build --release --release.format zip --release.parallel 2
+
+The release targets, the linker flags of each target, the archive format, the
+name of the release files and extra files to bundle with the binaries can also
+be defined in config.yml:
+
+	release:
+	  targets:
+	    - target: linux:amd64
+	      ldflags: ["-extldflags=-static"]
+	    - target: darwin:arm64
+	  format: tar.zst
+	  name: "{{.Prefix}}-{{.Version}}-{{.OS}}-{{.Arch}}"
+	  files: ["LICENSE"]
+
+Besides the binaries, the release directory contains a CycloneDX SBOM with the
+Go modules of the chain, the in-toto build provenance of the binaries and a
+checksum file.
+
+Use the --reproducible flag to build a release that produces the same files for
+the same source code. Binaries are built without local file system paths and
+are stamped with version control information, and the files in the archives
+use the time of the last commit as modification time. Set SOURCE_DATE_EPOCH to
+use a different time:
+
+	ignite chain build --release --reproducible
+
+The checksum file can be signed using a PEM encoded PKCS #8 private key. The
+signature is saved next to the checksum file with the ".sig" extension:
+
+	ignite chain build --release --sign-key release.pem
+
+Use the --upgrade flag to build the release of a chain upgrade for Cosmovisor.
+The binaries are archived inside a "bin" directory, as expected by Cosmovisor
+to download upgrade binaries, and the release contains the Cosmovisor directory
+tree for the upgrade, "cosmovisor/upgrades/<name>/bin/<binary>", which can be
+copied to the home directory of a node.
+
+The release also contains an "upgrade-plan-info.json" file with the URL and checksum
+of the binary of each release target, which is the info of the governance
+upgrade proposal. Use the --upgrade.url flag to set the URL where the release
+files are published:
+
+	ignite chain build --upgrade v2 -t linux:amd64 -t linux:arm64 --upgrade.url https://example.com/releases/v2
 `,
 		Args: cobra.NoArgs,
 		RunE: chainBuildHandler,
@@ -86,12 +144,19 @@ for your current environment.
 	flagSetPath(c)
 	flagSetClearCache(c)
 	c.Flags().AddFlagSet(flagSetCheckDependencies())
+	c.Flags().AddFlagSet(flagSetProfile())
 	c.Flags().AddFlagSet(flagSetSkipProto())
 	c.Flags().AddFlagSet(flagSetDebug())
 	c.Flags().Bool(flagRelease, false, "build for a release")
 	c.Flags().StringSliceP(flagReleaseTargets, "t", []string{}, "release targets. Available only with --release flag")
 	c.Flags().StringSlice(flagBuildTags, []string{}, "parameters to build the chain binary")
 	c.Flags().String(flagReleasePrefix, "", "tarball prefix for each release target. Available only with --release flag")
+	c.Flags().String(flagReleaseFormat, "", "archive format of the release binaries (tar.gz, tar.zst, zip or raw). Available only with --release flag")
+	c.Flags().Int(flagReleaseParallel, runtime.NumCPU(), "number of release targets built in parallel. Available only with --release flag")
+	c.Flags().Bool(flagReproducible, false, "build a reproducible release. Available only with --release flag")
+	c.Flags().String(flagSignKey, "", "private key file to sign the release checksum. Available only with --release flag")
+	c.Flags().String(flagUpgrade, "", "name of the chain upgrade to build a Cosmovisor release for")
+	c.Flags().String(flagUpgradeURL, "", "URL where the upgrade release files are published. Available only with --upgrade flag")
 	c.Flags().StringP(flagOutput, "o", "", "binary output path")
 	c.Flags().BoolP("verbose", "v", false, "verbose output")
 
@@ -100,12 +165,18 @@ for your current environment.
 
 func chainBuildHandler(cmd *cobra.Command, _ []string) error {
 	var (
-		isRelease, _      = cmd.Flags().GetBool(flagRelease)
-		releaseTargets, _ = cmd.Flags().GetStringSlice(flagReleaseTargets)
-		releasePrefix, _  = cmd.Flags().GetString(flagReleasePrefix)
-		buildTags, _      = cmd.Flags().GetStringSlice(flagBuildTags)
-		output, _         = cmd.Flags().GetString(flagOutput)
-		session           = cliui.New(
+		isRelease, _       = cmd.Flags().GetBool(flagRelease)
+		releaseTargets, _  = cmd.Flags().GetStringSlice(flagReleaseTargets)
+		releasePrefix, _   = cmd.Flags().GetString(flagReleasePrefix)
+		releaseFormat, _   = cmd.Flags().GetString(flagReleaseFormat)
+		releaseParallel, _ = cmd.Flags().GetInt(flagReleaseParallel)
+		reproducible, _    = cmd.Flags().GetBool(flagReproducible)
+		signKey, _         = cmd.Flags().GetString(flagSignKey)
+		upgrade, _         = cmd.Flags().GetString(flagUpgrade)
+		upgradeURL, _      = cmd.Flags().GetString(flagUpgradeURL)
+		buildTags, _       = cmd.Flags().GetStringSlice(flagBuildTags)
+		output, _          = cmd.Flags().GetString(flagOutput)
+		session            = cliui.New(
 			cliui.WithVerbosity(getVerbosity(cmd)),
 			cliui.StartSpinner(),
 		)
@@ -123,6 +194,10 @@ func chainBuildHandler(cmd *cobra.Command, _ []string) error {
 		chainOption = append(chainOption, chain.CheckDependencies())
 	}
 
+	if profile := getProfile(cmd); profile != "" {
+		chainOption = append(chainOption, chain.ConfigProfile(profile))
+	}
+
 	c, err := chain.NewWithHomeFlags(cmd, chainOption...)
 	if err != nil {
 		return err
@@ -134,12 +209,42 @@ func chainBuildHandler(cmd *cobra.Command, _ []string) error {
 	}
 
 	ctx := cmd.Context()
-	if isRelease {
-		releasePath, err := c.BuildRelease(ctx, cacheStorage, buildTags, output, releasePrefix, releaseTargets...)
+	// upgrades are always built as releases
+	if isRelease || upgrade != "" {
+		releaseOptions := []chain.ReleaseOption{chain.ReleaseParallel(releaseParallel)}
+		if releaseFormat != "" {
+			releaseOptions = append(releaseOptions, chain.ReleaseFormat(releaseFormat))
+		}
+		if reproducible {
+			releaseOptions = append(releaseOptions, chain.ReleaseReproducible())
+		}
+		if signKey != "" {
+			releaseOptions = append(releaseOptions, chain.ReleaseSignKey(signKey))
+		}
+		if upgrade != "" {
+			releaseOptions = append(releaseOptions, chain.ReleaseUpgrade(upgrade, upgradeURL))
+		}
+
+		releasePath, err := c.BuildRelease(
+			ctx,
+			cacheStorage,
+			buildTags,
+			output,
+			releasePrefix,
+			releaseTargets,
+			releaseOptions...,
+		)
 		if err != nil {
 			return err
 		}
 
+		if upgrade != "" {
+			infoPath := filepath.Join(releasePath, cosmovisor.PlanInfoFile)
+			if err := session.Printf("🗃  Upgrade %s info: %s\n", upgrade, colors.Info(infoPath)); err != nil {
+				return err
+			}
+		}
+
 		return session.Printf("🗃  Release created: %s\n", colors.Info(releasePath))
 	}
 
@@ -0,0 +1,27 @@
+package ignitecmd
+
+import (
+	"github.com/spf13/cobra"
+)
+
+// NewChainCheck creates a new command to check the source code of the chain.
+func NewChainCheck() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "check [command]",
+		Short: "Check the source code of the chain for state machine issues",
+		Long: `Commands in this namespace check the source code of the chain modules for
+issues that can break the consensus of the chain.
+
+To check the state machine code for non-deterministic code:
+
+	ignite chain check determinism
+`,
+		Args: cobra.ExactArgs(1),
+	}
+
+	c.AddCommand(
+		NewChainCheckDeterminism(),
+	)
+
+	return c
+}
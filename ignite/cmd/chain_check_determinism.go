@@ -0,0 +1,120 @@
+package ignitecmd
+
+import (
+	"bytes"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/ignite/cli/v29/ignite/pkg/cliui"
+	"github.com/ignite/cli/v29/ignite/pkg/cosmoslint"
+	"github.com/ignite/cli/v29/ignite/pkg/errors"
+	"github.com/ignite/cli/v29/ignite/services/chain"
+)
+
+const flagAllowlist = "allowlist"
+
+// NewChainCheckDeterminism returns a command to check the state machine code for non-determinism.
+func NewChainCheckDeterminism() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "determinism",
+		Short: "Detect non-deterministic code in the state machine of the modules",
+		Long: `The determinism command finds the code of the chain modules that is reachable
+from the message servers and from the functions called at the beginning and the
+end of each block, like BeginBlock and EndBlock, and reports the code that can
+have different results in each node:
+
+  maprange   map iteration that writes to the state, maps have a random order
+  goroutine  goroutines, which are scheduled in a random order
+  timenow    calls to time.Now instead of using the block time
+  rand       random values generated with math/rand or crypto/rand
+  float      floating point arithmetic
+  os         access to the operating system, like environment variables or files
+  net        network access
+
+The check is done statically by analyzing the source code. Method calls are
+resolved by name, so it can report code that is not really reachable.
+
+Issues can be ignored by adding a "//nolint:<rule>" comment to the line of the
+issue, or with an allowlist file. By default the ".determinism-allowlist" file of
+the app directory is used when it exists. Each line of the allowlist is a path
+pattern of the files, optionally followed by a line number and a rule:
+
+  # Allow all the issues of a file
+  x/blog/keeper/legacy.go
+  # Allow the issues of a line
+  x/blog/keeper/msg_server.go:42
+  # Allow the floating point arithmetic of the types of all modules
+  x/*/types/*.go float
+
+The command fails when issues are found.
+`,
+		Args: cobra.NoArgs,
+		RunE: chainCheckDeterminismHandler,
+	}
+
+	flagSetPath(c)
+	c.Flags().String(flagAllowlist, "", "allowlist file of the issues to ignore (default is .determinism-allowlist when it exists)")
+	c.Flags().String(flagLintFormat, string(cosmoslint.FormatText), "format of the report (text, json, sarif)")
+	c.Flags().StringP(flagLintOutput, "o", "", "file to write the report to instead of the standard output")
+
+	return c
+}
+
+func chainCheckDeterminismHandler(cmd *cobra.Command, _ []string) error {
+	var (
+		allowlist, _  = cmd.Flags().GetString(flagAllowlist)
+		formatName, _ = cmd.Flags().GetString(flagLintFormat)
+		output, _     = cmd.Flags().GetString(flagLintOutput)
+	)
+
+	format, err := cosmoslint.ParseFormat(formatName)
+	if err != nil {
+		return err
+	}
+
+	session := cliui.New(
+		cliui.StartSpinnerWithText("Checking..."),
+	)
+	defer session.End()
+
+	c, err := chain.NewWithHomeFlags(
+		cmd,
+		chain.WithOutputer(session),
+		chain.CollectEvents(session.EventBus()),
+	)
+	if err != nil {
+		return err
+	}
+
+	issues, err := c.CheckDeterminism(allowlist)
+	if err != nil {
+		return err
+	}
+
+	var report bytes.Buffer
+	if err := cosmoslint.WriteReport(&report, format, issues); err != nil {
+		return err
+	}
+
+	session.StopSpinner()
+
+	if output != "" {
+		if err := os.WriteFile(output, report.Bytes(), 0o644); err != nil {
+			return err
+		}
+	} else if err := session.Print(report.String()); err != nil {
+		return err
+	}
+
+	if len(issues) > 0 {
+		return errors.Errorf("%d non-deterministic code issues found", len(issues))
+	}
+
+	// The message is not printed with the report to keep the report output parsable
+	if format == cosmoslint.FormatText || output != "" {
+		return session.Println("✨ No non-deterministic code found.")
+	}
+
+	return nil
+}
@@ -0,0 +1,46 @@
+package ignitecmd
+
+import (
+	"github.com/spf13/cobra"
+)
+
+// NewChainConfig creates a new command to work with the chain config file.
+func NewChainConfig() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "config [command]",
+		Short: "Validate the config file and export its JSON Schema",
+		Long: `Commands in this namespace help to write valid config files.
+
+The JSON Schema of the config file can be used by editors to validate and
+autocomplete the config values while it is being edited:
+
+	ignite chain config schema -o config.schema.json
+
+For example editors that use the YAML language server can reference the schema
+at the top of the config file:
+
+	# yaml-language-server: $schema=./config.schema.json
+
+Config files are validated each time they are read. Unknown keys, like a
+misspelled field name, are reported with their line and column. To validate
+the config file without running other commands, for example in CI:
+
+	ignite chain config validate
+
+A config profile can be validated too:
+
+	ignite chain config validate --profile ci
+`,
+		Args: cobra.ExactArgs(1),
+		// Config commands must work with invalid config files so the chain
+		// pre run handler, which reads and migrates the config, is not used
+		PersistentPreRunE: func(*cobra.Command, []string) error { return nil },
+	}
+
+	c.AddCommand(
+		NewChainConfigSchema(),
+		NewChainConfigValidate(),
+	)
+
+	return c
+}
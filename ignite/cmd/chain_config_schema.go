@@ -0,0 +1,51 @@
+package ignitecmd
+
+import (
+	"os"
+
+	"github.com/spf13/cobra"
+
+	chainconfig "github.com/ignite/cli/v29/ignite/config/chain"
+	"github.com/ignite/cli/v29/ignite/pkg/cliui"
+	"github.com/ignite/cli/v29/ignite/pkg/cliui/icons"
+)
+
+// NewChainConfigSchema creates a new command to export the JSON Schema of the chain config.
+func NewChainConfigSchema() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "schema",
+		Short: "Export the JSON Schema of the config file",
+		Long: `Export the JSON Schema of the latest config file version.
+
+The schema is printed to the standard output unless an output file is used.`,
+		Args: cobra.NoArgs,
+		RunE: chainConfigSchemaHandler,
+	}
+
+	c.Flags().StringP(flagOutput, "o", "", "JSON Schema output file")
+
+	return c
+}
+
+func chainConfigSchemaHandler(cmd *cobra.Command, _ []string) error {
+	output, _ := cmd.Flags().GetString(flagOutput)
+
+	schema, err := chainconfig.Schema()
+	if err != nil {
+		return err
+	}
+
+	if output == "" {
+		_, err := cmd.OutOrStdout().Write(append(schema, '\n'))
+		return err
+	}
+
+	session := cliui.New()
+	defer session.End()
+
+	if err := os.WriteFile(output, append(schema, '\n'), 0o644); err != nil {
+		return err
+	}
+
+	return session.Printf("%s Config JSON Schema exported to %s\n", icons.OK, output)
+}
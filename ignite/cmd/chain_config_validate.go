@@ -0,0 +1,51 @@
+package ignitecmd
+
+import (
+	"github.com/spf13/cobra"
+
+	chainconfig "github.com/ignite/cli/v29/ignite/config/chain"
+	"github.com/ignite/cli/v29/ignite/pkg/cliui"
+	"github.com/ignite/cli/v29/ignite/pkg/cliui/icons"
+)
+
+// NewChainConfigValidate creates a new command to validate the chain config.
+func NewChainConfigValidate() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "validate",
+		Short: "Validate the config file",
+		Long: `Validate the config file of the blockchain app.
+
+The command fails when the config file is not valid, for example when it
+contains unknown keys or invalid values, so it can be used in CI pipelines.`,
+		Args: cobra.NoArgs,
+		RunE: chainConfigValidateHandler,
+	}
+
+	flagSetPath(c)
+	c.Flags().AddFlagSet(flagSetProfile())
+
+	return c
+}
+
+func chainConfigValidateHandler(cmd *cobra.Command, _ []string) error {
+	session := cliui.New()
+	defer session.End()
+
+	configPath := getConfig(cmd)
+	if configPath == "" {
+		path, err := goModulePath(cmd)
+		if err != nil {
+			return err
+		}
+
+		if configPath, err = chainconfig.LocateDefault(path); err != nil {
+			return err
+		}
+	}
+
+	if _, err := chainconfig.ParseFile(configPath, chainconfig.WithProfile(getProfile(cmd))); err != nil {
+		return err
+	}
+
+	return session.Printf("%s Config file %s is valid\n", icons.OK, configPath)
+}
@@ -1,38 +1,131 @@
 package ignitecmd
 
 import (
+	"bytes"
+	"os"
+
 	"github.com/spf13/cobra"
 
 	"github.com/ignite/cli/v29/ignite/pkg/cliui"
+	"github.com/ignite/cli/v29/ignite/pkg/cosmoslint"
+	"github.com/ignite/cli/v29/ignite/pkg/errors"
 	"github.com/ignite/cli/v29/ignite/services/chain"
 )
 
+const (
+	flagLintFormat          = "format"
+	flagLintOutput          = "output"
+	flagLintBreakingAgainst = "breaking-against"
+)
+
 // NewChainLint returns a lint command to build a blockchain app.
 func NewChainLint() *cobra.Command {
 	c := &cobra.Command{
 		Use:   "lint",
-		Short: "Lint codebase using golangci-lint",
-		Long:  "The lint command runs the golangci-lint tool to lint the codebase.",
-		Args:  cobra.NoArgs,
-		RunE: func(cmd *cobra.Command, _ []string) error {
-			session := cliui.New(
-				cliui.StartSpinnerWithText("Linting..."),
-			)
-			defer session.End()
+		Short: "Lint the Go code, the proto files and the state machine of the chain",
+		Long: `The lint command runs the golangci-lint tool to lint the Go code, buf to lint
+the proto files, and a set of Cosmos analyzers that detect code that is unsafe
+to run in the state machine of the chain modules:
+
+  maprange  iteration over maps, which has a random order
+  timenow   calls to time.Now in the keepers instead of using the block time
+  float     floating point arithmetic in the message handlers
 
-			chainOption := []chain.Option{
-				chain.WithOutputer(session),
-				chain.CollectEvents(session.EventBus()),
-			}
+The golangci-lint version and config file, the git ref to check the proto files
+for breaking changes against, and the Cosmos analyzers to disable can be defined
+in the "lint" section of the config file:
 
-			c, err := chain.NewWithHomeFlags(cmd, chainOption...)
-			if err != nil {
-				return err
-			}
+  lint:
+    version: v1.57.2
+    config: .golangci.yml
+    breaking_against: main
+    disable: ["float"]
 
-			return c.Lint(cmd.Context())
-		},
+Issues of the Cosmos analyzers can be ignored by adding a "//nolint:<analyzer>"
+comment to the line of the issue.
+
+The issues can be reported as JSON or using SARIF to annotate the code in CI:
+
+  ignite chain lint --format sarif --output lint.sarif
+
+The command fails when issues are found.
+`,
+		Args: cobra.NoArgs,
+		RunE: chainLintHandler,
 	}
 
+	flagSetPath(c)
+	c.Flags().String(flagLintFormat, string(cosmoslint.FormatText), "format of the lint report (text, json, sarif)")
+	c.Flags().StringP(flagLintOutput, "o", "", "file to write the lint report to instead of the standard output")
+	c.Flags().String(flagLintBreakingAgainst, "", "git ref to check the proto files for breaking changes against")
+	c.Flags().Bool(flagSkipProto, false, "skip the linting of the proto files")
+
 	return c
 }
+
+func chainLintHandler(cmd *cobra.Command, _ []string) error {
+	var (
+		formatName, _      = cmd.Flags().GetString(flagLintFormat)
+		output, _          = cmd.Flags().GetString(flagLintOutput)
+		breakingAgainst, _ = cmd.Flags().GetString(flagLintBreakingAgainst)
+	)
+
+	format, err := cosmoslint.ParseFormat(formatName)
+	if err != nil {
+		return err
+	}
+
+	session := cliui.New(
+		cliui.StartSpinnerWithText("Linting..."),
+	)
+	defer session.End()
+
+	chainOption := []chain.Option{
+		chain.WithOutputer(session),
+		chain.CollectEvents(session.EventBus()),
+	}
+
+	c, err := chain.NewWithHomeFlags(cmd, chainOption...)
+	if err != nil {
+		return err
+	}
+
+	var lintOptions []chain.LintOption
+	if flagGetSkipProto(cmd) {
+		lintOptions = append(lintOptions, chain.LintSkipProto())
+	}
+	if breakingAgainst != "" {
+		lintOptions = append(lintOptions, chain.LintBreakingAgainst(breakingAgainst))
+	}
+
+	issues, err := c.Lint(cmd.Context(), lintOptions...)
+	if err != nil {
+		return err
+	}
+
+	var report bytes.Buffer
+	if err := cosmoslint.WriteReport(&report, format, issues); err != nil {
+		return err
+	}
+
+	session.StopSpinner()
+
+	if output != "" {
+		if err := os.WriteFile(output, report.Bytes(), 0o644); err != nil {
+			return err
+		}
+	} else if err := session.Print(report.String()); err != nil {
+		return err
+	}
+
+	if len(issues) > 0 {
+		return errors.Errorf("%d lint issues found", len(issues))
+	}
+
+	// The message is not printed with the report to keep the report output parsable
+	if format == cosmoslint.FormatText || output != "" {
+		return session.Println("✨ No lint issues found.")
+	}
+
+	return nil
+}
@@ -0,0 +1,168 @@
+package ignitecmd
+
+import (
+	"os"
+	"regexp"
+
+	"github.com/spf13/cobra"
+
+	chainconfig "github.com/ignite/cli/v29/ignite/config/chain"
+	"github.com/ignite/cli/v29/ignite/pkg/chaincmd"
+	"github.com/ignite/cli/v29/ignite/pkg/cliui"
+	"github.com/ignite/cli/v29/ignite/pkg/errors"
+	"github.com/ignite/cli/v29/ignite/pkg/nodelog"
+	"github.com/ignite/cli/v29/ignite/services/chain"
+)
+
+const (
+	flagFollow = "follow"
+	flagLines  = "lines"
+	flagGrep   = "grep"
+	flagLevel  = "level"
+)
+
+// NewChainLogs creates a new command to display the logs of the nodes started by serve.
+func NewChainLogs() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "logs [validator]",
+		Short: "Display the logs of a node started by serve",
+		Long: `The logs command displays the output of a blockchain node started by the
+"serve" command. The output of every validator node is saved to a log file in
+the Ignite data directory of the chain, which is rotated when it grows too big.
+
+By default the logs of the first validator are displayed, the name of another
+validator can be used to display the logs of its node:
+
+	ignite chain logs bob
+
+Use the follow flag to keep displaying the new logs while the chain is served:
+
+	ignite chain logs -f
+
+Logs can be filtered by level and using a regular expression. Lines without a
+level, like the lines of a panic stack trace, are always displayed:
+
+	ignite chain logs --level error --grep "module=(state|consensus)"
+`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: chainLogsHandler,
+	}
+
+	flagSetPath(c)
+	c.Flags().AddFlagSet(flagSetHome())
+	c.Flags().BoolP(flagFollow, "f", false, "keep displaying new logs")
+	c.Flags().IntP(flagLines, "n", 100, "number of lines to display, use 0 to display all the lines")
+	c.Flags().String(flagGrep, "", "display only the lines that match a regular expression")
+	c.Flags().String(flagLevel, "", "minimum log level to display (trace, debug, info, warn, error, fatal)")
+
+	return c
+}
+
+func chainLogsHandler(cmd *cobra.Command, args []string) error {
+	session := cliui.New()
+	defer session.End()
+
+	filter, err := chainLogsFilter(cmd)
+	if err != nil {
+		return err
+	}
+
+	chainOption := []chain.Option{
+		chain.KeyringBackend(chaincmd.KeyringBackendTest),
+	}
+
+	if config := getConfig(cmd); config != "" {
+		chainOption = append(chainOption, chain.ConfigFile(config))
+	}
+
+	c, err := chain.NewWithHomeFlags(cmd, chainOption...)
+	if err != nil {
+		return err
+	}
+
+	cfg, err := c.Config()
+	if err != nil {
+		return err
+	}
+
+	validator, err := chainconfig.FirstValidator(cfg)
+	if err != nil {
+		return err
+	}
+
+	name := validator.Name
+	if len(args) > 0 {
+		name = args[0]
+	}
+
+	if !hasValidator(cfg, name) {
+		return errors.Errorf("validator %q is not defined in the config", name)
+	}
+
+	logPath, err := c.NodeLogPath(name)
+	if err != nil {
+		return err
+	}
+
+	if _, err := os.Stat(logPath); os.IsNotExist(err) {
+		return errors.Errorf("no logs found for %s, logs are saved when the chain is served", name)
+	}
+
+	lines, err := nodelog.Tail(logPath, 0)
+	if err != nil {
+		return err
+	}
+
+	var selected []string
+	for _, line := range lines {
+		if filter.Match(line) {
+			selected = append(selected, line)
+		}
+	}
+
+	if n, _ := cmd.Flags().GetInt(flagLines); n > 0 && len(selected) > n {
+		selected = selected[len(selected)-n:]
+	}
+
+	for _, line := range selected {
+		if err := session.Println(line); err != nil {
+			return err
+		}
+	}
+
+	if follow, _ := cmd.Flags().GetBool(flagFollow); !follow {
+		return nil
+	}
+
+	return nodelog.Follow(cmd.Context(), logPath, func(line string) {
+		if filter.Match(line) {
+			_ = session.Println(line)
+		}
+	})
+}
+
+func chainLogsFilter(cmd *cobra.Command) (filter nodelog.Filter, err error) {
+	if level, _ := cmd.Flags().GetString(flagLevel); level != "" {
+		if filter.MinLevel, err = nodelog.ParseLevel(level); err != nil {
+			return filter, err
+		}
+	}
+
+	if pattern, _ := cmd.Flags().GetString(flagGrep); pattern != "" {
+		if filter.Pattern, err = regexp.Compile(pattern); err != nil {
+			return filter, errors.Errorf("invalid grep pattern: %w", err)
+		}
+	}
+
+	return filter, nil
+}
+
+func hasValidator(cfg *chainconfig.Config, name string) bool {
+	for _, v := range cfg.Validators {
+		if v.Name == name {
+			return true
+		}
+	}
+
+	return false
+}
@@ -21,6 +21,8 @@ const (
 	flagGenerateClients = "generate-clients"
 	flagQuitOnFail      = "quit-on-fail"
 	flagResetOnce       = "reset-once"
+	flagFromState       = "from-state"
+	flagAttach          = "attach"
 )
 
 // NewChainServe creates a new serve command to serve a blockchain.
@@ -29,9 +31,9 @@ func NewChainServe() *cobra.Command {
 		Use:   "serve",
 		Short: "Start a blockchain node in development",
 		Long: `The serve command compiles and installs the binary (like "ignite chain build"),
-uses that binary to initialize the blockchain's data directory for one validator
-(like "ignite chain init"), and starts the node locally for development purposes
-with automatic code reloading.
+uses that binary to initialize the blockchain's data directory for each validator
+defined in the config (like "ignite chain init"), and starts the nodes locally for
+development purposes with automatic code reloading.
 
 Automatic code reloading means Ignite starts watching the project directory.
 Whenever a file change is detected, Ignite automatically rebuilds, reinitializes
@@ -40,6 +42,11 @@ and restarts the node.
 Whenever possible Ignite will try to keep the current state of the chain by
 exporting and importing the genesis file.
 
+Changes to the config file are applied using the smallest possible action.
+Faucet changes only restart the faucet server, changes to the validators' app,
+config or client values rewrite the node config files and restart the node,
+and only changes to the genesis, the accounts or the validators reset the state.
+
 To force Ignite to start from a clean slate even if a genesis file exists, use
 the following flag:
 
@@ -50,6 +57,11 @@ the following flag:
 
 	ignite chain serve --force-reset
 
+To start the chain from a state saved with "ignite chain state save", use the
+following flag:
+
+	ignite chain serve --from-state after-gov-proposal
+
 With Ignite it's possible to start more than one blockchain from the same source
 code using different config files. This is handy if you're building
 inter-blockchain functionality and, for example, want to try sending packets
@@ -57,6 +69,33 @@ from one blockchain to another. To start a node using a specific config file:
 
 	ignite chain serve --config mars.yml
 
+To use a set of config values for a specific environment, like CI or a demo,
+define a profile in the "profiles" section of the config file or in a
+"config.<profile>.yml" file next to it, and select it using the following flag:
+
+	ignite chain serve --profile demo
+
+When more than one validator is defined in the config file, a data directory is
+initialized for each one of them and every validator signs its own gentx. The
+nodes are connected to each other as persistent peers, which allows to test
+consensus dependent logic like slashing or upgrades locally. The data directory
+of each additional validator can be defined using the validator's "home" option,
+otherwise the chain's data directory suffixed with the validator name is used:
+
+	validators:
+	  - name: alice
+	    bonded: 100000000stake
+	  - name: bob
+	    bonded: 100000000stake
+
+To use Ignite with a node that is already running, for example a node started by
+a Docker Compose stack, attach to it using its RPC address. The app is not built
+nor started, but the faucet is served, code is generated when proto files change
+and the accounts of the config that define a mnemonic are imported into the
+keyring. The chain binary must be installed to attach to a node:
+
+	ignite chain serve --attach localhost:26657
+
 The serve command is meant to be used ONLY FOR DEVELOPMENT PURPOSES. Under the
 hood, it runs "appd start", where "appd" is the name of your chain's binary. For
 production, you may want to run "appd start" manually.
@@ -69,6 +108,7 @@ production, you may want to run "appd start" manually.
 	flagSetClearCache(c)
 	c.Flags().AddFlagSet(flagSetHome())
 	c.Flags().AddFlagSet(flagSetCheckDependencies())
+	c.Flags().AddFlagSet(flagSetProfile())
 	c.Flags().AddFlagSet(flagSetSkipProto())
 	c.Flags().BoolP("verbose", "v", false, "verbose output")
 	c.Flags().BoolP(flagForceReset, "f", false, "force reset of the app state on start and every source change")
@@ -76,6 +116,8 @@ production, you may want to run "appd start" manually.
 	c.Flags().Bool(flagGenerateClients, false, "generate code for the configured clients on reset or source code change")
 	c.Flags().Bool(flagQuitOnFail, false, "quit program if the app fails to start")
 	c.Flags().StringSlice(flagBuildTags, []string{}, "parameters to build the chain binary")
+	c.Flags().String(flagFromState, "", "restore a saved chain state when the chain is started")
+	c.Flags().String(flagAttach, "", "RPC address of a running node to attach to instead of building and starting the chain")
 
 	return c
 }
@@ -133,6 +175,10 @@ func chainServe(cmd *cobra.Command, session *cliui.Session) error {
 		chainOption = append(chainOption, chain.CheckDependencies())
 	}
 
+	if profile := getProfile(cmd); profile != "" {
+		chainOption = append(chainOption, chain.ConfigProfile(profile))
+	}
+
 	// check if custom config is defined
 	config, _ := cmd.Flags().GetString(flagConfig)
 	if config != "" {
@@ -182,9 +228,28 @@ func chainServe(cmd *cobra.Command, session *cliui.Session) error {
 		serveOptions = append(serveOptions, chain.ServeSkipProto())
 	}
 
+	if fromState, _ := cmd.Flags().GetString(flagFromState); fromState != "" {
+		serveOptions = append(serveOptions, chain.ServeFromState(fromState))
+	}
+
 	if quitOnFail {
 		serveOptions = append(serveOptions, chain.QuitOnFail())
 	}
 
+	if attach, _ := cmd.Flags().GetString(flagAttach); attach != "" {
+		fromState, _ := cmd.Flags().GetString(flagFromState)
+		if forceUpdate || resetOnce || fromState != "" {
+			return errors.Errorf(
+				"--%s can't be used together with --%s, --%s or --%s",
+				flagAttach,
+				flagForceReset,
+				flagResetOnce,
+				flagFromState,
+			)
+		}
+
+		serveOptions = append(serveOptions, chain.ServeAttach(attach))
+	}
+
 	return c.Serve(cmd.Context(), cacheStorage, serveOptions...)
 }
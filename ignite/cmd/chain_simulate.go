@@ -1,11 +1,23 @@
 package ignitecmd
 
 import (
+	"bytes"
+	"fmt"
+	"os"
 	"path/filepath"
+	"runtime"
+	"slices"
+	"strings"
 
 	"github.com/cosmos/cosmos-sdk/types/simulation"
 	"github.com/spf13/cobra"
+	flag "github.com/spf13/pflag"
 
+	"github.com/ignite/cli/v29/ignite/pkg/chaincmd"
+	"github.com/ignite/cli/v29/ignite/pkg/cliui"
+	"github.com/ignite/cli/v29/ignite/pkg/cliui/icons"
+	"github.com/ignite/cli/v29/ignite/pkg/errors"
+	"github.com/ignite/cli/v29/ignite/pkg/simcampaign"
 	"github.com/ignite/cli/v29/ignite/services/chain"
 )
 
@@ -26,16 +38,64 @@ const (
 	flagSimappVerbose                = "verbose"
 	flagSimappPeriod                 = "period"
 	flagSimappGenesisTime            = "genesisTime"
+	flagSimappMode                   = "mode"
+	flagSimappRuns                   = "runs"
+	flagSimappSeeds                  = "seeds"
+	flagSimappParallel               = "parallel"
+	flagSimappOutputDir              = "outputDir"
+
+	simulationSummaryMarkdown = "summary.md"
+	simulationSummaryHTML     = "summary.html"
 )
 
+// campaignFlags are the simulate flags that are not used to reproduce the simulation of a seed.
+var campaignFlags = []string{
+	flagSimappSeed,
+	flagSimappSeeds,
+	flagSimappParallel,
+	flagSimappOutputDir,
+	flagSimappExportParamsPath,
+	flagSimappExportStatsPath,
+}
+
 // NewChainSimulate creates a new simulation command to run the blockchain simulation.
 func NewChainSimulate() *cobra.Command {
 	c := &cobra.Command{
 		Use:   "simulate",
 		Short: "Run simulation testing for the blockchain",
-		Long:  "Run simulation testing for the blockchain. It sends many randomized-input messages of each module to a simulated node and checks if invariants break",
-		Args:  cobra.NoArgs,
-		RunE:  chainSimulationHandler,
+		Long: `Run simulation testing for the blockchain. It sends many randomized-input
+messages of each module to a simulated node and checks if invariants break.
+
+The simulation mode selects the simulation test of the app that runs:
+
+  full           the full app simulation (default)
+  import-export  runs the simulation, exports the state, imports it into a new
+                 app and compares the stores of both apps
+  after-import   runs the simulation, exports the state, imports it into a new
+                 app and runs the simulation again
+  determinism    runs the simulation with the same seed many times and compares
+                 the app hashes
+
+The stores that diverge are reported with the keys that are different:
+
+	ignite chain simulate --mode determinism --runs 5 --seed 7
+
+The modes run the tests of the "app/sim_test.go" file of the chain.
+
+A simulation campaign runs the simulation with many seeds, which can be a list
+of seeds and ranges of seeds, running some of them at the same time:
+
+	ignite chain simulate --seeds 1..50 --parallel 4
+
+The output of each simulation, the exported operation stats and the random
+params are saved in a directory for each seed inside the output directory. The
+seeds that fail are reported with the invariants that they broke and with the
+command to reproduce their simulation. A summary of the campaign with the
+success rate of the operations of each message type is written to the output
+directory in Markdown and HTML.
+`,
+		Args: cobra.NoArgs,
+		RunE: chainSimulationHandler,
 	}
 	simappFlags(c)
 	return c
@@ -46,14 +106,27 @@ func chainSimulationHandler(cmd *cobra.Command, _ []string) error {
 		verbose, _     = cmd.Flags().GetBool(flagSimappVerbose)
 		period, _      = cmd.Flags().GetUint(flagSimappPeriod)
 		genesisTime, _ = cmd.Flags().GetInt64(flagSimappGenesisTime)
+		modeName, _    = cmd.Flags().GetString(flagSimappMode)
+		runs, _        = cmd.Flags().GetInt(flagSimappRuns)
 		config         = newConfigFromFlags(cmd)
 		appPath        = flagGetPath(cmd)
 	)
+
+	mode, err := chaincmd.ParseSimulationMode(modeName)
+	if err != nil {
+		return err
+	}
+
 	// create the chain with path
 	absPath, err := filepath.Abs(appPath)
 	if err != nil {
 		return err
 	}
+
+	if cmd.Flags().Changed(flagSimappSeeds) {
+		return chainSimulationCampaignHandler(cmd, absPath, config, mode, runs)
+	}
+
 	c, err := chain.New(absPath)
 	if err != nil {
 		return err
@@ -69,9 +142,134 @@ func chainSimulationHandler(cmd *cobra.Command, _ []string) error {
 		chain.SimappWithPeriod(period),
 		chain.SimappWithGenesisTime(genesisTime),
 		chain.SimappWithConfig(config),
+		chain.SimappWithMode(mode),
+		chain.SimappWithRuns(runs),
 	)
 }
 
+// chainSimulationCampaignHandler runs the simulation with many seeds and reports the seeds that failed.
+func chainSimulationCampaignHandler(
+	cmd *cobra.Command,
+	appPath string,
+	config simulation.Config,
+	mode chaincmd.SimulationMode,
+	runs int,
+) error {
+	var (
+		seedsValue, _  = cmd.Flags().GetString(flagSimappSeeds)
+		parallel, _    = cmd.Flags().GetInt(flagSimappParallel)
+		outputDir, _   = cmd.Flags().GetString(flagSimappOutputDir)
+		verbose, _     = cmd.Flags().GetBool(flagSimappVerbose)
+		period, _      = cmd.Flags().GetUint(flagSimappPeriod)
+		genesisTime, _ = cmd.Flags().GetInt64(flagSimappGenesisTime)
+	)
+
+	if cmd.Flags().Changed(flagSimappSeed) {
+		return errors.Errorf("--%s and --%s flags can't be used together", flagSimappSeed, flagSimappSeeds)
+	}
+
+	seeds, err := simcampaign.ParseSeeds(seedsValue)
+	if err != nil {
+		return err
+	}
+
+	outputDir, err = filepath.Abs(outputDir)
+	if err != nil {
+		return err
+	}
+
+	session := cliui.New(cliui.StartSpinnerWithText(fmt.Sprintf("Simulating %d seeds...", len(seeds))))
+	defer session.End()
+
+	c, err := chain.New(appPath, chain.CollectEvents(session.EventBus()))
+	if err != nil {
+		return err
+	}
+
+	config.ChainID, err = c.ID()
+	if err != nil {
+		return err
+	}
+
+	campaign, err := c.SimulateCampaign(cmd.Context(), seeds, parallel, outputDir,
+		chain.SimappWithVerbose(verbose),
+		chain.SimappWithPeriod(period),
+		chain.SimappWithGenesisTime(genesisTime),
+		chain.SimappWithConfig(config),
+		chain.SimappWithMode(mode),
+		chain.SimappWithRuns(runs),
+	)
+	if err != nil {
+		return err
+	}
+
+	for i, run := range campaign.Runs {
+		campaign.Runs[i].Reproducer = simulationReproducer(cmd, run.Seed)
+	}
+
+	if err := writeSimulationSummaries(outputDir, campaign); err != nil {
+		return err
+	}
+
+	session.StopSpinner()
+
+	failed := campaign.Failed()
+	for _, run := range failed {
+		reason := run.Failure
+		if len(run.BrokenInvariants) > 0 {
+			reason = fmt.Sprintf("broken invariants: %s", strings.Join(run.BrokenInvariants, ", "))
+		}
+
+		_ = session.Printf(
+			"%s Seed %d failed: %s\n  Reproduce: %s\n  Output: %s\n",
+			icons.NotOK, run.Seed, reason, run.Reproducer, run.Dir,
+		)
+	}
+
+	_ = session.Printf("Summary: %s\n", filepath.Join(outputDir, simulationSummaryMarkdown))
+
+	if len(failed) > 0 {
+		return errors.Errorf("%d of %d simulations failed", len(failed), len(seeds))
+	}
+
+	return session.Printf("%s All the %d simulations passed\n", icons.OK, len(seeds))
+}
+
+// simulationReproducer returns the command to run the simulation of a seed
+// with the flags used to run the simulation campaign.
+func simulationReproducer(cmd *cobra.Command, seed int64) string {
+	args := []string{"ignite chain simulate", fmt.Sprintf("--%s %d", flagSimappSeed, seed)}
+
+	cmd.Flags().Visit(func(f *flag.Flag) {
+		if slices.Contains(campaignFlags, f.Name) {
+			return
+		}
+		if f.Value.Type() == "bool" {
+			args = append(args, fmt.Sprintf("--%s=%s", f.Name, f.Value))
+			return
+		}
+		args = append(args, fmt.Sprintf("--%s %s", f.Name, f.Value))
+	})
+
+	return strings.Join(args, " ")
+}
+
+// writeSimulationSummaries writes the Markdown and HTML summaries of a campaign to the output directory.
+func writeSimulationSummaries(outputDir string, campaign simcampaign.Campaign) error {
+	var md, html bytes.Buffer
+	if err := simcampaign.WriteMarkdown(&md, campaign); err != nil {
+		return err
+	}
+	if err := simcampaign.WriteHTML(&html, campaign); err != nil {
+		return err
+	}
+
+	if err := os.WriteFile(filepath.Join(outputDir, simulationSummaryMarkdown), md.Bytes(), 0o644); err != nil {
+		return err
+	}
+	return os.WriteFile(filepath.Join(outputDir, simulationSummaryHTML), html.Bytes(), 0o644)
+}
+
 // newConfigFromFlags creates a simulation from the retrieved values of the flags.
 func newConfigFromFlags(cmd *cobra.Command) simulation.Config {
 	var (
@@ -127,4 +325,13 @@ func simappFlags(c *cobra.Command) {
 	c.Flags().BoolP(flagSimappVerbose, "v", false, "verbose log output")
 	c.Flags().Uint(flagSimappPeriod, 0, "run slow invariants only once every period assertions")
 	c.Flags().Int64(flagSimappGenesisTime, 0, "override genesis UNIX time instead of using a random UNIX time")
+
+	// mode flags
+	c.Flags().String(flagSimappMode, string(chaincmd.SimulationModeFull), "simulation mode (full, import-export, after-import, determinism)")
+	c.Flags().Int(flagSimappRuns, 0, "number of times to run the simulation with the same seed in determinism mode, zero uses the default of the app")
+
+	// campaign flags
+	c.Flags().String(flagSimappSeeds, "", "run a simulation campaign with a list of seeds and seed ranges (e.g. 1..50 or 1,7,10..20)")
+	c.Flags().Int(flagSimappParallel, runtime.NumCPU(), "number of simulations of the campaign run in parallel")
+	c.Flags().String(flagSimappOutputDir, "simulations", "directory to save the results and the summary of the simulation campaign")
 }
@@ -0,0 +1,50 @@
+package ignitecmd
+
+import (
+	"github.com/spf13/cobra"
+)
+
+// NewChainState creates a new command to manage named chain states.
+func NewChainState() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "state [command]",
+		Short: "Save and restore named chain states",
+		Long: `Commands in this namespace let you save the current state of your blockchain
+using a name and restore it later. This is useful to go back to a known state,
+for example the state after a governance proposal passed, instead of replaying
+the transactions needed to reach it.
+
+A state is saved by exporting the genesis of the chain, the chain must not be
+running while a state is saved:
+
+	ignite chain state save after-gov-proposal
+
+States are stored in the Ignite data directory of the chain together with
+metadata like the height of the exported state, the app version and the source
+code commit hash. To list all the saved states:
+
+	ignite chain state list
+
+To restore a state, the database of the chain is reset and the exported genesis
+of the state is used as the genesis of the chain:
+
+	ignite chain state restore after-gov-proposal
+
+A state can also be restored when the chain is served:
+
+	ignite chain serve --from-state after-gov-proposal
+`,
+		Args: cobra.ExactArgs(1),
+	}
+
+	flagSetPath(c)
+	c.PersistentFlags().AddFlagSet(flagSetHome())
+
+	c.AddCommand(
+		NewChainStateSave(),
+		NewChainStateList(),
+		NewChainStateRestore(),
+	)
+
+	return c
+}
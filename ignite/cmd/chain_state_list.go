@@ -0,0 +1,60 @@
+package ignitecmd
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/ignite/cli/v29/ignite/pkg/cliui"
+	"github.com/ignite/cli/v29/ignite/pkg/cliui/entrywriter"
+)
+
+// NewChainStateList creates a new command to list the saved chain states.
+func NewChainStateList() *cobra.Command {
+	return &cobra.Command{
+		Use:   "list",
+		Short: "List the saved chain states",
+		Args:  cobra.NoArgs,
+		RunE:  chainStateListHandler,
+	}
+}
+
+func chainStateListHandler(cmd *cobra.Command, _ []string) error {
+	session := cliui.New()
+	defer session.End()
+
+	c, err := newChainWithStateFlags(cmd, session)
+	if err != nil {
+		return err
+	}
+
+	states, err := c.States()
+	if err != nil {
+		return err
+	}
+
+	if len(states) == 0 {
+		return session.Println("No chain states saved")
+	}
+
+	entries := make([][]string, 0, len(states))
+	for _, s := range states {
+		entries = append(entries, []string{
+			s.Name,
+			strconv.FormatInt(s.Height, 10),
+			valueOrNone(s.AppVersion),
+			valueOrNone(s.SourceHash),
+			s.CreatedAt.Local().Format(time.DateTime),
+		})
+	}
+
+	return session.PrintTable([]string{"Name", "Height", "App Version", "Source Hash", "Created"}, entries...)
+}
+
+func valueOrNone(v string) string {
+	if v == "" {
+		return entrywriter.None
+	}
+	return v
+}
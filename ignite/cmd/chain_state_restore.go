@@ -0,0 +1,48 @@
+package ignitecmd
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/ignite/cli/v29/ignite/pkg/cliui"
+	"github.com/ignite/cli/v29/ignite/pkg/cliui/icons"
+)
+
+// NewChainStateRestore creates a new command to restore a saved chain state.
+func NewChainStateRestore() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "restore [name]",
+		Short: "Restore a saved chain state",
+		Long: `Restore a saved chain state by resetting the database of the chain and using
+the exported genesis of the state as the chain genesis. The chain must be
+initialized and must not be running while the state is restored.
+`,
+		Args: cobra.ExactArgs(1),
+		RunE: chainStateRestoreHandler,
+	}
+
+	c.Flags().BoolP("verbose", "v", false, "verbose output")
+
+	return c
+}
+
+func chainStateRestoreHandler(cmd *cobra.Command, args []string) error {
+	session := cliui.New(
+		cliui.WithVerbosity(getVerbosity(cmd)),
+		cliui.StartSpinner(),
+	)
+	defer session.End()
+
+	c, err := newChainWithStateFlags(cmd, session)
+	if err != nil {
+		return err
+	}
+
+	session.StartSpinner("Restoring chain state...")
+
+	state, err := c.RestoreState(cmd.Context(), args[0])
+	if err != nil {
+		return err
+	}
+
+	return session.Printf("%s Chain state %q restored from height %d\n", icons.OK, state.Name, state.Height)
+}
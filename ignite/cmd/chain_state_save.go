@@ -0,0 +1,60 @@
+package ignitecmd
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/ignite/cli/v29/ignite/pkg/chaincmd"
+	"github.com/ignite/cli/v29/ignite/pkg/cliui"
+	"github.com/ignite/cli/v29/ignite/pkg/cliui/icons"
+	"github.com/ignite/cli/v29/ignite/services/chain"
+)
+
+// NewChainStateSave creates a new command to save the current chain state.
+func NewChainStateSave() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "save [name]",
+		Short: "Save the current chain state using a name",
+		Args:  cobra.ExactArgs(1),
+		RunE:  chainStateSaveHandler,
+	}
+
+	c.Flags().BoolP("verbose", "v", false, "verbose output")
+
+	return c
+}
+
+func chainStateSaveHandler(cmd *cobra.Command, args []string) error {
+	session := cliui.New(
+		cliui.WithVerbosity(getVerbosity(cmd)),
+		cliui.StartSpinner(),
+	)
+	defer session.End()
+
+	c, err := newChainWithStateFlags(cmd, session)
+	if err != nil {
+		return err
+	}
+
+	session.StartSpinner("Exporting chain state...")
+
+	state, err := c.SaveState(cmd.Context(), args[0])
+	if err != nil {
+		return err
+	}
+
+	return session.Printf("%s Chain state %q saved at height %d\n", icons.OK, state.Name, state.Height)
+}
+
+func newChainWithStateFlags(cmd *cobra.Command, session *cliui.Session) (*chain.Chain, error) {
+	chainOption := []chain.Option{
+		chain.KeyringBackend(chaincmd.KeyringBackendTest),
+		chain.WithOutputer(session),
+		chain.CollectEvents(session.EventBus()),
+	}
+
+	if config := getConfig(cmd); config != "" {
+		chainOption = append(chainOption, chain.ConfigFile(config))
+	}
+
+	return chain.NewWithHomeFlags(cmd, chainOption...)
+}
@@ -0,0 +1,158 @@
+package ignitecmd
+
+import (
+	"os"
+	"path/filepath"
+
+	"github.com/spf13/cobra"
+
+	"github.com/ignite/cli/v29/ignite/pkg/chaincmd"
+	"github.com/ignite/cli/v29/ignite/pkg/cliui"
+	"github.com/ignite/cli/v29/ignite/pkg/xgit"
+	"github.com/ignite/cli/v29/ignite/services/chain"
+)
+
+const (
+	flagUpgradeFrom         = "from"
+	flagUpgradeTo           = "to"
+	flagUpgradeName         = "upgrade-name"
+	flagUpgradeHeightOffset = "height-offset"
+	flagUpgradeVotingPeriod = "voting-period"
+)
+
+// NewChainUpgradeTest creates a new command to rehearse an in-place chain upgrade.
+func NewChainUpgradeTest() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "upgrade-test",
+		Short: "Rehearse an in-place upgrade between two versions of the chain",
+		Long: `The upgrade-test command rehearses an in-place upgrade of the chain locally.
+
+The binaries of the version to upgrade from and the version to upgrade to are
+built. The version to upgrade from is initialized like when the chain is served
+and its validator nodes are started. A software upgrade proposal is submitted
+and voted using the validator accounts defined in the config. When the chain
+halts at the upgrade height, the nodes are restarted with the binary of the
+version to upgrade to, and the upgrade succeeds when the chain keeps producing
+blocks:
+
+	ignite chain upgrade-test --from v1.0.0 --upgrade-name v2
+
+The version to upgrade from is a git ref of the app repository. The version to
+upgrade to is, by default, the current state of the app directory. It can also
+be a git ref or the path to another working tree of the app:
+
+	ignite chain upgrade-test --from v1.0.0 --to v2.0.0 --upgrade-name v2
+
+The upgrade name must match the name of an upgrade handler registered by the
+version to upgrade to, like the ones created by "ignite scaffold upgrade".
+
+The chain is initialized in a temporary directory, which is kept when the
+upgrade fails to be able to inspect the node data.
+`,
+		Args: cobra.NoArgs,
+		RunE: chainUpgradeTestHandler,
+	}
+
+	flagSetPath(c)
+	flagSetClearCache(c)
+	c.Flags().AddFlagSet(flagSetProfile())
+	c.Flags().String(flagUpgradeFrom, "", "git ref of the chain version to upgrade from")
+	c.Flags().String(flagUpgradeTo, "", "git ref or directory of the chain version to upgrade to (default: the app path)")
+	c.Flags().String(flagUpgradeName, "", "name of the upgrade plan")
+	c.Flags().Int64(flagUpgradeHeightOffset, chain.DefaultUpgradeHeightOffset, "number of blocks between the upgrade proposal and the upgrade height")
+	c.Flags().Duration(flagUpgradeVotingPeriod, chain.DefaultUpgradeVotingPeriod, "voting period of the upgrade proposal")
+	c.Flags().StringSlice(flagBuildTags, []string{}, "parameters to build the chain binaries")
+
+	_ = c.MarkFlagRequired(flagUpgradeFrom)
+	_ = c.MarkFlagRequired(flagUpgradeName)
+
+	return c
+}
+
+func chainUpgradeTestHandler(cmd *cobra.Command, _ []string) error {
+	var (
+		appPath         = flagGetPath(cmd)
+		from, _         = cmd.Flags().GetString(flagUpgradeFrom)
+		to, _           = cmd.Flags().GetString(flagUpgradeTo)
+		name, _         = cmd.Flags().GetString(flagUpgradeName)
+		heightOffset, _ = cmd.Flags().GetInt64(flagUpgradeHeightOffset)
+		votingPeriod, _ = cmd.Flags().GetDuration(flagUpgradeVotingPeriod)
+		buildTags, _    = cmd.Flags().GetStringSlice(flagBuildTags)
+		session         = cliui.New(cliui.WithVerbosity(getVerbosity(cmd)), cliui.StartSpinner())
+		ctx             = cmd.Context()
+		upgradeOptions  = []chain.UpgradeOption{
+			chain.UpgradeHeightOffset(heightOffset),
+			chain.UpgradeVotingPeriod(votingPeriod),
+			chain.UpgradeBuildTags(buildTags...),
+		}
+	)
+	defer session.End()
+
+	workDir, err := os.MkdirTemp("", "ignite-upgrade-test")
+	if err != nil {
+		return err
+	}
+
+	// The work directory is only kept when the upgrade rehearsal fails
+	keepWorkDir := false
+	defer func() {
+		if !keepWorkDir {
+			os.RemoveAll(workDir)
+		}
+	}()
+
+	session.StartSpinner("Checking out the chain versions...")
+
+	fromPath, err := xgit.CloneRef(ctx, appPath, from, filepath.Join(workDir, "src", "from"))
+	if err != nil {
+		return err
+	}
+
+	// The version to upgrade to is a directory when it exists, otherwise a git ref
+	toPath := appPath
+	if to != "" {
+		if info, statErr := os.Stat(to); statErr == nil && info.IsDir() {
+			toPath = to
+		} else if toPath, err = xgit.CloneRef(ctx, appPath, to, filepath.Join(workDir, "src", "to")); err != nil {
+			return err
+		}
+	}
+
+	chainOption := []chain.Option{
+		chain.KeyringBackend(chaincmd.KeyringBackendTest),
+		chain.WithOutputer(session),
+		chain.CollectEvents(session.EventBus()),
+	}
+
+	if profile := getProfile(cmd); profile != "" {
+		chainOption = append(chainOption, chain.ConfigProfile(profile))
+	}
+
+	fromChain, err := chain.New(fromPath, chainOption...)
+	if err != nil {
+		return err
+	}
+
+	toPath, err = filepath.Abs(toPath)
+	if err != nil {
+		return err
+	}
+
+	toChain, err := chain.New(toPath, chainOption...)
+	if err != nil {
+		return err
+	}
+
+	cacheStorage, err := newCache(cmd)
+	if err != nil {
+		return err
+	}
+
+	if err := toChain.RehearseUpgrade(ctx, cacheStorage, fromChain, name, workDir, upgradeOptions...); err != nil {
+		keepWorkDir = true
+		session.Printf("The upgrade rehearsal data was kept in %s\n", workDir)
+		return err
+	}
+
+	return session.Printf("🎉 Upgrade %s was successfully rehearsed.\n", name)
+}
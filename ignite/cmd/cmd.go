@@ -37,6 +37,7 @@ const (
 	flagYes        = "yes"
 	flagClearCache = "clear-cache"
 	flagSkipProto  = "skip-proto"
+	flagProfile    = "profile"
 
 	checkVersionTimeout = time.Millisecond * 600
 	cacheFileName       = "ignite_cache.db"
@@ -165,6 +166,17 @@ func getConfig(cmd *cobra.Command) (config string) {
 	return
 }
 
+func flagSetProfile() *flag.FlagSet {
+	fs := flag.NewFlagSet("", flag.ContinueOnError)
+	fs.String(flagProfile, "", "config profile defined in the config file or in a config.<profile>.yml file")
+	return fs
+}
+
+func getProfile(cmd *cobra.Command) (profile string) {
+	profile, _ = cmd.Flags().GetString(flagProfile)
+	return
+}
+
 func getChainConfig(cmd *cobra.Command) (*chainconfig.Config, string, error) {
 	cfg, ok := cmd.Context().Value(keyChainConfig).(*chainconfig.Config)
 	if ok {
@@ -184,7 +196,7 @@ func getChainConfig(cmd *cobra.Command) (*chainconfig.Config, string, error) {
 		}
 	}
 
-	cfg, err = chainconfig.ParseFile(configPath)
+	cfg, err = chainconfig.ParseFile(configPath, chainconfig.WithProfile(getProfile(cmd)))
 	if err != nil {
 		return nil, "", err
 	}
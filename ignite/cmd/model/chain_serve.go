@@ -27,6 +27,7 @@ const (
 var (
 	msgStopServe  = colors.Faint("Press the 'q' key to stop serve")
 	msgWaitingFix = colors.Info("Waiting for a fix before retrying...")
+	msgCrashed    = "Blockchain crashed"
 )
 
 type Context interface {
@@ -60,9 +61,10 @@ type ChainServe struct {
 	cmd  tea.Cmd
 	quit context.CancelFunc
 
-	state  uint  // Keeps track of the model/view being displayed
-	broken bool  // True when blockchain app's source code has issues
-	error  error // Critical error returned during command execution
+	state   uint  // Keeps track of the model/view being displayed
+	broken  bool  // True when blockchain app's source code has issues
+	crashed bool  // True when a blockchain node keeps crashing after being restarted
+	error   error // Critical error returned during command execution
 
 	// Model definitions for the chain serve views
 	startModel   cliuimodel.StatusEvents
@@ -163,7 +165,10 @@ func (m ChainServe) processKeyMsg(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 func (m ChainServe) processEventMsg(msg cliuimodel.EventMsg) (tea.Model, tea.Cmd) {
 	// When an error event is received it means there is an issue with
 	// the blockchain app's source code that the user must fix.
-	m.broken = msg.Group == events.GroupError
+	// A crash event means that a node keeps crashing at runtime, in
+	// which case the event contains the panic trace of the node.
+	m.crashed = msg.Group == events.GroupCrash
+	m.broken = msg.Group == events.GroupError || m.crashed
 
 	// UI responds to key press or mouse events by default but we use
 	// events and the events bus to interact with the UI during execution.
@@ -221,7 +226,9 @@ func (m ChainServe) renderStartView() string {
 func (m ChainServe) renderRunView() string {
 	var view strings.Builder
 
-	if !m.broken {
+	if m.crashed {
+		fmt.Fprintf(&view, "%s %s\n\n", icons.NotOK, msgCrashed)
+	} else if !m.broken {
 		view.WriteString("Blockchain is running\n\n")
 	}
 
@@ -237,7 +244,9 @@ func (m ChainServe) renderRunView() string {
 func (m ChainServe) renderRebuildView() string {
 	var view strings.Builder
 
-	if !m.broken {
+	if m.crashed {
+		fmt.Fprintf(&view, "%s %s\n\n", icons.NotOK, msgCrashed)
+	} else if !m.broken {
 		view.WriteString("Changes detected, restarting...\n\n")
 	}
 
@@ -124,6 +124,34 @@ func TestChainServeRunBrokenView(t *testing.T) {
 	require.Equal(t, want, view)
 }
 
+func TestChainServeRunCrashedView(t *testing.T) {
+	// Arrange
+	var model tea.Model
+
+	model = cmdmodel.NewChainServe(testdata.ModelContext{}, testdata.DummyEventsProvider{}, testdata.FooCmd)
+	trace := "panic: runtime error\n\ngoroutine 1 [running]:"
+	waitingFix := colors.Info("Waiting for a fix before retrying...")
+
+	want := fmt.Sprintf("%s Blockchain crashed\n\n%s\n\n%s\n\n%s\n", icons.NotOK, trace, waitingFix, chainServeActions)
+	want = cliuimodel.FormatView(want)
+
+	// Arrange: Update model to display the run view
+	model, _ = model.Update(cliuimodel.EventMsg{
+		Event: events.New("Run", events.ProgressFinish()),
+	})
+
+	// Arrange: Update model to display the panic trace within the run view
+	model, _ = model.Update(cliuimodel.EventMsg{
+		Event: events.New(trace, events.Group(events.GroupCrash)),
+	})
+
+	// Act
+	view := model.View()
+
+	// Assert
+	require.Equal(t, want, view)
+}
+
 func TestChainServeRebuildView(t *testing.T) {
 	// Arrange
 	var model tea.Model
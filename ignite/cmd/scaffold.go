@@ -21,6 +21,11 @@ const (
 	flagResponse     = "response"
 	flagDescription  = "desc"
 	flagProtoDir     = "proto-dir"
+	flagEvents       = "events"
+
+	flagAuthority       = "authority"
+	flagImmutableFields = "immutable-fields"
+	flagStatusField     = "status-field"
 
 	msgCommitPrefix = "Your saved project changes have not been committed.\nTo enable reverting to your current state, commit your saved changes."
 	msgCommitPrompt = "Do you want to proceed without committing your saved changes"
@@ -30,21 +35,35 @@ const (
 	supportFieldTypes = `
 Currently supports: 
 
-| Type         | Alias   | Index | Code Type | Description                     |
-|--------------|---------|-------|-----------|---------------------------------|
-| string       | -       | yes   | string    | Text type                       |
-| array.string | strings | no    | []string  | List of text type               |
-| bool         | -       | yes   | bool      | Boolean type                    |
-| int          | -       | yes   | int32     | Integer type                    |
-| array.int    | ints    | no    | []int32   | List of integers types          |
-| uint         | -       | yes   | uint64    | Unsigned integer type           |
-| array.uint   | uints   | no    | []uint64  | List of unsigned integers types |
-| coin         | -       | no    | sdk.Coin  | Cosmos SDK coin type            |
-| array.coin   | coins   | no    | sdk.Coins | List of Cosmos SDK coin types   |
+| Type          | Alias   | Index | Code Type      | Description                       |
+|---------------|---------|-------|----------------|-----------------------------------|
+| string        | -       | yes   | string         | Text type                         |
+| array.string  | strings | no    | []string       | List of text type                 |
+| bool          | -       | yes   | bool           | Boolean type                      |
+| int           | -       | yes   | int32          | Integer type                      |
+| array.int     | ints    | no    | []int32        | List of integers types            |
+| uint          | -       | yes   | uint64         | Unsigned integer type             |
+| array.uint    | uints   | no    | []uint64       | List of unsigned integers types   |
+| coin          | -       | no    | sdk.Coin       | Cosmos SDK coin type              |
+| array.coin    | coins   | no    | sdk.Coins      | List of Cosmos SDK coin types     |
+| address       | -       | no    | string         | Account address validated bech32  |
+| dec           | -       | no    | math.LegacyDec | Cosmos SDK decimal type           |
+| math.int      | -       | no    | math.Int       | Cosmos SDK arbitrary size integer |
+| timestamp     | -       | no    | time.Time      | Timestamp type                    |
+| duration      | -       | no    | time.Duration  | Duration type                     |
+| bytes         | -       | no    | []byte         | Bytes type                        |
+| enum          | -       | no    | proto enum     | Enum type with the given values   |
+| custom        | -       | no    | *MyType        | Custom type                       |
+| []custom      | -       | no    | []*MyType      | List of custom types              |
+| map<k,custom> | -       | no    | map[k]*MyType  | Map of custom types               |
 
 Field Usage:
     - fieldName
     - fieldName:fieldType
+    - fieldName:enum:ValueA|ValueB
+    - fieldName:MyType or fieldName:MyType.Nested
+    - fieldName:[]MyType
+    - fieldName:map<string,MyType>
 
 If no :fieldType, default (string) is used
 `
@@ -95,6 +114,10 @@ add, delete, and fetch the data from the store. If you want to scaffold only the
 logic, for example, you've decided to scaffold messages separately, you can do
 that as well with the "--no-message" flag.
 
+The actions performed by a module can be notified to indexers and clients with
+typed events. The event scaffolding command defines a new typed event and the
+keeper method to emit it.
+
 Reading data from a blockchain happens with a help of queries. Similar to how
 you can scaffold messages to write data, you can scaffold queries to read the
 data back from your blockchain application.
@@ -123,8 +146,10 @@ with an "--ibc" flag. Note that the default module is not IBC-enabled.
 		NewScaffoldParams(),
 		NewScaffoldConfigs(),
 		NewScaffoldMessage(),
+		NewScaffoldEvent(),
 		NewScaffoldQuery(),
 		NewScaffoldPacket(),
+		NewScaffoldUpgrade(),
 		NewScaffoldVue(),
 		NewScaffoldReact(),
 	)
@@ -170,6 +195,7 @@ func scaffoldType(
 	cmd *cobra.Command,
 	args []string,
 	kind scaffolder.AddTypeKind,
+	options ...scaffolder.AddTypeOption,
 ) error {
 	var (
 		typeName          = args[0]
@@ -181,8 +207,6 @@ func scaffoldType(
 		appPath           = flagGetPath(cmd)
 	)
 
-	var options []scaffolder.AddTypeOption
-
 	if len(fields) > 0 {
 		options = append(options, scaffolder.TypeWithFields(fields...))
 	}
@@ -286,6 +310,34 @@ func flagSetScaffoldType() *flag.FlagSet {
 	return f
 }
 
+func flagSetTypeRules() *flag.FlagSet {
+	f := flag.NewFlagSet("", flag.ContinueOnError)
+	f.String(flagAuthority, "creator", "who can create, update and delete the values (creator, anyone, gov, module-admin)")
+	f.StringSlice(flagImmutableFields, []string{}, "fields that can't be updated")
+	f.String(flagStatusField, "", "status field with its allowed transitions (e.g. status:draft->active->closed)")
+	return f
+}
+
+// flagGetTypeRules returns the options of the authority, immutable fields and status field flags.
+func flagGetTypeRules(cmd *cobra.Command) []scaffolder.AddTypeOption {
+	var (
+		authority, _       = cmd.Flags().GetString(flagAuthority)
+		immutableFields, _ = cmd.Flags().GetStringSlice(flagImmutableFields)
+		statusField, _     = cmd.Flags().GetString(flagStatusField)
+		options            []scaffolder.AddTypeOption
+	)
+	if authority != "" {
+		options = append(options, scaffolder.TypeWithAuthority(authority))
+	}
+	if len(immutableFields) > 0 {
+		options = append(options, scaffolder.TypeWithImmutableFields(immutableFields...))
+	}
+	if statusField != "" {
+		options = append(options, scaffolder.TypeWithStatusField(statusField))
+	}
+	return options
+}
+
 func flagGetModule(cmd *cobra.Command) string {
 	module, _ := cmd.Flags().GetString(flagModule)
 	return module
@@ -301,6 +353,11 @@ func flagGetNoMessage(cmd *cobra.Command) bool {
 	return noMessage
 }
 
+func flagGetEvents(cmd *cobra.Command) bool {
+	events, _ := cmd.Flags().GetBool(flagEvents)
+	return events
+}
+
 func flagGetSigner(cmd *cobra.Command) string {
 	signer, _ := cmd.Flags().GetString(flagSigner)
 	return signer
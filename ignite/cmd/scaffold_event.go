@@ -0,0 +1,98 @@
+package ignitecmd
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/ignite/cli/v29/ignite/pkg/cliui"
+	"github.com/ignite/cli/v29/ignite/services/scaffolder"
+)
+
+// NewScaffoldEvent returns the command to scaffold typed events.
+func NewScaffoldEvent() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "event NAME [field]...",
+		Short: "Typed event emitted by a module",
+		Long: `Scaffold a new typed event for a Cosmos SDK module.
+
+Events are emitted when transactions are processed to notify indexers, clients
+and other off-chain services about the actions performed by a module. Typed
+events are defined as proto messages, so they can be decoded back into Go types.
+
+	ignite scaffold event post-liked post-id:uint liker:address --module blog
+
+The command above will add an "EventPostLiked" proto message to the
+"proto/{app}/blog/events.proto" file and an "EmitPostLikedEvent" method to the
+keeper of the "blog" module, which emits the event with "EmitTypedEvent":
+
+	err := k.EmitPostLikedEvent(ctx, &types.EventPostLiked{PostId: id, Liker: msg.Creator})
+
+Events support fields with standard and custom types like messages. See
+"ignite scaffold list --help" for details.
+
+The messages scaffolded by "ignite scaffold list", "ignite scaffold map" and
+"ignite scaffold message" can also emit typed events with the "--events" flag.
+`,
+		Args:    cobra.MinimumNArgs(1),
+		PreRunE: migrationPreRunHandler,
+		RunE:    scaffoldEventHandler,
+	}
+
+	flagSetPath(c)
+	flagSetClearCache(c)
+
+	c.Flags().AddFlagSet(flagSetYes())
+	c.Flags().String(flagModule, "", "module to add the event into. Default: app's main module")
+
+	return c
+}
+
+func scaffoldEventHandler(cmd *cobra.Command, args []string) error {
+	var (
+		eventName  = args[0]
+		fields     = args[1:]
+		appPath    = flagGetPath(cmd)
+		moduleName = flagGetModule(cmd)
+	)
+
+	session := cliui.New(cliui.StartSpinnerWithText(statusScaffolding))
+	defer session.End()
+
+	cfg, _, err := getChainConfig(cmd)
+	if err != nil {
+		return err
+	}
+
+	cacheStorage, err := newCache(cmd)
+	if err != nil {
+		return err
+	}
+
+	sc, err := scaffolder.New(cmd.Context(), appPath, cfg.Build.Proto.Path)
+	if err != nil {
+		return err
+	}
+
+	err = sc.AddEvent(cmd.Context(), moduleName, eventName, fields...)
+	if err != nil {
+		return err
+	}
+
+	sm, err := sc.ApplyModifications()
+	if err != nil {
+		return err
+	}
+
+	if err := sc.PostScaffold(cmd.Context(), cacheStorage, false); err != nil {
+		return err
+	}
+
+	modificationsStr, err := sm.String()
+	if err != nil {
+		return err
+	}
+
+	session.Println(modificationsStr)
+	session.Printf("\n🎉 Created an event `%[1]v`.\n\n", eventName)
+
+	return nil
+}
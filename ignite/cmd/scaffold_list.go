@@ -51,10 +51,18 @@ business logic you will implement next.
 By default, all fields are assumed to be strings. If you want a field of a
 different type, you can specify it after a colon ":". The following types are
 supported: string, bool, int, uint, coin, array.string, array.int, array.uint,
-array.coin. An example of using field types:
+array.coin, address, dec, math.int, timestamp, duration, bytes and enum. An
+example of using field types:
 
 	ignite scaffold list pool amount:coin tags:array.string height:int
 
+Enum fields define their values after the type, separated by "|":
+
+	ignite scaffold list pool owner:address fee:dec 'status:enum:Active|Closed'
+
+The enum type is named after the type and the field, e.g. "PoolStatus" with the
+"POOL_STATUS_ACTIVE" and "POOL_STATUS_CLOSED" values.
+
 For detailed type information use ignite scaffold type --help
 
 "Index" indicates whether the type can be used as an index in
@@ -66,13 +74,20 @@ Ignite also supports custom types:
 	ignite scaffold list product price:coin details:ProductDetails
 
 In the example above the "ProductDetails" type was defined first, and then used
-as a custom type for the "details" field. Ignite doesn't support arrays of
-custom types yet.
+as a custom type for the "details" field. Arrays and maps of custom types, and
+custom types nested in other messages are supported too:
+
+	ignite scaffold list catalog 'products:[]ProductDetails' 'stock:map<string,ProductDetails>'
+	ignite scaffold list review details:ProductDetails.Rating
 
-Your chain will accept custom types in JSON-notation:
+The keys of the maps can be string, int or uint. Your chain will accept custom
+types in JSON-notation:
 
 	exampled tx example create-product 100coin '{"name": "x", "desc": "y"}' --from alice
 
+The genesis validation of the scaffolded type calls the "Validate" method of its
+custom types when they define one, so nested types are validated recursively.
+
 By default the code will be scaffolded in the module that matches your project's
 name. If you have several modules in your project, you might want to specify a
 different module:
@@ -93,6 +108,33 @@ like to scaffold messages manually. Use a flag to skip message scaffolding:
 
 The "creator" field is not generated if a list is scaffolded with the
 "--no-message" flag.
+
+By default, anyone can create a value, which can only be updated and deleted by
+its creator. Use the "--authority" flag to allow anyone to update and delete any
+value, or to only allow the module authority (usually the gov module account) or
+the admin defined in the module params to create, update and delete values:
+
+	ignite scaffold list post title body --authority module-admin
+
+The "admin" param is added to the module params if it doesn't exist. Messages
+restricted to the gov or module admin authorities are not simulated.
+
+Fields can be made immutable so they can't be updated after the value is
+created. A status field can define the states of the values, which can only
+change from a state to the next one. The status of the new values is the first
+state and the status field is added to the fields of the type when missing:
+
+	ignite scaffold list post title body --immutable-fields title --status-field 'status:draft->active->closed'
+
+The validation of these rules is defined in "x/{moduleName}/types/rules_{name}.go"
+and is called by the message handlers.
+
+Use the "--events" flag to emit typed events when the values are created,
+updated or deleted. The "Event{Name}Created", "Event{Name}Updated" and
+"Event{Name}Deleted" events are defined with the type and contain the signer of
+the message and the fields of the value:
+
+	ignite scaffold list post title body --events
 `,
 		Args:    cobra.MinimumNArgs(1),
 		PreRunE: migrationPreRunHandler,
@@ -104,10 +146,16 @@ The "creator" field is not generated if a list is scaffolded with the
 
 	c.Flags().AddFlagSet(flagSetYes())
 	c.Flags().AddFlagSet(flagSetScaffoldType())
+	c.Flags().AddFlagSet(flagSetTypeRules())
+	c.Flags().Bool(flagEvents, false, "emit typed events when the values are created, updated or deleted")
 
 	return c
 }
 
 func scaffoldListHandler(cmd *cobra.Command, args []string) error {
-	return scaffoldType(cmd, args, scaffolder.ListType())
+	options := flagGetTypeRules(cmd)
+	if flagGetEvents(cmd) {
+		options = append(options, scaffolder.TypeWithEvents())
+	}
+	return scaffoldType(cmd, args, scaffolder.ListType(), options...)
 }
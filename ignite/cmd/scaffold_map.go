@@ -7,7 +7,8 @@ import (
 )
 
 const (
-	FlagIndexes = "index"
+	FlagIndexes          = "index"
+	FlagSecondaryIndexes = "secondary-index"
 )
 
 // NewScaffoldMap returns a new command to scaffold a map.
@@ -51,9 +52,27 @@ With this command, you would get a "Product" value indexed by both a category
 and a GUID (globally unique ID). This will let you programmatically fetch
 product values that have the same category but are using different GUIDs.
 
+To look up values by other fields than the index, use the "--secondary-index"
+flag. Secondary indexes can be added to fields of type string, address, bool,
+int and uint, or to the signer of the messages:
+
+	ignite scaffold map product price owner:address status --secondary-index owner,status
+
+For each secondary index, this command adds a keeper method that returns the
+values matching a field, as well as a paginated query, a REST route and a CLI
+command to list them:
+
+	blogd q blog list-product-by-owner [owner]
+
+The secondary indexes are kept up to date when values are created, updated,
+deleted or imported from the genesis.
+
 Since the behavior of "list" and "map" scaffolding is very similar, you can use
-the "--no-message", "--module", "--signer" flags as well as the colon syntax for
-custom types.
+the "--no-message", "--module", "--signer", "--authority", "--immutable-fields",
+"--status-field" and "--events" flags as well as the colon syntax for custom
+types:
+
+	ignite scaffold map product price --authority gov --status-field 'status:draft->active->closed'
 
 For detailed type information use ignite scaffold type --help
 `,
@@ -67,12 +86,25 @@ For detailed type information use ignite scaffold type --help
 
 	c.Flags().AddFlagSet(flagSetYes())
 	c.Flags().AddFlagSet(flagSetScaffoldType())
+	c.Flags().AddFlagSet(flagSetTypeRules())
+	c.Flags().Bool(flagEvents, false, "emit typed events when the values are created, updated or deleted")
 	c.Flags().StringSlice(FlagIndexes, []string{"index"}, "fields that index the value")
+	c.Flags().StringSlice(FlagSecondaryIndexes, []string{}, "fields used as secondary indexes to look up the values")
 
 	return c
 }
 
 func scaffoldMapHandler(cmd *cobra.Command, args []string) error {
-	indexes, _ := cmd.Flags().GetStringSlice(FlagIndexes)
-	return scaffoldType(cmd, args, scaffolder.MapType(indexes...))
+	var (
+		indexes, _          = cmd.Flags().GetStringSlice(FlagIndexes)
+		secondaryIndexes, _ = cmd.Flags().GetStringSlice(FlagSecondaryIndexes)
+		options             = flagGetTypeRules(cmd)
+	)
+	if len(secondaryIndexes) > 0 {
+		options = append(options, scaffolder.TypeWithSecondaryIndexes(secondaryIndexes...))
+	}
+	if flagGetEvents(cmd) {
+		options = append(options, scaffolder.TypeWithEvents())
+	}
+	return scaffoldType(cmd, args, scaffolder.MapType(indexes...), options...)
 }
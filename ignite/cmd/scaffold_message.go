@@ -56,6 +56,11 @@ specify response fields and their types. For example
 The command above will scaffold MsgCreatePost which returns both an ID (an
 integer) and a title (a string).
 
+Use the "--events" flag to define an "Event{Name}" typed event with the signer
+and the fields of the message, which is emitted when the message is handled:
+
+	ignite scaffold message add-pool amount:coins denom --events
+
 Message scaffolding follows the rules as "ignite scaffold list/map/single" and
 supports fields with standard and custom types. See "ignite scaffold list —help"
 for details.
@@ -74,6 +79,7 @@ for details.
 	c.Flags().Bool(flagNoSimulation, false, "disable CRUD simulation scaffolding")
 	c.Flags().StringP(flagDescription, "d", "", "description of the command")
 	c.Flags().String(flagSigner, "", "label for the message signer (default: creator)")
+	c.Flags().Bool(flagEvents, false, "emit a typed event with the fields of the message")
 
 	return c
 }
@@ -86,6 +92,7 @@ func messageHandler(cmd *cobra.Command, args []string) error {
 		signer            = flagGetSigner(cmd)
 		appPath           = flagGetPath(cmd)
 		withoutSimulation = flagGetNoSimulation(cmd)
+		withEvents        = flagGetEvents(cmd)
 	)
 
 	session := cliui.New(cliui.StartSpinnerWithText(statusScaffolding))
@@ -118,6 +125,11 @@ func messageHandler(cmd *cobra.Command, args []string) error {
 		options = append(options, scaffolder.WithoutSimulation())
 	}
 
+	// Emit a typed event
+	if withEvents {
+		options = append(options, scaffolder.WithEvents())
+	}
+
 	sc, err := scaffolder.New(cmd.Context(), appPath, cfg.Build.Proto.Path)
 	if err != nil {
 		return err
@@ -0,0 +1,108 @@
+package ignitecmd
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/ignite/cli/v29/ignite/pkg/cliui"
+	"github.com/ignite/cli/v29/ignite/services/scaffolder"
+)
+
+const (
+	flagAddStore    = "add-store"
+	flagDeleteStore = "delete-store"
+	flagMigrate     = "migrate"
+)
+
+// NewScaffoldUpgrade returns the command to scaffold a chain upgrade.
+func NewScaffoldUpgrade() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "upgrade [name]",
+		Short: "Chain upgrade with store upgrades and module migrations",
+		Long: `Scaffold the handler of a chain upgrade and register it in the app.
+
+The upgrade is applied when a software upgrade plan with the same name is
+reached. The upgrade handler runs the in-place store migrations of the modules
+whose consensus version changed:
+
+  ignite scaffold upgrade v2
+
+Stores of new modules must be added by the upgrade, and stores of removed modules
+must be deleted:
+
+  ignite scaffold upgrade v2 --add-store blog --delete-store crisis
+
+To migrate the store of a module use the "--migrate" flag with the current
+consensus version of the module. The consensus version of the module is bumped,
+and a stub of the migration is added to the migrator of the module keeper:
+
+  ignite scaffold upgrade v2 --migrate blog:1
+
+The migration of the store must then be implemented in the
+"x/blog/keeper/migrations.go" file.
+`,
+		Args:    cobra.ExactArgs(1),
+		PreRunE: migrationPreRunHandler,
+		RunE:    scaffoldUpgradeHandler,
+	}
+
+	flagSetPath(c)
+	flagSetClearCache(c)
+
+	c.Flags().AddFlagSet(flagSetYes())
+	c.Flags().StringSlice(flagAddStore, []string{}, "store keys of the modules added by the upgrade")
+	c.Flags().StringSlice(flagDeleteStore, []string{}, "store keys of the modules deleted by the upgrade")
+	c.Flags().StringSlice(flagMigrate, []string{}, "module store migrations in module:fromVersion format")
+
+	return c
+}
+
+func scaffoldUpgradeHandler(cmd *cobra.Command, args []string) error {
+	var (
+		name            = args[0]
+		appPath         = flagGetPath(cmd)
+		addStores, _    = cmd.Flags().GetStringSlice(flagAddStore)
+		deleteStores, _ = cmd.Flags().GetStringSlice(flagDeleteStore)
+		migrations, _   = cmd.Flags().GetStringSlice(flagMigrate)
+	)
+
+	session := cliui.New(cliui.StartSpinnerWithText(statusScaffolding))
+	defer session.End()
+
+	cfg, _, err := getChainConfig(cmd)
+	if err != nil {
+		return err
+	}
+
+	cacheStorage, err := newCache(cmd)
+	if err != nil {
+		return err
+	}
+
+	sc, err := scaffolder.New(cmd.Context(), appPath, cfg.Build.Proto.Path)
+	if err != nil {
+		return err
+	}
+
+	if err := sc.AddUpgrade(name, addStores, deleteStores, migrations); err != nil {
+		return err
+	}
+
+	sm, err := sc.ApplyModifications()
+	if err != nil {
+		return err
+	}
+
+	if err := sc.PostScaffold(cmd.Context(), cacheStorage, true); err != nil {
+		return err
+	}
+
+	modificationsStr, err := sm.String()
+	if err != nil {
+		return err
+	}
+
+	session.Println(modificationsStr)
+	session.Printf("\n🎉 Created the upgrade `%[1]v`.\n\n", name)
+
+	return nil
+}
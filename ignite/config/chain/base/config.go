@@ -5,6 +5,7 @@ import (
 
 	"github.com/ignite/cli/v29/ignite/config/chain/defaults"
 	"github.com/ignite/cli/v29/ignite/config/chain/version"
+	"github.com/ignite/cli/v29/ignite/pkg/jsonpatch"
 	"github.com/ignite/cli/v29/ignite/pkg/xyaml"
 )
 
@@ -17,6 +18,57 @@ type Account struct {
 	CoinType      string   `yaml:"cointype,omitempty"`
 	AccountNumber string   `yaml:"account_number,omitempty"`
 	AddressIndex  string   `yaml:"address_index,omitempty"`
+
+	// Vesting optionally defines a vesting schedule for the account coins.
+	Vesting *Vesting `yaml:"vesting,omitempty"`
+
+	// Module is the name of a module to add the account as a module account.
+	// Module accounts have their address derived from the module name.
+	Module string `yaml:"module,omitempty"`
+}
+
+// VestingType defines the type of vesting schedule of an account.
+type VestingType string
+
+const (
+	// VestingContinuous vests the coins linearly between the start and the end time.
+	VestingContinuous VestingType = "continuous"
+
+	// VestingDelayed vests all the coins at the end time.
+	VestingDelayed VestingType = "delayed"
+
+	// VestingPeriodic vests the coins of each period at the end of the period.
+	VestingPeriodic VestingType = "periodic"
+)
+
+// Vesting defines the vesting schedule of an account.
+// Times are unix epoch timestamps in seconds.
+type Vesting struct {
+	// Type is the type of the vesting schedule.
+	Type VestingType `yaml:"type"`
+
+	// Coins are the vesting coins of continuous and delayed vesting accounts.
+	Coins []string `yaml:"coins,omitempty"`
+
+	// StartTime is the time when the vesting starts.
+	// It is required by continuous and periodic vesting accounts.
+	StartTime int64 `yaml:"start_time,omitempty"`
+
+	// EndTime is the time when the vesting ends.
+	// It is required by continuous and delayed vesting accounts.
+	EndTime int64 `yaml:"end_time,omitempty"`
+
+	// Periods are the vesting periods of periodic vesting accounts.
+	Periods []VestingPeriod `yaml:"periods,omitempty"`
+}
+
+// VestingPeriod defines a period of a periodic vesting schedule.
+type VestingPeriod struct {
+	// Length is the duration of the period in seconds.
+	Length int64 `yaml:"length"`
+
+	// Coins are the coins that vest at the end of the period.
+	Coins []string `yaml:"coins"`
 }
 
 // Build holds build configs.
@@ -109,6 +161,149 @@ type Faucet struct {
 	Port uint `yaml:"port,omitempty"`
 }
 
+// GenesisPatch defines a patch operation to apply to the genesis.
+type GenesisPatch struct {
+	// Op is the patch operation, which can be add, replace, remove, append or merge.
+	Op string `yaml:"op"`
+
+	// Path is the JSONPath of the genesis values to patch.
+	Path string `yaml:"path"`
+
+	// Value is the value used by the patch operation.
+	Value interface{} `yaml:"value,omitempty"`
+}
+
+// JSONPatch returns the patch to apply to the genesis JSON.
+func (p GenesisPatch) JSONPatch() jsonpatch.Patch {
+	return jsonpatch.Patch{
+		Op:    jsonpatch.Op(p.Op),
+		Path:  p.Path,
+		Value: p.Value,
+	}
+}
+
+// ProvisionTx defines a transaction to broadcast once the chain is started.
+// Each transaction must define exactly one kind of message.
+type ProvisionTx struct {
+	// Name describes the transaction in the serve status.
+	Name string `yaml:"name,omitempty"`
+
+	// From is the name of the config account that signs the transaction.
+	From string `yaml:"from"`
+
+	// BankSend sends tokens to an account.
+	BankSend *ProvisionBankSend `yaml:"bank_send,omitempty"`
+
+	// Delegate delegates tokens to a validator.
+	Delegate *ProvisionDelegate `yaml:"delegate,omitempty"`
+
+	// GovProposal submits a governance proposal.
+	GovProposal *ProvisionGovProposal `yaml:"gov_proposal,omitempty"`
+
+	// Messages are custom module messages using their proto JSON
+	// representation, including the message type in the "@type" key.
+	Messages []xyaml.Map `yaml:"messages,omitempty"`
+}
+
+// ProvisionBankSend defines a bank send transaction.
+type ProvisionBankSend struct {
+	// To is the name of a config account or an address.
+	To string `yaml:"to"`
+
+	// Amount is the list of coins to send.
+	Amount []string `yaml:"amount"`
+}
+
+// ProvisionDelegate defines a delegation transaction.
+type ProvisionDelegate struct {
+	// Validator is the name of a config validator or a validator operator address.
+	Validator string `yaml:"validator"`
+
+	// Amount is the amount of coins to delegate.
+	Amount string `yaml:"amount"`
+}
+
+// ProvisionGovProposal defines a governance proposal submission.
+type ProvisionGovProposal struct {
+	Title     string   `yaml:"title"`
+	Summary   string   `yaml:"summary"`
+	Metadata  string   `yaml:"metadata,omitempty"`
+	Deposit   []string `yaml:"deposit,omitempty"`
+	Expedited bool     `yaml:"expedited,omitempty"`
+
+	// Messages are the proposal messages using their proto JSON representation.
+	Messages []xyaml.Map `yaml:"messages,omitempty"`
+}
+
+// Release holds the configs used to build releases.
+type Release struct {
+	// Targets is the list of platforms to build a release for.
+	Targets []ReleaseTarget `yaml:"targets,omitempty"`
+
+	// Format is the archive format of the release binaries: "tar.gz", "tar.zst", "zip" or "raw".
+	Format string `yaml:"format,omitempty"`
+
+	// Name is the template used to name the release files. The template can
+	// use the {{.Prefix}}, {{.Version}}, {{.OS}} and {{.Arch}} values.
+	Name string `yaml:"name,omitempty"`
+
+	// Files is the list of extra files to bundle with the release binaries,
+	// for example a LICENSE or a genesis file, relative to the app directory.
+	Files []string `yaml:"files,omitempty"`
+}
+
+// ReleaseTarget defines a platform to build a release for.
+type ReleaseTarget struct {
+	// Target is the platform with the GOOS:GOARCH format.
+	Target string `yaml:"target"`
+
+	// LDFlags are additional linker flags used to build the target binary.
+	LDFlags []string `yaml:"ldflags,omitempty"`
+}
+
+// Lint holds the configs used to lint the chain.
+type Lint struct {
+	// Version is the golangci-lint version used to lint the Go code.
+	Version string `yaml:"version,omitempty"`
+
+	// Config is the path of the golangci-lint config file relative to the app directory.
+	Config string `yaml:"config,omitempty"`
+
+	// BreakingAgainst is the git ref used to check the proto files for breaking changes.
+	BreakingAgainst string `yaml:"breaking_against,omitempty"`
+
+	// Disable is the list of Cosmos analyzers that are not run.
+	Disable []string `yaml:"disable,omitempty"`
+}
+
+// Bench holds the configs used to benchmark the chain.
+type Bench struct {
+	// Accounts is the number of accounts that broadcast transactions concurrently.
+	Accounts int `yaml:"accounts,omitempty"`
+
+	// Coins are the coins given in genesis to each bench account.
+	Coins []string `yaml:"coins,omitempty"`
+
+	// Amount is the amount that the bank send transactions send.
+	Amount []string `yaml:"amount,omitempty"`
+
+	// Messages are message templates using their proto JSON representation, including
+	// the message type in the "@type" key, that are broadcasted instead of bank sends.
+	// The string values can use the {{ .Sender }}, {{ .Recipient }} and {{ .Seq }} placeholders.
+	Messages []xyaml.Map `yaml:"messages,omitempty"`
+}
+
+// Serve holds the configs used to serve the chain.
+type Serve struct {
+	// MaxRestarts is the number of times a crashed node is restarted within the
+	// restart window before it's considered to be crash looping. It defaults to 3.
+	MaxRestarts int `yaml:"max_restarts,omitempty"`
+
+	// RestartWindow is the time window used to detect crash loops using
+	// the Go duration format, for example "1m30s". It defaults to one minute.
+	RestartWindow string `yaml:"restart_window,omitempty"`
+}
+
 // Init overwrites sdk configurations with given values.
 type Init struct {
 	// App overwrites appd's config/app.toml configs.
@@ -152,11 +347,25 @@ type Config struct {
 	Validation Validation      `yaml:"validation,omitempty"`
 	Version    version.Version `yaml:"version"`
 	Build      Build           `yaml:"build,omitempty"`
+	Release    Release         `yaml:"release,omitempty"`
+	Lint       Lint            `yaml:"lint,omitempty"`
+	Bench      Bench           `yaml:"bench,omitempty"`
+	Serve      Serve           `yaml:"serve,omitempty"`
 	Accounts   []Account       `yaml:"accounts"`
 	Faucet     Faucet          `yaml:"faucet,omitempty"`
 	Client     Client          `yaml:"client,omitempty"`
 	Genesis    xyaml.Map       `yaml:"genesis,omitempty"`
 	Minimal    bool            `yaml:"minimal,omitempty"`
+
+	// GenesisPatches are applied in order to the genesis once the gentxs are collected.
+	GenesisPatches []GenesisPatch `yaml:"genesis_patches,omitempty"`
+
+	// Provision contains the transactions to broadcast in order once the chain is started.
+	Provision []ProvisionTx `yaml:"provision,omitempty"`
+
+	// Profiles are named sets of config values that are merged
+	// on top of the config when the profile is selected.
+	Profiles map[string]xyaml.Map `yaml:"profiles,omitempty"`
 }
 
 // GetVersion returns the config version.
@@ -58,6 +58,9 @@ type (
 
 	// Validator defines the latest validator settings.
 	Validator = v1.Validator
+
+	// GenesisValidator defines the latest genesis validator settings.
+	GenesisValidator = v1.GenesisValidator
 )
 
 // DefaultChainConfig returns a config for the latest version initialized with default values.
@@ -0,0 +1,165 @@
+package chain
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/ignite/cli/v29/ignite/config/chain/base"
+)
+
+// Changes describes the differences between two chain configs grouped
+// by the kind of action required to apply them to a running chain.
+type Changes struct {
+	// State is true when the changes can only be applied by resetting
+	// the chain state, for example when genesis or accounts change.
+	State bool
+
+	// Node is true when the node config files of a validator changed,
+	// which are the values written to app.toml, config.toml and client.toml.
+	Node bool
+
+	// Faucet is true when the faucet config changed.
+	Faucet bool
+
+	// Other is true when any other config value changed, for example
+	// the build or the client code generation configs.
+	Other bool
+}
+
+// IsEmpty returns true when there are no config changes.
+func (c Changes) IsEmpty() bool {
+	return !c.State && !c.Node && !c.Faucet && !c.Other
+}
+
+// IsFaucetOnly returns true when only the faucet config changed.
+func (c Changes) IsFaucetOnly() bool {
+	return c.Faucet && !c.State && !c.Node && !c.Other
+}
+
+// Checksums contains the checksum of each group of config values.
+// Checksums allow detecting config changes without keeping a copy of
+// the config values, which might contain secrets like mnemonics.
+type Checksums struct {
+	State  string
+	Node   string
+	Faucet string
+	Other  string
+}
+
+// Changes returns the changes between the config values of the checksums.
+func (c Checksums) Changes(to Checksums) Changes {
+	return Changes{
+		State:  c.State != to.State,
+		Node:   c.Node != to.Node,
+		Faucet: c.Faucet != to.Faucet,
+		Other:  c.Other != to.Other,
+	}
+}
+
+// ConfigChecksums returns the checksums of the config value groups.
+// Checksums are computed using the YAML representation of the values to avoid
+// reporting changes caused by different Go types representing the same YAML value.
+func ConfigChecksums(c *Config) (checksums Checksums, err error) {
+	if checksums.State, err = yamlChecksum(stateValues(c)); err != nil {
+		return Checksums{}, err
+	}
+
+	if checksums.Node, err = yamlChecksum(nodeValues(c)); err != nil {
+		return Checksums{}, err
+	}
+
+	if checksums.Faucet, err = yamlChecksum(c.Faucet); err != nil {
+		return Checksums{}, err
+	}
+
+	if checksums.Other, err = yamlChecksum(otherValues(c)); err != nil {
+		return Checksums{}, err
+	}
+
+	return checksums, nil
+}
+
+// Diff compares two configs and returns the changes between them.
+func Diff(from, to *Config) (Changes, error) {
+	fromChecksums, err := ConfigChecksums(from)
+	if err != nil {
+		return Changes{}, err
+	}
+
+	toChecksums, err := ConfigChecksums(to)
+	if err != nil {
+		return Changes{}, err
+	}
+
+	return fromChecksums.Changes(toChecksums), nil
+}
+
+// stateValues returns the config values that define the initial state of the chain.
+func stateValues(c *Config) interface{} {
+	validators := make([]Validator, len(c.Validators))
+	for i, v := range c.Validators {
+		v.App = nil
+		v.Config = nil
+		v.Client = nil
+		validators[i] = v
+	}
+
+	return struct {
+		Validation        base.Validation     `yaml:"validation"`
+		Accounts          []base.Account      `yaml:"accounts"`
+		Genesis           interface{}         `yaml:"genesis"`
+		GenesisPatches    []base.GenesisPatch `yaml:"genesis_patches"`
+		Provision         []base.ProvisionTx  `yaml:"provision"`
+		Validators        []Validator         `yaml:"validators"`
+		GenesisValidators []GenesisValidator  `yaml:"genesis_validators"`
+	}{
+		Validation:        c.Validation,
+		Accounts:          c.Accounts,
+		Genesis:           c.Genesis,
+		GenesisPatches:    c.GenesisPatches,
+		Provision:         c.Provision,
+		Validators:        validators,
+		GenesisValidators: c.GenesisValidators,
+	}
+}
+
+// nodeValues returns the config values that are written to the node config files.
+func nodeValues(c *Config) interface{} {
+	type node struct {
+		App    interface{} `yaml:"app"`
+		Config interface{} `yaml:"config"`
+		Client interface{} `yaml:"client"`
+	}
+
+	nodes := make([]node, len(c.Validators))
+	for i, v := range c.Validators {
+		nodes[i] = node{App: v.App, Config: v.Config, Client: v.Client}
+	}
+
+	return nodes
+}
+
+// otherValues returns the config values that are not part of any other group.
+func otherValues(c *Config) interface{} {
+	other := c.Config
+	other.Validation = ""
+	other.Accounts = nil
+	other.Genesis = nil
+	other.GenesisPatches = nil
+	other.Provision = nil
+	other.Faucet = base.Faucet{}
+
+	return other
+}
+
+func yamlChecksum(v interface{}) (string, error) {
+	bz, err := yaml.Marshal(v)
+	if err != nil {
+		return "", err
+	}
+
+	h := sha256.Sum256(bz)
+	return hex.EncodeToString(h[:]), nil
+}
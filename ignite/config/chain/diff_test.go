@@ -0,0 +1,122 @@
+package chain_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	chainconfig "github.com/ignite/cli/v29/ignite/config/chain"
+	"github.com/ignite/cli/v29/ignite/config/chain/base"
+	"github.com/ignite/cli/v29/ignite/pkg/xyaml"
+)
+
+func TestDiff(t *testing.T) {
+	newConfig := func() *chainconfig.Config {
+		cfg := chainconfig.DefaultChainConfig()
+		cfg.Accounts = []base.Account{{Name: "alice", Coins: []string{"100stake"}}}
+		cfg.Validators = []chainconfig.Validator{{
+			Name:   "alice",
+			Bonded: "100stake",
+			App:    xyaml.Map{"minimum-gas-prices": "0stake"},
+		}}
+		cfg.Genesis = xyaml.Map{"chain_id": "mars-1"}
+		return cfg
+	}
+
+	tests := []struct {
+		name   string
+		update func(*chainconfig.Config)
+		want   chainconfig.Changes
+	}{
+		{
+			name:   "no changes",
+			update: func(*chainconfig.Config) {},
+			want:   chainconfig.Changes{},
+		},
+		{
+			name: "same values with different types",
+			update: func(c *chainconfig.Config) {
+				c.Genesis = map[string]interface{}{"chain_id": "mars-1"}
+			},
+			want: chainconfig.Changes{},
+		},
+		{
+			name: "faucet changes",
+			update: func(c *chainconfig.Config) {
+				c.Faucet.CoinsMax = []string{"10stake"}
+			},
+			want: chainconfig.Changes{Faucet: true},
+		},
+		{
+			name: "node changes",
+			update: func(c *chainconfig.Config) {
+				c.Validators[0].App["minimum-gas-prices"] = "1stake"
+				c.Validators[0].Config = xyaml.Map{"consensus": map[string]interface{}{"timeout_commit": "5s"}}
+			},
+			want: chainconfig.Changes{Node: true},
+		},
+		{
+			name: "genesis changes",
+			update: func(c *chainconfig.Config) {
+				c.Genesis["chain_id"] = "mars-2"
+			},
+			want: chainconfig.Changes{State: true},
+		},
+		{
+			name: "account changes",
+			update: func(c *chainconfig.Config) {
+				c.Accounts[0].Coins = []string{"200stake"}
+			},
+			want: chainconfig.Changes{State: true},
+		},
+		{
+			name: "validator changes",
+			update: func(c *chainconfig.Config) {
+				c.Validators[0].Bonded = "50stake"
+			},
+			want: chainconfig.Changes{State: true},
+		},
+		{
+			name: "other changes",
+			update: func(c *chainconfig.Config) {
+				c.Build.Binary = "marsd"
+			},
+			want: chainconfig.Changes{Other: true},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			from, to := newConfig(), newConfig()
+			tt.update(to)
+
+			changes, err := chainconfig.Diff(from, to)
+
+			require.NoError(t, err)
+			require.Equal(t, tt.want, changes)
+		})
+	}
+}
+
+func TestChangesIsFaucetOnly(t *testing.T) {
+	require.True(t, chainconfig.Changes{Faucet: true}.IsFaucetOnly())
+	require.False(t, chainconfig.Changes{Faucet: true, Node: true}.IsFaucetOnly())
+	require.False(t, chainconfig.Changes{}.IsFaucetOnly())
+	require.True(t, chainconfig.Changes{}.IsEmpty())
+}
+
+func TestConfigChecksums(t *testing.T) {
+	mnemonic := "alice secret mnemonic"
+	cfg := chainconfig.DefaultChainConfig()
+	cfg.Accounts = []base.Account{{Name: "alice", Coins: []string{"100stake"}, Mnemonic: mnemonic}}
+
+	checksums, err := chainconfig.ConfigChecksums(cfg)
+	require.NoError(t, err)
+	require.NotContains(t, fmt.Sprintf("%v", checksums), mnemonic)
+	require.True(t, checksums.Changes(checksums).IsEmpty())
+
+	cfg.Accounts[0].Mnemonic = "bob secret mnemonic"
+	updated, err := chainconfig.ConfigChecksums(cfg)
+	require.NoError(t, err)
+	require.Equal(t, chainconfig.Changes{State: true}, checksums.Changes(updated))
+}
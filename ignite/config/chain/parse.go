@@ -2,21 +2,29 @@ package chain
 
 import (
 	"bytes"
+	"fmt"
 	"io"
 	"os"
+	"strings"
+	"text/template"
+	"time"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/bech32"
 	"gopkg.in/yaml.v3"
 
+	"github.com/ignite/cli/v29/ignite/config/chain/base"
 	"github.com/ignite/cli/v29/ignite/config/chain/version"
+	"github.com/ignite/cli/v29/ignite/pkg/cosmoslint"
 	"github.com/ignite/cli/v29/ignite/pkg/errors"
+	"github.com/ignite/cli/v29/ignite/pkg/xyaml"
 )
 
 // Parse reads a config file.
 // When the version of the file being read is not the latest
 // it is automatically migrated to the latest version.
-func Parse(configFile io.Reader) (*Config, error) {
-	cfg, err := parse(configFile)
+func Parse(configFile io.Reader, options ...ParseOption) (*Config, error) {
+	cfg, err := parse(configFile, newParseOptions(options))
 	if err != nil {
 		return cfg, errors.Errorf("error parsing config file: %w", err)
 	}
@@ -28,7 +36,7 @@ func Parse(configFile io.Reader) (*Config, error) {
 // When the version of the file being read is not the latest
 // it is automatically migrated to the latest version.
 func ParseNetwork(configFile io.Reader) (*Config, error) {
-	cfg, err := parse(configFile)
+	cfg, err := parse(configFile, parseOptions{})
 	if err != nil {
 		return cfg, err
 	}
@@ -36,22 +44,55 @@ func ParseNetwork(configFile io.Reader) (*Config, error) {
 	return cfg, validateNetworkConfig(cfg)
 }
 
-func parse(configFile io.Reader) (*Config, error) {
-	var buf bytes.Buffer
+func parse(configFile io.Reader, o parseOptions) (*Config, error) {
+	config, err := io.ReadAll(configFile)
+	if err != nil {
+		return DefaultChainConfig(), err
+	}
+
+	// Replace environment variable references before decoding
+	// to allow using them for any config value
+	raw := config
+	if config, err = interpolateEnv(config); err != nil {
+		return DefaultChainConfig(), err
+	}
 
 	// Read the config file version first to know how to decode it
-	version, err := ReadConfigVersion(io.TeeReader(configFile, &buf))
+	version, err := ReadConfigVersion(bytes.NewReader(config))
 	if err != nil {
 		return DefaultChainConfig(), err
 	}
 
 	// Decode the current config file version and assign default
 	// values for the fields that are empty
-	c, err := decodeConfig(&buf, version)
+	c, err := decodeConfig(bytes.NewReader(config), version)
 	if err != nil {
 		return DefaultChainConfig(), err
 	}
 
+	// Unknown config keys are rejected to avoid ignoring values with typos.
+	// The config is checked before replacing the environment variables because
+	// the replaced config might not keep the position of the values.
+	if err := checkKnownFields(raw, c); err != nil {
+		return DefaultChainConfig(), err
+	}
+
+	// Merge the profile values before assigning the default values
+	if o.profile != "" {
+		if o.profileOverlay, err = interpolateEnv(o.profileOverlay); err != nil {
+			return DefaultChainConfig(), err
+		}
+
+		if config, err = applyProfile(c, config, o); err != nil {
+			return DefaultChainConfig(), err
+		}
+
+		// Profile values use the same config version as the config
+		if c, err = decodeConfig(bytes.NewReader(config), version); err != nil {
+			return DefaultChainConfig(), errors.Errorf("error parsing config profile %q: %w", o.profile, err)
+		}
+	}
+
 	// Make sure that the empty fields contain default values
 	// after reading the config from the YAML file
 	if err = c.SetDefaults(); err != nil {
@@ -64,11 +105,16 @@ func parse(configFile io.Reader) (*Config, error) {
 		return DefaultChainConfig(), err
 	}
 
+	// Profiles are only used while parsing
+	cfg.Profiles = nil
+
 	return cfg, nil
 }
 
 // ParseFile parses a config from a file path.
-func ParseFile(path string) (*Config, error) {
+// When a profile is used its values are read from the "profiles" section
+// of the config file and from the profile file next to the config file.
+func ParseFile(path string, options ...ParseOption) (*Config, error) {
 	file, err := os.Open(path)
 	if err != nil {
 		return DefaultChainConfig(), err
@@ -76,7 +122,16 @@ func ParseFile(path string) (*Config, error) {
 
 	defer file.Close()
 
-	return Parse(file)
+	if o := newParseOptions(options); o.profile != "" {
+		overlay, err := os.ReadFile(ProfilePath(path, o.profile))
+		if err == nil {
+			options = append(options, withProfileOverlay(overlay))
+		} else if !os.IsNotExist(err) {
+			return DefaultChainConfig(), err
+		}
+	}
+
+	return Parse(file, options...)
 }
 
 // ParseNetworkFile parses a config for Ignite Network genesis from a file path.
@@ -120,11 +175,62 @@ func decodeConfig(r io.Reader, version version.Version) (version.Converter, erro
 	return cfg, nil
 }
 
+// checkKnownFields checks that all the keys of a YAML config match a config field.
+// The values of the config profiles are checked too because they use the same fields.
+func checkKnownFields(config []byte, c version.Converter) error {
+	var node yaml.Node
+	if err := yaml.Unmarshal(config, &node); err != nil {
+		return err
+	}
+
+	var unknown []xyaml.UnknownField
+	collect := func(n *yaml.Node) {
+		var fieldsErr *xyaml.UnknownFieldsError
+		if err := xyaml.CheckKnownFields(n, c); errors.As(err, &fieldsErr) {
+			unknown = append(unknown, fieldsErr.Fields...)
+		}
+	}
+
+	collect(&node)
+
+	if profiles := mappingValue(&node, "profiles"); profiles != nil && profiles.Kind == yaml.MappingNode {
+		for i := 1; i < len(profiles.Content); i += 2 {
+			collect(profiles.Content[i])
+		}
+	}
+
+	if len(unknown) > 0 {
+		return &xyaml.UnknownFieldsError{Fields: unknown}
+	}
+
+	return nil
+}
+
+// mappingValue returns the value node of a key from a YAML document or mapping node.
+func mappingValue(node *yaml.Node, key string) *yaml.Node {
+	if node.Kind == yaml.DocumentNode && len(node.Content) > 0 {
+		node = node.Content[0]
+	}
+
+	if node.Kind != yaml.MappingNode {
+		return nil
+	}
+
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		if node.Content[i].Value == key {
+			return node.Content[i+1]
+		}
+	}
+
+	return nil
+}
+
 func validateConfig(c *Config) error {
 	if len(c.Accounts) == 0 {
 		return &ValidationError{"at least one account is required"}
 	}
 
+	names := make(map[string]struct{})
 	for _, validator := range c.Validators {
 		if validator.Name == "" {
 			return &ValidationError{"validator 'name' is required"}
@@ -133,13 +239,358 @@ func validateConfig(c *Config) error {
 		if validator.Bonded == "" {
 			return &ValidationError{"validator 'bonded' is required"}
 		}
+
+		// Each validator uses its name to define its own home directory
+		if _, ok := names[validator.Name]; ok {
+			return &ValidationError{fmt.Sprintf("validator name '%s' is duplicated", validator.Name)}
+		}
+		names[validator.Name] = struct{}{}
+	}
+
+	for _, account := range c.Accounts {
+		if err := validateAccount(account); err != nil {
+			return err
+		}
+	}
+
+	for i, patch := range c.GenesisPatches {
+		if err := patch.JSONPatch().Validate(); err != nil {
+			return &ValidationError{fmt.Sprintf("genesis patch #%d is invalid: %s", i+1, err)}
+		}
+	}
+
+	if len(c.GenesisValidators) > 0 && len(c.Validators) == 0 {
+		return &ValidationError{"genesis validators require at least one validator"}
+	}
+
+	for _, validator := range c.GenesisValidators {
+		if validator.Name == "" {
+			return &ValidationError{"genesis validator 'name' is required"}
+		}
+
+		if validator.Bonded == "" {
+			return &ValidationError{"genesis validator 'bonded' is required"}
+		}
+
+		if _, ok := names[validator.Name]; ok {
+			return &ValidationError{fmt.Sprintf("validator name '%s' is duplicated", validator.Name)}
+		}
+		names[validator.Name] = struct{}{}
+
+		// Genesis validators have their own account which is created using the validator name
+		for _, account := range c.Accounts {
+			if account.Name == validator.Name {
+				return &ValidationError{fmt.Sprintf("genesis validator name '%s' is already used by an account", validator.Name)}
+			}
+		}
+	}
+
+	if err := validateGenesisValidatorsPower(c); err != nil {
+		return err
+	}
+
+	for i, tx := range c.Provision {
+		if err := validateProvisionTx(c, tx); err != nil {
+			return &ValidationError{fmt.Sprintf("provision tx #%d is invalid: %s", i+1, err)}
+		}
+	}
+
+	if err := validateRelease(c.Release); err != nil {
+		return &ValidationError{fmt.Sprintf("release is invalid: %s", err)}
+	}
+
+	if err := validateLint(c.Lint); err != nil {
+		return &ValidationError{fmt.Sprintf("lint is invalid: %s", err)}
+	}
+
+	if err := validateBench(c.Bench); err != nil {
+		return &ValidationError{fmt.Sprintf("bench is invalid: %s", err)}
+	}
+
+	if err := validateServe(c.Serve); err != nil {
+		return &ValidationError{fmt.Sprintf("serve is invalid: %s", err)}
+	}
+
+	return nil
+}
+
+func validateServe(s base.Serve) error {
+	if s.MaxRestarts < 0 {
+		return errors.New("'max_restarts' can't be negative")
+	}
+
+	if s.RestartWindow != "" {
+		window, err := time.ParseDuration(s.RestartWindow)
+		if err != nil {
+			return errors.Errorf("invalid 'restart_window': %w", err)
+		}
+
+		if window <= 0 {
+			return errors.New("'restart_window' must be positive")
+		}
+	}
+
+	return nil
+}
+
+func validateBench(b base.Bench) error {
+	if b.Accounts < 0 {
+		return errors.New("'accounts' can't be negative")
+	}
+
+	if len(b.Messages) > 0 && len(b.Amount) > 0 {
+		return errors.New("define either 'amount' or 'messages'")
+	}
+
+	return validateProvisionMessages(b.Messages)
+}
+
+func validateLint(l base.Lint) error {
+	for _, name := range l.Disable {
+		if _, ok := cosmoslint.AnalyzerByName(name); !ok {
+			return errors.Errorf("unknown analyzer '%s' can't be disabled", name)
+		}
+	}
+
+	return nil
+}
+
+func validateRelease(r base.Release) error {
+	targets := make(map[string]struct{})
+	for _, t := range r.Targets {
+		goos, goarch, ok := strings.Cut(t.Target, ":")
+		if !ok || goos == "" || goarch == "" {
+			return errors.Errorf("target '%s' must have the GOOS:GOARCH format", t.Target)
+		}
+
+		if _, ok := targets[t.Target]; ok {
+			return errors.Errorf("target '%s' is duplicated", t.Target)
+		}
+		targets[t.Target] = struct{}{}
+	}
+
+	if r.Name != "" {
+		if _, err := template.New("release").Parse(r.Name); err != nil {
+			return errors.Errorf("invalid 'name' template: %w", err)
+		}
+	}
+
+	return nil
+}
+
+func validateProvisionTx(c *Config, tx base.ProvisionTx) error {
+	if tx.From == "" {
+		return errors.New("'from' is required")
+	}
+
+	if !hasAccount(c, tx.From) {
+		return errors.Errorf("'from' account '%s' is not defined", tx.From)
+	}
+
+	var kinds int
+	if tx.BankSend != nil {
+		kinds++
+
+		if tx.BankSend.To == "" || len(tx.BankSend.Amount) == 0 {
+			return errors.New("bank send requires 'to' and 'amount'")
+		}
+	}
+
+	if tx.Delegate != nil {
+		kinds++
+
+		if tx.Delegate.Validator == "" || tx.Delegate.Amount == "" {
+			return errors.New("delegate requires 'validator' and 'amount'")
+		}
+	}
+
+	if tx.GovProposal != nil {
+		kinds++
+
+		if tx.GovProposal.Title == "" || tx.GovProposal.Summary == "" {
+			return errors.New("governance proposal requires 'title' and 'summary'")
+		}
+
+		if err := validateProvisionMessages(tx.GovProposal.Messages); err != nil {
+			return err
+		}
+	}
+
+	if len(tx.Messages) > 0 {
+		kinds++
+
+		if err := validateProvisionMessages(tx.Messages); err != nil {
+			return err
+		}
+	}
+
+	if kinds != 1 {
+		return errors.New("define one of 'bank_send', 'delegate', 'gov_proposal' or 'messages'")
+	}
+
+	return nil
+}
+
+func validateProvisionMessages(messages []xyaml.Map) error {
+	for i, msg := range messages {
+		if t, _ := msg["@type"].(string); t == "" {
+			return errors.Errorf("message #%d requires an '@type'", i+1)
+		}
+	}
+	return nil
+}
+
+// validateGenesisValidatorsPower checks that the genesis validators, which don't run a node,
+// have less than one third of the bonded coins. Otherwise, the validators running a node don't
+// have enough voting power to reach consensus and the chain can't produce blocks.
+func validateGenesisValidatorsPower(c *Config) error {
+	var bonded, genesisBonded sdk.Coins
+
+	for _, validator := range c.Validators {
+		coin, err := sdk.ParseCoinNormalized(validator.Bonded)
+		if err != nil {
+			return &ValidationError{fmt.Sprintf("validator '%s' bonded coins are invalid: %s", validator.Name, err)}
+		}
+		bonded = bonded.Add(coin)
+	}
+
+	for _, validator := range c.GenesisValidators {
+		coin, err := sdk.ParseCoinNormalized(validator.Bonded)
+		if err != nil {
+			return &ValidationError{fmt.Sprintf("genesis validator '%s' bonded coins are invalid: %s", validator.Name, err)}
+		}
+		genesisBonded = genesisBonded.Add(coin)
+	}
+
+	for _, coin := range genesisBonded {
+		total := bonded.AmountOf(coin.Denom).Add(coin.Amount)
+		if coin.Amount.MulRaw(3).GTE(total) {
+			return &ValidationError{fmt.Sprintf(
+				"genesis validators bond %s which is not less than one third of the total bonded %s%s",
+				coin,
+				total,
+				coin.Denom,
+			)}
+		}
+	}
+
+	return nil
+}
+
+// hasAccount checks if an account with a name is created when the chain is initialized.
+func hasAccount(c *Config, name string) bool {
+	for _, account := range c.Accounts {
+		if account.Name == name {
+			return true
+		}
+	}
+
+	// Genesis validators have an account with the validator name
+	for _, validator := range c.GenesisValidators {
+		if validator.Name == name {
+			return true
+		}
+	}
+
+	return false
+}
+
+func validateAccount(account base.Account) error {
+	if account.Module != "" {
+		if account.Address != "" || account.Mnemonic != "" {
+			return &ValidationError{fmt.Sprintf("module account '%s' can't have an address or a mnemonic", account.Name)}
+		}
+
+		if account.Vesting != nil {
+			return &ValidationError{fmt.Sprintf("module account '%s' can't have a vesting schedule", account.Name)}
+		}
+	}
+
+	if account.Vesting == nil {
+		return nil
+	}
+
+	vesting := account.Vesting
+	switch vesting.Type {
+	case base.VestingContinuous, base.VestingDelayed:
+		if len(vesting.Coins) == 0 {
+			return &ValidationError{fmt.Sprintf("account '%s' vesting 'coins' are required", account.Name)}
+		}
+
+		if vesting.EndTime <= 0 {
+			return &ValidationError{fmt.Sprintf("account '%s' vesting 'end_time' is required", account.Name)}
+		}
+
+		if vesting.Type == base.VestingContinuous && vesting.StartTime >= vesting.EndTime {
+			return &ValidationError{fmt.Sprintf("account '%s' vesting 'start_time' must be before 'end_time'", account.Name)}
+		}
+	case base.VestingPeriodic:
+		if vesting.StartTime <= 0 {
+			return &ValidationError{fmt.Sprintf("account '%s' vesting 'start_time' is required", account.Name)}
+		}
+
+		if len(vesting.Periods) == 0 {
+			return &ValidationError{fmt.Sprintf("account '%s' vesting 'periods' are required", account.Name)}
+		}
+
+		for _, period := range vesting.Periods {
+			if period.Length <= 0 || len(period.Coins) == 0 {
+				return &ValidationError{fmt.Sprintf("account '%s' vesting periods require a 'length' and 'coins'", account.Name)}
+			}
+		}
+	default:
+		return &ValidationError{fmt.Sprintf(
+			"account '%s' vesting type '%s' is invalid, use '%s', '%s' or '%s'",
+			account.Name,
+			vesting.Type,
+			base.VestingContinuous,
+			base.VestingDelayed,
+			base.VestingPeriodic,
+		)}
+	}
+
+	return validateVestingCoins(account)
+}
+
+// validateVestingCoins checks that the vesting coins of an account don't exceed its coins.
+// The vesting coins of periodic vesting accounts are the sum of the coins of all the periods.
+func validateVestingCoins(account base.Account) error {
+	coins, err := sdk.ParseCoinsNormalized(strings.Join(account.Coins, ","))
+	if err != nil {
+		return &ValidationError{fmt.Sprintf("account '%s' coins are invalid: %s", account.Name, err)}
+	}
+
+	vestingCoins := account.Vesting.Coins
+	for _, period := range account.Vesting.Periods {
+		vestingCoins = append(vestingCoins, period.Coins...)
+	}
+
+	var vesting sdk.Coins
+	for _, c := range vestingCoins {
+		coin, err := sdk.ParseCoinNormalized(c)
+		if err != nil {
+			return &ValidationError{fmt.Sprintf("account '%s' vesting coins are invalid: %s", account.Name, err)}
+		}
+		vesting = vesting.Add(coin)
+	}
+
+	for _, coin := range vesting {
+		if amount := coins.AmountOf(coin.Denom); coin.Amount.GT(amount) {
+			return &ValidationError{fmt.Sprintf(
+				"account '%s' vests %s which is more than its %s%s",
+				account.Name,
+				coin,
+				amount,
+				coin.Denom,
+			)}
+		}
 	}
 
 	return nil
 }
 
 func validateNetworkConfig(c *Config) error {
-	if len(c.Validators) != 0 {
+	if len(c.Validators) != 0 || len(c.GenesisValidators) != 0 {
 		return &ValidationError{"no validators can be used in config for network genesis"}
 	}
 
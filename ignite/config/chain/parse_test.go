@@ -9,8 +9,10 @@ import (
 	"github.com/stretchr/testify/require"
 
 	chainconfig "github.com/ignite/cli/v29/ignite/config/chain"
+	"github.com/ignite/cli/v29/ignite/config/chain/base"
 	"github.com/ignite/cli/v29/ignite/config/chain/version"
 	"github.com/ignite/cli/v29/ignite/config/testdata"
+	"github.com/ignite/cli/v29/ignite/pkg/xyaml"
 )
 
 func TestReadConfigVersion(t *testing.T) {
@@ -103,3 +105,388 @@ func TestParseNetworkWithInvalidData(t *testing.T) {
 		),
 	)
 }
+
+func TestParseWithDuplicatedValidators(t *testing.T) {
+	// Arrange
+	r := strings.NewReader(`
+version: 1
+accounts:
+  - name: alice
+    coins: ["100000000stake"]
+  - name: bob
+    coins: ["100000000stake"]
+validators:
+  - name: alice
+    bonded: 100000000stake
+  - name: alice
+    bonded: 100000000stake
+`)
+
+	var want *chainconfig.ValidationError
+
+	// Act
+	_, err := chainconfig.Parse(r)
+
+	// Assert
+	require.ErrorAs(t, err, &want)
+	require.Equal(t, "validator name 'alice' is duplicated", want.Message)
+}
+
+func TestParseWithVestingAccounts(t *testing.T) {
+	// Arrange
+	r := strings.NewReader(`
+version: 1
+accounts:
+  - name: alice
+    coins: ["100000000stake"]
+  - name: bob
+    coins: ["1000token"]
+    vesting:
+      type: periodic
+      start_time: 1700000000
+      periods:
+        - length: 3600
+          coins: ["500token"]
+        - length: 3600
+          coins: ["500token"]
+  - name: treasury
+    coins: ["1000token"]
+    module: treasury
+validators:
+  - name: alice
+    bonded: 100000000stake
+genesis_validators:
+  - name: carol
+    bonded: 1000stake
+`)
+
+	// Act
+	cfg, err := chainconfig.Parse(r)
+
+	// Assert
+	require.NoError(t, err)
+	require.Equal(t, base.VestingPeriodic, cfg.Accounts[1].Vesting.Type)
+	require.Len(t, cfg.Accounts[1].Vesting.Periods, 2)
+	require.Equal(t, "treasury", cfg.Accounts[2].Module)
+	require.Equal(t, []chainconfig.GenesisValidator{{Name: "carol", Bonded: "1000stake"}}, cfg.GenesisValidators)
+}
+
+func TestParseWithInvalidValues(t *testing.T) {
+	cases := []struct {
+		name   string
+		config string
+		err    string
+	}{
+		{
+			name: "invalid vesting type",
+			config: `
+accounts:
+  - name: bob
+    coins: ["1000token"]
+    vesting:
+      type: linear
+`,
+			err: "account 'bob' vesting type 'linear' is invalid, use 'continuous', 'delayed' or 'periodic'",
+		},
+		{
+			name: "continuous vesting without end time",
+			config: `
+accounts:
+  - name: bob
+    coins: ["1000token"]
+    vesting:
+      type: continuous
+      coins: ["500token"]
+      start_time: 1700000000
+`,
+			err: "account 'bob' vesting 'end_time' is required",
+		},
+		{
+			name: "continuous vesting ending before start",
+			config: `
+accounts:
+  - name: bob
+    coins: ["1000token"]
+    vesting:
+      type: continuous
+      coins: ["500token"]
+      start_time: 1700000000
+      end_time: 1600000000
+`,
+			err: "account 'bob' vesting 'start_time' must be before 'end_time'",
+		},
+		{
+			name: "vesting more than the account coins",
+			config: `
+accounts:
+  - name: bob
+    coins: ["1000token"]
+    vesting:
+      type: delayed
+      coins: ["1500token"]
+      end_time: 1700000000
+`,
+			err: "account 'bob' vests 1500token which is more than its 1000token",
+		},
+		{
+			name: "periodic vesting more than the account coins",
+			config: `
+accounts:
+  - name: bob
+    coins: ["1000token"]
+    vesting:
+      type: periodic
+      start_time: 1700000000
+      periods:
+        - length: 3600
+          coins: ["600token"]
+        - length: 3600
+          coins: ["600token"]
+`,
+			err: "account 'bob' vests 1200token which is more than its 1000token",
+		},
+		{
+			name: "module account with address",
+			config: `
+accounts:
+  - name: treasury
+    coins: ["1000token"]
+    address: cosmos1adn9gxjmrc3hrsdx5zpc9sj2ra7kgqkmphf8yw
+    module: treasury
+`,
+			err: "module account 'treasury' can't have an address or a mnemonic",
+		},
+		{
+			name: "genesis validator name used by an account",
+			config: `
+accounts:
+  - name: bob
+    coins: ["1000token"]
+validators:
+  - name: alice
+    bonded: 1000stake
+genesis_validators:
+  - name: bob
+    bonded: 1000stake
+`,
+			err: "genesis validator name 'bob' is already used by an account",
+		},
+		{
+			name: "genesis validators without validators",
+			config: `
+accounts:
+  - name: bob
+    coins: ["1000token"]
+genesis_validators:
+  - name: carol
+    bonded: 1000stake
+`,
+			err: "genesis validators require at least one validator",
+		},
+		{
+			name: "genesis validators with one third of the bonded coins",
+			config: `
+accounts:
+  - name: alice
+    coins: ["1000stake"]
+validators:
+  - name: alice
+    bonded: 1000stake
+genesis_validators:
+  - name: carol
+    bonded: 300stake
+  - name: dave
+    bonded: 200stake
+`,
+			err: "genesis validators bond 500stake which is not less than one third of the total bonded 1500stake",
+		},
+		{
+			name: "invalid genesis validator bonded coins",
+			config: `
+accounts:
+  - name: alice
+    coins: ["1000stake"]
+validators:
+  - name: alice
+    bonded: 1000stake
+genesis_validators:
+  - name: carol
+    bonded: stake
+`,
+			err: "genesis validator 'carol' bonded coins are invalid: invalid decimal coin expression: stake",
+		},
+		{
+			name: "invalid genesis patch",
+			config: `
+accounts:
+  - name: bob
+    coins: ["1000token"]
+genesis_patches:
+  - op: replace
+    path: app_state.bank.params
+    value: {}
+  - op: move
+    path: app_state.bank.params
+`,
+			err: `genesis patch #2 is invalid: invalid operation "move", use add, replace, remove, append or merge`,
+		},
+		{
+			name: "provision tx from unknown account",
+			config: `
+accounts:
+  - name: bob
+    coins: ["1000token"]
+provision:
+  - from: alice
+    bank_send:
+      to: bob
+      amount: ["10token"]
+`,
+			err: "provision tx #1 is invalid: 'from' account 'alice' is not defined",
+		},
+		{
+			name: "provision tx with many messages kinds",
+			config: `
+accounts:
+  - name: bob
+    coins: ["1000token"]
+provision:
+  - from: bob
+    delegate:
+      validator: bob
+      amount: 10token
+    bank_send:
+      to: bob
+      amount: ["10token"]
+`,
+			err: "provision tx #1 is invalid: define one of 'bank_send', 'delegate', 'gov_proposal' or 'messages'",
+		},
+		{
+			name: "provision message without type",
+			config: `
+accounts:
+  - name: bob
+    coins: ["1000token"]
+provision:
+  - from: bob
+    messages:
+      - "@type": /mars.blog.MsgCreatePost
+        title: hello
+  - from: bob
+    gov_proposal:
+      title: Update params
+      summary: Update the blog params
+      messages:
+        - authority: cosmos10d07y265gmmuvt4z0w9aw880jnsr700j6zn9kn
+`,
+			err: "provision tx #2 is invalid: message #1 requires an '@type'",
+		},
+		{
+			name: "invalid release target",
+			config: `
+accounts:
+  - name: bob
+    coins: ["1000token"]
+release:
+  targets:
+    - target: linux:amd64
+    - target: darwin
+`,
+			err: "release is invalid: target 'darwin' must have the GOOS:GOARCH format",
+		},
+		{
+			name: "duplicated release target",
+			config: `
+accounts:
+  - name: bob
+    coins: ["1000token"]
+release:
+  targets:
+    - target: linux:amd64
+    - target: linux:amd64
+      ldflags: ["-s", "-w"]
+`,
+			err: "release is invalid: target 'linux:amd64' is duplicated",
+		},
+		{
+			name: "unknown disabled lint analyzer",
+			config: `
+accounts:
+  - name: bob
+    coins: ["1000token"]
+lint:
+  disable: ["maprange", "goroutine"]
+`,
+			err: "lint is invalid: unknown analyzer 'goroutine' can't be disabled",
+		},
+		{
+			name: "bench message without type",
+			config: `
+accounts:
+  - name: bob
+    coins: ["1000token"]
+bench:
+  messages:
+    - creator: "{{ .Sender }}"
+`,
+			err: "bench is invalid: message #1 requires an '@type'",
+		},
+		{
+			name: "invalid serve restart window",
+			config: `
+accounts:
+  - name: bob
+    coins: ["1000token"]
+serve:
+  max_restarts: 5
+  restart_window: 2
+`,
+			err: "serve is invalid: invalid 'restart_window': time: missing unit in duration \"2\"",
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			var want *chainconfig.ValidationError
+
+			_, err := chainconfig.Parse(strings.NewReader("version: 1\n" + tt.config))
+
+			require.ErrorAs(t, err, &want)
+			require.Equal(t, tt.err, want.Message)
+		})
+	}
+}
+
+func TestParseWithUnknownFields(t *testing.T) {
+	// Arrange
+	r := strings.NewReader(`version: 1
+accounts:
+  - name: alice
+    coins: ["100000000stake"]
+validators:
+  - name: alice
+    bonded: 100000000stake
+faucet:
+  name: alice
+  coins_maxx: ["100stake"]
+profiles:
+  ci:
+    faucet:
+      port: 4501
+      address: 0.0.0.0:4501
+`)
+
+	var want *xyaml.UnknownFieldsError
+
+	// Act
+	_, err := chainconfig.Parse(r)
+
+	// Assert
+	require.ErrorAs(t, err, &want)
+	require.EqualError(
+		t,
+		err,
+		`error parsing config file: line 10, column 3: unknown field "faucet.coins_maxx"; `+
+			`line 15, column 7: unknown field "faucet.address"`,
+	)
+}
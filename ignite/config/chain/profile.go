@@ -0,0 +1,198 @@
+package chain
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/ignite/cli/v29/ignite/config/chain/version"
+	"github.com/ignite/cli/v29/ignite/pkg/errors"
+)
+
+// ErrProfileNotFound is returned when a config profile is not defined.
+var ErrProfileNotFound = errors.New("config profile not found")
+
+// envVarRe matches the environment variable references of a config file,
+// which use the ${NAME} or ${NAME:-default} syntax.
+var envVarRe = regexp.MustCompile(`\$\{([A-Za-z_][A-Za-z0-9_]*)(:-([^}]*))?\}`)
+
+// ParseOption configures config parsing.
+type ParseOption func(*parseOptions)
+
+type parseOptions struct {
+	profile        string
+	profileOverlay []byte
+}
+
+// WithProfile parses the config using a profile.
+// The profile values are merged on top of the config values.
+// When parsing a config file the profile can be defined in the "profiles"
+// section of the config file or in a profile file next to it, for example
+// "config.dev.yml" for the "dev" profile of a "config.yml" file.
+func WithProfile(profile string) ParseOption {
+	return func(o *parseOptions) {
+		o.profile = profile
+	}
+}
+
+// withProfileOverlay parses the config using the content of a profile file as profile values.
+func withProfileOverlay(overlay []byte) ParseOption {
+	return func(o *parseOptions) {
+		o.profileOverlay = overlay
+	}
+}
+
+func newParseOptions(options []ParseOption) parseOptions {
+	var o parseOptions
+	for _, apply := range options {
+		apply(&o)
+	}
+	return o
+}
+
+// ProfilePath returns the path of the profile file of a config file.
+func ProfilePath(configPath, profile string) string {
+	ext := filepath.Ext(configPath)
+	return fmt.Sprintf("%s.%s%s", strings.TrimSuffix(configPath, ext), profile, ext)
+}
+
+// interpolateEnv replaces the environment variable references of a config with their values.
+// Only the values of the YAML nodes are replaced, so the values of the variables don't need to
+// be escaped. References to variables that are not defined and don't have a default value
+// return an error, unless they are only used within YAML comments.
+func interpolateEnv(config []byte) ([]byte, error) {
+	var node yaml.Node
+	if err := yaml.Unmarshal(config, &node); err != nil {
+		return nil, err
+	}
+
+	var missing []string
+	interpolated := interpolateEnvNodes(&node, func(ref string) string {
+		m := envVarRe.FindStringSubmatch(ref)
+		name, hasDefault := m[1], len(m[2]) > 0
+
+		if value, ok := os.LookupEnv(name); ok {
+			return value
+		}
+
+		if hasDefault {
+			return m[3]
+		}
+
+		missing = append(missing, name)
+		return ref
+	})
+
+	if len(missing) > 0 {
+		return nil, errors.Errorf("environment variables are not defined: %s", strings.Join(missing, ", "))
+	}
+
+	// The config is kept as is when there are no references to keep the position
+	// of the values within the config, which is used to report the decoding errors
+	if !interpolated {
+		return config, nil
+	}
+
+	return yaml.Marshal(&node)
+}
+
+// interpolateEnvNodes replaces the environment variable references of the keys and values
+// of a YAML node and its children and returns true when any reference was replaced.
+func interpolateEnvNodes(node *yaml.Node, replace func(ref string) string) (interpolated bool) {
+	if node.Kind == yaml.ScalarNode && envVarRe.MatchString(node.Value) {
+		node.Value = envVarRe.ReplaceAllStringFunc(node.Value, replace)
+		interpolated = true
+
+		// The type of unquoted values is resolved again from their new value to
+		// allow using variables for values that are not strings, like ports.
+		// The encoder then quotes the values that can't be written unquoted.
+		if node.Style&(yaml.DoubleQuotedStyle|yaml.SingleQuotedStyle|yaml.LiteralStyle|yaml.FoldedStyle) == 0 {
+			node.Tag = ""
+		}
+	}
+
+	for _, n := range node.Content {
+		if interpolateEnvNodes(n, replace) {
+			interpolated = true
+		}
+	}
+
+	return interpolated
+}
+
+// applyProfile merges the values of a profile on top of the config values and
+// returns the merged config. The profile values can be defined in the "profiles"
+// section of the config or in a profile overlay, in which case the overlay values
+// have priority. The YAML documents are merged so the profile values can set the
+// config values to empty values like false or 0.
+func applyProfile(c version.Converter, config []byte, o parseOptions) ([]byte, error) {
+	var node yaml.Node
+	if err := yaml.Unmarshal(config, &node); err != nil {
+		return nil, err
+	}
+
+	var overlays []*yaml.Node
+	if profiles := mappingValue(&node, "profiles"); profiles != nil {
+		if profile := mappingValue(profiles, o.profile); profile != nil {
+			overlays = append(overlays, profile)
+		}
+	}
+
+	if o.profileOverlay != nil {
+		if err := checkKnownFields(o.profileOverlay, c); err != nil {
+			return nil, errors.Errorf("error parsing config profile %q: %w", o.profile, err)
+		}
+
+		var overlay yaml.Node
+		if err := yaml.Unmarshal(o.profileOverlay, &overlay); err != nil {
+			return nil, errors.Errorf("error parsing config profile %q: %w", o.profile, err)
+		}
+
+		overlays = append(overlays, &overlay)
+	}
+
+	if len(overlays) == 0 {
+		return nil, errors.Wrapf(ErrProfileNotFound, "profile %q", o.profile)
+	}
+
+	for _, overlay := range overlays {
+		if overlay.Kind == yaml.DocumentNode && len(overlay.Content) > 0 {
+			overlay = overlay.Content[0]
+		}
+
+		// Empty profiles don't change the config
+		if overlay.Kind == 0 || overlay.Tag == "!!null" {
+			continue
+		}
+
+		if overlay.Kind != yaml.MappingNode {
+			return nil, errors.Errorf("error parsing config profile %q: profile values must be a mapping", o.profile)
+		}
+
+		mergeYAMLMappings(node.Content[0], overlay)
+	}
+
+	return yaml.Marshal(&node)
+}
+
+// mergeYAMLMappings merges the values of the src mapping node into the dst mapping node.
+// Nested mappings are merged while other values, including sequences, are replaced.
+func mergeYAMLMappings(dst, src *yaml.Node) {
+	for i := 0; i+1 < len(src.Content); i += 2 {
+		key, value := src.Content[i], src.Content[i+1]
+
+		current := mappingValue(dst, key.Value)
+		switch {
+		case current == nil:
+			dst.Content = append(dst.Content, key, value)
+		case current.Kind == yaml.MappingNode && value.Kind == yaml.MappingNode:
+			mergeYAMLMappings(current, value)
+		default:
+			*current = *value
+		}
+	}
+}
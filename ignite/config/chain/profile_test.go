@@ -0,0 +1,179 @@
+package chain_test
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	chainconfig "github.com/ignite/cli/v29/ignite/config/chain"
+)
+
+const profilesConfig = `
+version: 1
+accounts:
+  - name: alice
+    coins: ["100000000stake"]
+  - name: bob
+    coins: ["1000stake"]
+validators:
+  - name: alice
+    bonded: 100000000stake
+genesis:
+  chain_id: mars-1
+  app_state:
+    staking:
+      params:
+        bond_denom: stake
+minimal: true
+profiles:
+  demo:
+    genesis:
+      chain_id: mars-demo
+    minimal: false
+    faucet:
+      name: bob
+      coins: ["5stake"]
+  ci:
+    genesis:
+      chain_id: mars-ci-section
+`
+
+func TestParseFileWithProfile(t *testing.T) {
+	dir := t.TempDir()
+	configPath := filepath.Join(dir, "config.yml")
+	require.NoError(t, os.WriteFile(configPath, []byte(profilesConfig), 0o644))
+
+	// The profile file has priority over the profile defined in the config
+	ciConfig := `
+accounts:
+  - name: alice
+    coins: ["5stake"]
+genesis:
+  chain_id: mars-ci
+`
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "config.ci.yml"), []byte(ciConfig), 0o644))
+
+	t.Run("without profile", func(t *testing.T) {
+		cfg, err := chainconfig.ParseFile(configPath)
+
+		require.NoError(t, err)
+		require.Equal(t, "mars-1", cfg.Genesis["chain_id"])
+		require.True(t, cfg.Minimal)
+		require.Nil(t, cfg.Faucet.Name)
+		require.Nil(t, cfg.Profiles)
+	})
+
+	t.Run("profile from config", func(t *testing.T) {
+		cfg, err := chainconfig.ParseFile(configPath, chainconfig.WithProfile("demo"))
+
+		require.NoError(t, err)
+		require.Equal(t, "mars-demo", cfg.Genesis["chain_id"])
+		require.NotNil(t, cfg.Genesis["app_state"])
+		require.False(t, cfg.Minimal)
+		require.Equal(t, "bob", *cfg.Faucet.Name)
+		require.Equal(t, []string{"5stake"}, cfg.Faucet.Coins)
+		require.Len(t, cfg.Accounts, 2)
+		require.Nil(t, cfg.Profiles)
+	})
+
+	t.Run("profile from file", func(t *testing.T) {
+		cfg, err := chainconfig.ParseFile(configPath, chainconfig.WithProfile("ci"))
+
+		require.NoError(t, err)
+		require.Equal(t, "mars-ci", cfg.Genesis["chain_id"])
+		require.Len(t, cfg.Accounts, 1)
+		require.Equal(t, []string{"5stake"}, cfg.Accounts[0].Coins)
+		require.Len(t, cfg.Validators, 1)
+		require.True(t, cfg.Minimal)
+	})
+
+	t.Run("unknown profile", func(t *testing.T) {
+		_, err := chainconfig.ParseFile(configPath, chainconfig.WithProfile("prod"))
+
+		require.ErrorIs(t, err, chainconfig.ErrProfileNotFound)
+	})
+}
+
+func TestParseWithEnvVars(t *testing.T) {
+	config := `
+version: 1
+# The ${TEST_UNDEFINED} references within comments are ignored
+accounts:
+  - name: alice
+    coins: ["100000000stake"]
+    mnemonic: "${TEST_ALICE_MNEMONIC}"
+  - name: bob
+    coins: ["${TEST_BOB_COINS:-1000stake}"]
+validators:
+  - name: alice
+    bonded: 100000000stake
+`
+
+	t.Run("defined variables", func(t *testing.T) {
+		t.Setenv("TEST_ALICE_MNEMONIC", "alice mnemonic")
+
+		cfg, err := chainconfig.Parse(strings.NewReader(config))
+
+		require.NoError(t, err)
+		require.Equal(t, "alice mnemonic", cfg.Accounts[0].Mnemonic)
+		require.Equal(t, []string{"1000stake"}, cfg.Accounts[1].Coins)
+	})
+
+	t.Run("undefined variables", func(t *testing.T) {
+		_, err := chainconfig.Parse(strings.NewReader(config))
+
+		require.EqualError(t, err, "error parsing config file: environment variables are not defined: TEST_ALICE_MNEMONIC")
+	})
+}
+
+func TestParseWithEnvVarsSpecialValues(t *testing.T) {
+	config := `
+version: 1
+accounts:
+  - name: alice
+    coins: ["100000000stake"]
+    mnemonic: ${TEST_ALICE_MNEMONIC}
+  - name: bob
+    coins: ["1000stake"]
+    mnemonic: "${TEST_BOB_MNEMONIC}"
+validators:
+  - name: alice
+    bonded: 100000000stake
+faucet:
+  name: ${TEST_FAUCET_NAME}
+  port: ${TEST_FAUCET_PORT}
+genesis:
+  chain_url: ${TEST_CHAIN_URL}
+  note: '${TEST_NOTE}'
+`
+
+	env := map[string]string{
+		"TEST_ALICE_MNEMONIC": "alice mnemonic: with # special characters",
+		"TEST_BOB_MNEMONIC":   "bob \"mnemonic\"\non two lines",
+		"TEST_FAUCET_NAME":    "*alice",
+		"TEST_CHAIN_URL":      "https://example.com/chain#fragment",
+		"TEST_NOTE":           "&it's a note",
+	}
+	for name, value := range env {
+		t.Setenv(name, value)
+	}
+	t.Setenv("TEST_FAUCET_PORT", "4501")
+
+	cfg, err := chainconfig.Parse(strings.NewReader(config))
+
+	require.NoError(t, err)
+	require.Equal(t, env["TEST_ALICE_MNEMONIC"], cfg.Accounts[0].Mnemonic)
+	require.Equal(t, env["TEST_BOB_MNEMONIC"], cfg.Accounts[1].Mnemonic)
+	require.Equal(t, env["TEST_FAUCET_NAME"], *cfg.Faucet.Name)
+	require.EqualValues(t, 4501, cfg.Faucet.Port)
+	require.Equal(t, env["TEST_CHAIN_URL"], cfg.Genesis["chain_url"])
+	require.Equal(t, env["TEST_NOTE"], cfg.Genesis["note"])
+}
+
+func TestProfilePath(t *testing.T) {
+	require.Equal(t, "chain/config.dev.yml", chainconfig.ProfilePath("chain/config.yml", "dev"))
+	require.Equal(t, "config.dev.yaml", chainconfig.ProfilePath("config.yaml", "dev"))
+}
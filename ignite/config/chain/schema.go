@@ -0,0 +1,23 @@
+package chain
+
+import (
+	"encoding/json"
+
+	"github.com/ignite/cli/v29/ignite/pkg/jsonschema"
+)
+
+// SchemaID is the ID of the chain config JSON Schema.
+const SchemaID = "https://ignite.com/schemas/chain-config.json"
+
+// Schema returns the JSON Schema of the latest config version.
+// The schema can be used by editors to validate and autocomplete config files.
+func Schema() ([]byte, error) {
+	s := jsonschema.Reflect(
+		Versions[LatestVersion],
+		jsonschema.WithID(SchemaID),
+		jsonschema.WithTitle("Ignite chain config"),
+		jsonschema.WithDescription("Configuration of a blockchain app created with Ignite CLI"),
+	)
+
+	return json.MarshalIndent(s, "", "  ")
+}
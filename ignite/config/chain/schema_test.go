@@ -0,0 +1,29 @@
+package chain_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	chainconfig "github.com/ignite/cli/v29/ignite/config/chain"
+	"github.com/ignite/cli/v29/ignite/pkg/jsonschema"
+)
+
+func TestSchema(t *testing.T) {
+	// Act
+	bz, err := chainconfig.Schema()
+
+	// Assert
+	require.NoError(t, err)
+
+	var s jsonschema.Schema
+	require.NoError(t, json.Unmarshal(bz, &s))
+	require.Equal(t, chainconfig.SchemaID, s.ID)
+	require.Equal(t, false, s.AdditionalProperties)
+	require.Contains(t, s.Properties, "validators")
+	require.Contains(t, s.Properties, "genesis_validators")
+	require.Equal(t, jsonschema.TypeArray, s.Properties["accounts"].Type)
+	require.Contains(t, s.Properties["faucet"].Properties, "coins_max")
+	require.Equal(t, jsonschema.TypeObject, s.Properties["genesis"].Type)
+}
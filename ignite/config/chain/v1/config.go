@@ -24,6 +24,10 @@ type Config struct {
 	base.Config `yaml:",inline"`
 
 	Validators []Validator `yaml:"validators"`
+
+	// GenesisValidators are additional validators that are bonded at genesis.
+	// Their bonded coins must be less than one third of the total bonded coins.
+	GenesisValidators []GenesisValidator `yaml:"genesis_validators,omitempty"`
 }
 
 func (c *Config) SetDefaults() error {
@@ -28,6 +28,26 @@ type Validator struct {
 	Gentx *Gentx `yaml:"gentx,omitempty"`
 }
 
+// GenesisValidator holds info related to an additional validator that is
+// bonded at genesis but that is not started as a node.
+type GenesisValidator struct {
+	// Name is the name of the validator and its account.
+	Name string `yaml:"name"`
+
+	// Bonded is how much the validator has staked.
+	Bonded string `yaml:"bonded"`
+
+	// Coins are the coins of the validator account.
+	// When empty the account is funded with the bonded coins.
+	Coins []string `yaml:"coins,omitempty"`
+
+	// Moniker is the validator's (optional) moniker.
+	Moniker string `yaml:"moniker,omitempty"`
+
+	// CommissionRate is the initial commission rate percentage.
+	CommissionRate string `yaml:"commission_rate,omitempty"`
+}
+
 // Gentx holds info related to Gentx settings.
 type Gentx struct {
 	// Amount is the amount for the current Gentx.
@@ -0,0 +1,437 @@
+// Package chainbench generates transaction load on a running chain and measures
+// its throughput, block times and the latency of the transactions inclusion.
+package chainbench
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"golang.org/x/sync/errgroup"
+
+	"github.com/ignite/cli/v29/ignite/pkg/cosmosaccount"
+	"github.com/ignite/cli/v29/ignite/pkg/cosmosclient"
+	"github.com/ignite/cli/v29/ignite/pkg/errors"
+)
+
+const (
+	// DefaultDuration is the default time during which transactions are broadcasted.
+	DefaultDuration = 30 * time.Second
+
+	// DefaultDrainTimeout is the default time to wait for the inclusion of the
+	// transactions that are pending once the load finishes.
+	DefaultDrainTimeout = 30 * time.Second
+
+	// DefaultPipeline is the default number of transactions of each account
+	// that wait for their inclusion at the same time.
+	DefaultPipeline = 1
+
+	// pollInterval is the time between checks of new blocks.
+	pollInterval = 200 * time.Millisecond
+
+	// retryInterval is the time an account waits before sending a new
+	// transaction when the previous one is rejected.
+	retryInterval = 500 * time.Millisecond
+)
+
+type (
+	// Result are the measurements of a benchmark.
+	Result struct {
+		// Commit is the source code version of the benchmarked chain.
+		Commit string `json:"commit,omitempty"`
+
+		// Accounts is the number of accounts that broadcasted transactions concurrently.
+		Accounts int `json:"accounts"`
+
+		// Pipeline is the number of transactions of each account that waited for their inclusion at the same time.
+		Pipeline int `json:"pipeline"`
+
+		// Duration is the time in seconds since the load started until the inclusion of the last transaction.
+		Duration float64 `json:"duration_seconds"`
+
+		// TxsSent is the number of transactions accepted in the mempool.
+		TxsSent int `json:"txs_sent"`
+
+		// TxsCommitted is the number of transactions included in a block that succeeded.
+		TxsCommitted int `json:"txs_committed"`
+
+		// TxsFailed is the number of transactions rejected by the mempool or that failed in a block.
+		TxsFailed int `json:"txs_failed"`
+
+		// TPS is the number of committed transactions per second.
+		TPS float64 `json:"tps"`
+
+		// Blocks is the number of blocks committed during the benchmark.
+		Blocks int `json:"blocks"`
+
+		// BlockTime is the time between consecutive blocks in milliseconds.
+		BlockTime Stats `json:"block_time_ms"`
+
+		// GasPerBlock is the gas used by the transactions of each block.
+		GasPerBlock Stats `json:"gas_per_block"`
+
+		// MempoolSize is the number of unconfirmed transactions sampled during the benchmark.
+		MempoolSize Stats `json:"mempool_size"`
+
+		// InclusionLatency is the time in milliseconds since a transaction is broadcasted
+		// until the time of the block that includes it.
+		InclusionLatency Stats `json:"inclusion_latency_ms"`
+	}
+
+	// Option configures a benchmark.
+	Option func(*bench)
+
+	bench struct {
+		client       cosmosclient.Client
+		duration     time.Duration
+		drainTimeout time.Duration
+		pipeline     int
+
+		mu        sync.Mutex
+		included  map[string]inclusion
+		waiters   map[string]chan inclusion
+		blockTime []float64
+		gas       []float64
+		mempool   []float64
+		latency   []float64
+		sent      int
+		committed int
+		failed    int
+		blocks    int
+	}
+
+	// inclusion is the result of a transaction included in a block.
+	inclusion struct {
+		time time.Time
+		code uint32
+	}
+)
+
+// WithDuration sets the time during which transactions are broadcasted.
+func WithDuration(d time.Duration) Option {
+	return func(b *bench) {
+		b.duration = d
+	}
+}
+
+// WithDrainTimeout sets the time to wait for the inclusion of the pending transactions.
+func WithDrainTimeout(d time.Duration) Option {
+	return func(b *bench) {
+		b.drainTimeout = d
+	}
+}
+
+// WithPipeline sets the number of transactions of each account that wait for their inclusion
+// at the same time. By default, each account waits for the inclusion of its transaction before
+// broadcasting a new one, which limits the load to one transaction per account and block.
+func WithPipeline(depth int) Option {
+	return func(b *bench) {
+		b.pipeline = depth
+	}
+}
+
+// Run broadcasts transactions concurrently from the accounts to the chain of the client
+// and measures the performance of the chain. Each account broadcasts a new transaction
+// once one of its pipelined transactions is included in a block, so the accounts must
+// be funded and the number of accounts and the pipeline depth define the load.
+func Run(
+	ctx context.Context,
+	client cosmosclient.Client,
+	accounts []cosmosaccount.Account,
+	addressPrefix string,
+	newMsg MsgFactory,
+	options ...Option,
+) (Result, error) {
+	if len(accounts) == 0 {
+		return Result{}, errors.New("at least one account is required")
+	}
+
+	b := &bench{
+		client:       client,
+		duration:     DefaultDuration,
+		drainTimeout: DefaultDrainTimeout,
+		pipeline:     DefaultPipeline,
+		included:     make(map[string]inclusion),
+		waiters:      make(map[string]chan inclusion),
+	}
+	for _, apply := range options {
+		apply(b)
+	}
+
+	if b.pipeline < 1 {
+		return Result{}, errors.New("the pipeline depth must be positive")
+	}
+
+	addresses := make([]string, len(accounts))
+	for i, account := range accounts {
+		address, err := account.Address(addressPrefix)
+		if err != nil {
+			return Result{}, err
+		}
+		addresses[i] = address
+	}
+
+	height, err := client.LatestBlockHeight(ctx)
+	if err != nil {
+		return Result{}, err
+	}
+
+	watchCtx, stopWatch := context.WithCancel(ctx)
+	defer stopWatch()
+
+	watchErr := make(chan error, 1)
+	go func() {
+		watchErr <- b.watch(watchCtx, height)
+	}()
+
+	// New transactions are broadcasted until the load ends, then the accounts
+	// wait for the inclusion of their last transaction until the drain timeout
+	loadCtx, stopLoad := context.WithTimeout(ctx, b.duration)
+	defer stopLoad()
+
+	drainCtx, stopDrain := context.WithTimeout(ctx, b.duration+b.drainTimeout)
+	defer stopDrain()
+
+	start := time.Now()
+
+	g, gCtx := errgroup.WithContext(drainCtx)
+	for i, account := range accounts {
+		i, account := i, account
+		g.Go(func() error {
+			recipient := addresses[(i+1)%len(addresses)]
+			return b.load(gCtx, loadCtx, account, addresses[i], recipient, newMsg)
+		})
+	}
+
+	if err := g.Wait(); err != nil && !errors.Is(err, context.DeadlineExceeded) {
+		return Result{}, err
+	}
+
+	stopWatch()
+	if err := <-watchErr; err != nil && !errors.Is(err, context.Canceled) {
+		return Result{}, err
+	}
+
+	if err := ctx.Err(); err != nil {
+		return Result{}, err
+	}
+
+	return b.result(len(accounts), time.Since(start)), nil
+}
+
+// load broadcasts the transactions of an account until the load context is done.
+// The pipelined transactions are signed with the sequence tracked by the account,
+// because the sequence of the account in the chain only changes once they are included.
+func (b *bench) load(
+	ctx, loadCtx context.Context,
+	account cosmosaccount.Account,
+	sender, recipient string,
+	newMsg MsgFactory,
+) error {
+	// The client fetches the sequence from the chain while it's not tracked
+	client := b.client
+	pending := make(chan struct{}, b.pipeline)
+
+	g, gCtx := errgroup.WithContext(ctx)
+	g.Go(func() error {
+		for seq := 0; loadCtx.Err() == nil; seq++ {
+			// Wait for the inclusion of a pending transaction when the pipeline is full
+			select {
+			case <-gCtx.Done():
+				return gCtx.Err()
+			case <-loadCtx.Done():
+				return nil
+			case pending <- struct{}{}:
+			}
+
+			msg, err := newMsg(gCtx, sender, recipient, seq)
+			if err != nil {
+				return err
+			}
+
+			tx, err := client.CreateTx(gCtx, account, msg)
+			if err != nil {
+				return errors.Errorf("account %s: %w", account.Name, err)
+			}
+
+			sentAt := time.Now()
+			hash, err := tx.BroadcastSync(gCtx)
+			if err != nil {
+				if gCtx.Err() != nil {
+					return gCtx.Err()
+				}
+
+				// Transactions rejected by the mempool are counted as failed
+				b.mu.Lock()
+				b.failed++
+				b.mu.Unlock()
+
+				// The sequence is fetched again from the chain once the pending
+				// transactions are included because the rejected one was not
+				for i := 1; i < b.pipeline; i++ {
+					pending <- struct{}{}
+				}
+				client.TxFactory = b.client.TxFactory
+				for i := 0; i < b.pipeline; i++ {
+					<-pending
+				}
+
+				select {
+				case <-loadCtx.Done():
+				case <-time.After(retryInterval):
+				}
+				continue
+			}
+
+			client.TxFactory = client.TxFactory.WithSequence(tx.Sequence() + 1)
+
+			g.Go(func() error {
+				defer func() { <-pending }()
+				return b.wait(gCtx, hash, sentAt)
+			})
+		}
+
+		return nil
+	})
+
+	return g.Wait()
+}
+
+// wait waits for the inclusion of a transaction in a block and records its latency.
+func (b *bench) wait(ctx context.Context, hash string, sentAt time.Time) error {
+	b.mu.Lock()
+	b.sent++
+
+	ch := make(chan inclusion, 1)
+	if in, ok := b.included[hash]; ok {
+		ch <- in
+	} else {
+		b.waiters[hash] = ch
+	}
+	b.mu.Unlock()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case in := <-ch:
+		b.mu.Lock()
+		defer b.mu.Unlock()
+
+		if in.code != 0 {
+			b.failed++
+			return nil
+		}
+
+		b.committed++
+		b.latency = append(b.latency, float64(max(in.time.Sub(sentAt), 0).Milliseconds()))
+
+		return nil
+	}
+}
+
+// watch records the blocks committed after a height and the size of the mempool
+// until the context is done.
+func (b *bench) watch(ctx context.Context, height int64) error {
+	ticker := time.NewTicker(pollInterval)
+	defer ticker.Stop()
+
+	var lastBlockTime time.Time
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+
+		latest, err := b.client.LatestBlockHeight(ctx)
+		if err != nil {
+			return err
+		}
+
+		for ; height < latest; height++ {
+			if lastBlockTime, err = b.recordBlock(ctx, height+1, lastBlockTime); err != nil {
+				return err
+			}
+		}
+
+		unconfirmed, err := b.client.RPC.NumUnconfirmedTxs(ctx)
+		if err != nil {
+			return errors.Errorf("failed to fetch the mempool size: %w", err)
+		}
+
+		b.mu.Lock()
+		b.mempool = append(b.mempool, float64(unconfirmed.Total))
+		b.mu.Unlock()
+	}
+}
+
+// recordBlock records the block time and the gas of a block and notifies the inclusion
+// of its transactions. The time of the block is returned to compute the next block time.
+func (b *bench) recordBlock(ctx context.Context, height int64, lastBlockTime time.Time) (time.Time, error) {
+	block, err := b.client.RPC.Block(ctx, &height)
+	if err != nil {
+		return time.Time{}, errors.Errorf("failed to fetch block %d: %w", height, err)
+	}
+
+	results, err := b.client.RPC.BlockResults(ctx, &height)
+	if err != nil {
+		return time.Time{}, errors.Errorf("failed to fetch the results of block %d: %w", height, err)
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	blockTime := block.Block.Time
+	if !lastBlockTime.IsZero() {
+		b.blockTime = append(b.blockTime, float64(blockTime.Sub(lastBlockTime).Milliseconds()))
+	}
+
+	var gas int64
+	for i, tx := range block.Block.Data.Txs {
+		var code uint32
+		if i < len(results.TxsResults) {
+			code = results.TxsResults[i].Code
+			gas += results.TxsResults[i].GasUsed
+		}
+
+		hash := fmt.Sprintf("%X", tx.Hash())
+		in := inclusion{time: blockTime, code: code}
+		if ch, ok := b.waiters[hash]; ok {
+			ch <- in
+			delete(b.waiters, hash)
+		} else {
+			// The transaction can be included before the account waits for it
+			b.included[hash] = in
+		}
+	}
+
+	b.gas = append(b.gas, float64(gas))
+	b.blocks++
+
+	return blockTime, nil
+}
+
+func (b *bench) result(accounts int, elapsed time.Duration) Result {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	r := Result{
+		Accounts:         accounts,
+		Pipeline:         b.pipeline,
+		Duration:         elapsed.Seconds(),
+		TxsSent:          b.sent,
+		TxsCommitted:     b.committed,
+		TxsFailed:        b.failed,
+		Blocks:           b.blocks,
+		BlockTime:        NewStats(b.blockTime),
+		GasPerBlock:      NewStats(b.gas),
+		MempoolSize:      NewStats(b.mempool),
+		InclusionLatency: NewStats(b.latency),
+	}
+	if elapsed > 0 {
+		r.TPS = float64(b.committed) / elapsed.Seconds()
+	}
+
+	return r
+}
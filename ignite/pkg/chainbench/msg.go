@@ -0,0 +1,80 @@
+package chainbench
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"text/template"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	"github.com/ignite/cli/v29/ignite/pkg/cosmosmsg"
+	"github.com/ignite/cli/v29/ignite/pkg/errors"
+	"github.com/ignite/cli/v29/ignite/pkg/xyaml"
+)
+
+type (
+	// MsgFactory creates the message of the transaction number seq that an account sends.
+	// The recipient is the address of another bench account.
+	MsgFactory func(ctx context.Context, sender, recipient string, seq int) (sdk.Msg, error)
+
+	// Decoder decodes messages from their proto JSON representation.
+	Decoder interface {
+		DecodeJSON(ctx context.Context, bz []byte) (*cosmosmsg.Msg, error)
+	}
+
+	// templateData is the data available to the message templates.
+	templateData struct {
+		Sender    string
+		Recipient string
+		Seq       int
+	}
+)
+
+// BankSend returns a factory of bank send messages that send an amount to the recipient.
+func BankSend(amount sdk.Coins) MsgFactory {
+	return func(_ context.Context, sender, recipient string, _ int) (sdk.Msg, error) {
+		return &banktypes.MsgSend{FromAddress: sender, ToAddress: recipient, Amount: amount}, nil
+	}
+}
+
+// MsgTemplates returns a factory of messages defined using their proto JSON representation,
+// including the message type in the "@type" key. The string values of the messages are
+// templates that can use the {{ .Sender }}, {{ .Recipient }} and {{ .Seq }} placeholders.
+// The messages are used in turns for each transaction of an account.
+func MsgTemplates(decoder Decoder, messages []xyaml.Map) (MsgFactory, error) {
+	if len(messages) == 0 {
+		return nil, errors.New("at least one message template is required")
+	}
+
+	templates := make([]*template.Template, len(messages))
+	for i, m := range messages {
+		bz, err := json.Marshal(m)
+		if err != nil {
+			return nil, err
+		}
+
+		if templates[i], err = template.New("msg").Option("missingkey=error").Parse(string(bz)); err != nil {
+			return nil, errors.Errorf("invalid message template #%d: %w", i+1, err)
+		}
+	}
+
+	return func(ctx context.Context, sender, recipient string, seq int) (sdk.Msg, error) {
+		var (
+			buf = bytes.Buffer{}
+			n   = seq % len(templates)
+		)
+		data := templateData{Sender: sender, Recipient: recipient, Seq: seq}
+		if err := templates[n].Execute(&buf, data); err != nil {
+			return nil, errors.Errorf("message template #%d: %w", n+1, err)
+		}
+
+		msg, err := decoder.DecodeJSON(ctx, buf.Bytes())
+		if err != nil {
+			return nil, errors.Errorf("message template #%d: %w", n+1, err)
+		}
+
+		return msg, nil
+	}, nil
+}
@@ -0,0 +1,81 @@
+package chainbench_test
+
+import (
+	"context"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/cli/v29/ignite/pkg/chainbench"
+	"github.com/ignite/cli/v29/ignite/pkg/cosmosmsg"
+	"github.com/ignite/cli/v29/ignite/pkg/xyaml"
+)
+
+// jsonDecoder is a decoder that keeps the JSON of the messages as their value.
+type jsonDecoder struct{}
+
+func (jsonDecoder) DecodeJSON(_ context.Context, bz []byte) (*cosmosmsg.Msg, error) {
+	return cosmosmsg.NewMsg("json", bz), nil
+}
+
+func TestBankSend(t *testing.T) {
+	// Arrange
+	amount := sdk.NewCoins(sdk.NewInt64Coin("stake", 1))
+	newMsg := chainbench.BankSend(amount)
+
+	// Act
+	msg, err := newMsg(context.Background(), "cosmos1a", "cosmos1b", 3)
+
+	// Assert
+	require.NoError(t, err)
+	require.Equal(t, &banktypes.MsgSend{FromAddress: "cosmos1a", ToAddress: "cosmos1b", Amount: amount}, msg)
+}
+
+func TestMsgTemplates(t *testing.T) {
+	// Arrange
+	messages := []xyaml.Map{
+		{
+			"@type":   "/mars.mars.MsgCreatePost",
+			"creator": "{{ .Sender }}",
+			"title":   "post {{ .Seq }}",
+		},
+		{
+			"@type": "/mars.mars.MsgFollow",
+			"from":  "{{ .Sender }}",
+			"to":    "{{ .Recipient }}",
+		},
+	}
+
+	newMsg, err := chainbench.MsgTemplates(jsonDecoder{}, messages)
+	require.NoError(t, err)
+
+	var values []string
+
+	// Act
+	for seq := 0; seq < 3; seq++ {
+		msg, err := newMsg(context.Background(), "cosmos1a", "cosmos1b", seq)
+		require.NoError(t, err)
+
+		value, err := msg.(*cosmosmsg.Msg).Marshal()
+		require.NoError(t, err)
+
+		values = append(values, string(value))
+	}
+
+	// Assert
+	require.Equal(t, []string{
+		`{"@type":"/mars.mars.MsgCreatePost","creator":"cosmos1a","title":"post 0"}`,
+		`{"@type":"/mars.mars.MsgFollow","from":"cosmos1a","to":"cosmos1b"}`,
+		`{"@type":"/mars.mars.MsgCreatePost","creator":"cosmos1a","title":"post 2"}`,
+	}, values)
+}
+
+func TestMsgTemplatesInvalid(t *testing.T) {
+	// Act
+	_, err := chainbench.MsgTemplates(jsonDecoder{}, []xyaml.Map{{"@type": "{{ .Sender"}})
+
+	// Assert
+	require.ErrorContains(t, err, "invalid message template #1")
+}
@@ -0,0 +1,43 @@
+package chainbench
+
+import (
+	"math"
+	"sort"
+)
+
+// Stats summarizes a set of samples.
+type Stats struct {
+	Avg float64 `json:"avg"`
+	P50 float64 `json:"p50"`
+	P95 float64 `json:"p95"`
+	Max float64 `json:"max"`
+}
+
+// NewStats returns the stats of a set of samples.
+func NewStats(samples []float64) Stats {
+	if len(samples) == 0 {
+		return Stats{}
+	}
+
+	sorted := make([]float64, len(samples))
+	copy(sorted, samples)
+	sort.Float64s(sorted)
+
+	var sum float64
+	for _, s := range sorted {
+		sum += s
+	}
+
+	return Stats{
+		Avg: sum / float64(len(sorted)),
+		P50: percentile(sorted, 50),
+		P95: percentile(sorted, 95),
+		Max: sorted[len(sorted)-1],
+	}
+}
+
+// percentile returns the percentile of sorted samples using the nearest rank method.
+func percentile(sorted []float64, p float64) float64 {
+	rank := int(math.Ceil(p / 100 * float64(len(sorted))))
+	return sorted[max(rank, 1)-1]
+}
@@ -0,0 +1,55 @@
+package chainbench_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/cli/v29/ignite/pkg/chainbench"
+)
+
+func TestNewStats(t *testing.T) {
+	cases := []struct {
+		name    string
+		samples []float64
+		want    chainbench.Stats
+	}{
+		{
+			name: "no samples",
+			want: chainbench.Stats{},
+		},
+		{
+			name:    "single sample",
+			samples: []float64{5},
+			want:    chainbench.Stats{Avg: 5, P50: 5, P95: 5, Max: 5},
+		},
+		{
+			name:    "unsorted samples",
+			samples: []float64{10, 1, 9, 2, 8, 3, 7, 4, 6, 5},
+			want:    chainbench.Stats{Avg: 5.5, P50: 5, P95: 10, Max: 10},
+		},
+		{
+			name:    "many samples",
+			samples: rangeOf(1, 100),
+			want:    chainbench.Stats{Avg: 50.5, P50: 50, P95: 95, Max: 100},
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			// Act
+			stats := chainbench.NewStats(tt.samples)
+
+			// Assert
+			require.Equal(t, tt.want, stats)
+		})
+	}
+}
+
+func rangeOf(from, to int) []float64 {
+	var samples []float64
+	for i := from; i <= to; i++ {
+		samples = append(samples, float64(i))
+	}
+	return samples
+}
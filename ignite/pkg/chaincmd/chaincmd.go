@@ -52,6 +52,8 @@ const (
 	optionCoinType                         = "--coin-type"
 	optionVestingAmount                    = "--vesting-amount"
 	optionVestingEndTime                   = "--vesting-end-time"
+	optionVestingStartTime                 = "--vesting-start-time"
+	optionModuleName                       = "--module-name"
 	optionBroadcastMode                    = "--broadcast-mode"
 	optionAccount                          = "--account"
 	optionIndex                            = "--index"
@@ -270,8 +272,31 @@ func (c ChainCmd) ListKeysCommand() step.Option {
 	return c.cliCommand(command)
 }
 
+// GenesisAccountOption for the AddGenesisAccountCommand and AddVestingAccountCommand.
+type GenesisAccountOption func([]string) []string
+
+// GenesisAccountWithModuleName provides module name option to add the account as a module account.
+func GenesisAccountWithModuleName(moduleName string) GenesisAccountOption {
+	return func(command []string) []string {
+		if len(moduleName) > 0 {
+			return append(command, optionModuleName, moduleName)
+		}
+		return command
+	}
+}
+
+// GenesisAccountWithVestingStartTime provides vesting start time option to add a continuous vesting account.
+func GenesisAccountWithVestingStartTime(vestingStartTime int64) GenesisAccountOption {
+	return func(command []string) []string {
+		if vestingStartTime > 0 {
+			return append(command, optionVestingStartTime, fmt.Sprintf("%d", vestingStartTime))
+		}
+		return command
+	}
+}
+
 // AddGenesisAccountCommand returns the command to add a new account in the genesis file of the chain.
-func (c ChainCmd) AddGenesisAccountCommand(address, coins string) step.Option {
+func (c ChainCmd) AddGenesisAccountCommand(address, coins string, options ...GenesisAccountOption) step.Option {
 	command := []string{
 		commandGenesis,
 		commandAddGenesisAccount,
@@ -279,11 +304,24 @@ func (c ChainCmd) AddGenesisAccountCommand(address, coins string) step.Option {
 		coins,
 	}
 
+	// Apply the options provided by the user
+	for _, apply := range options {
+		command = apply(command)
+	}
+
 	return c.daemonCommand(command)
 }
 
-// AddVestingAccountCommand returns the command to add a delayed vesting account in the genesis file of the chain.
-func (c ChainCmd) AddVestingAccountCommand(address, originalCoins, vestingCoins string, vestingEndTime int64) step.Option {
+// AddVestingAccountCommand returns the command to add a vesting account in the genesis file of the chain.
+// The account is a delayed vesting account unless a vesting start time option is provided,
+// in which case it is a continuous vesting account.
+func (c ChainCmd) AddVestingAccountCommand(
+	address,
+	originalCoins,
+	vestingCoins string,
+	vestingEndTime int64,
+	options ...GenesisAccountOption,
+) step.Option {
 	command := []string{
 		commandGenesis,
 		commandAddGenesisAccount,
@@ -295,6 +333,11 @@ func (c ChainCmd) AddVestingAccountCommand(address, originalCoins, vestingCoins
 		fmt.Sprintf("%d", vestingEndTime),
 	}
 
+	// Apply the options provided by the user
+	for _, apply := range options {
+		command = apply(command)
+	}
+
 	return c.daemonCommand(command)
 }
 
@@ -7,6 +7,7 @@ import (
 	"os"
 	"strings"
 
+	"github.com/ignite/cli/v29/ignite/pkg/chaincmd"
 	"github.com/ignite/cli/v29/ignite/pkg/cmdrunner/step"
 	"github.com/ignite/cli/v29/ignite/pkg/errors"
 )
@@ -210,8 +211,8 @@ func (r Runner) ShowAccount(ctx context.Context, name string) (Account, error) {
 }
 
 // AddGenesisAccount adds account to genesis by its address.
-func (r Runner) AddGenesisAccount(ctx context.Context, address, coins string) error {
-	return r.run(ctx, runOptions{}, r.chainCmd.AddGenesisAccountCommand(address, coins))
+func (r Runner) AddGenesisAccount(ctx context.Context, address, coins string, options ...chaincmd.GenesisAccountOption) error {
+	return r.run(ctx, runOptions{}, r.chainCmd.AddGenesisAccountCommand(address, coins, options...))
 }
 
 // AddVestingAccount adds vesting account to genesis by its address.
@@ -221,6 +222,7 @@ func (r Runner) AddVestingAccount(
 	originalCoins,
 	vestingCoins string,
 	vestingEndTime int64,
+	options ...chaincmd.GenesisAccountOption,
 ) error {
-	return r.run(ctx, runOptions{}, r.chainCmd.AddVestingAccountCommand(address, originalCoins, vestingCoins, vestingEndTime))
+	return r.run(ctx, runOptions{}, r.chainCmd.AddVestingAccountCommand(address, originalCoins, vestingCoins, vestingEndTime, options...))
 }
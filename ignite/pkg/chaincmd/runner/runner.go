@@ -39,6 +39,14 @@ func Stderr(w io.Writer) Option {
 	}
 }
 
+// Tee writes a copy of stdout and stderr of executed commands to w.
+func Tee(w io.Writer) Option {
+	return func(runner *Runner) {
+		runner.stdout = io.MultiWriter(runner.stdout, w)
+		runner.stderr = io.MultiWriter(runner.stderr, w)
+	}
+}
+
 // New creates a new Runner with cc and options.
 func New(ctx context.Context, chainCmd chaincmd.ChainCmd, options ...Option) (Runner, error) {
 	runner := Runner{
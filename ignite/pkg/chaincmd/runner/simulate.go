@@ -2,11 +2,13 @@ package chaincmdrunner
 
 import (
 	"context"
+	"io"
 	"os"
 
 	"github.com/cosmos/cosmos-sdk/types/simulation"
 
 	"github.com/ignite/cli/v29/ignite/pkg/chaincmd"
+	"github.com/ignite/cli/v29/ignite/pkg/cmdrunner/step"
 )
 
 // Simulation run the chain simulation.
@@ -18,27 +20,60 @@ func (r Runner) Simulation(
 	config simulation.Config,
 	period uint,
 	genesisTime int64,
+	mode chaincmd.SimulationMode,
+	options ...chaincmd.SimappOption,
 ) error {
 	return r.run(ctx, runOptions{stdout: os.Stdout},
-		chaincmd.SimulationCommand(
-			appPath,
-			chaincmd.SimappWithGenesis(config.GenesisFile),
-			chaincmd.SimappWithParams(config.ParamsFile),
-			chaincmd.SimappWithExportParamsPath(config.ExportParamsPath),
-			chaincmd.SimappWithExportParamsHeight(config.ExportParamsHeight),
-			chaincmd.SimappWithExportStatePath(config.ExportStatePath),
-			chaincmd.SimappWithExportStatsPath(config.ExportStatsPath),
-			chaincmd.SimappWithSeed(config.Seed),
-			chaincmd.SimappWithInitialBlockHeight(config.InitialBlockHeight),
-			chaincmd.SimappWithNumBlocks(config.NumBlocks),
-			chaincmd.SimappWithBlockSize(config.BlockSize),
-			chaincmd.SimappWithLean(config.Lean),
-			chaincmd.SimappWithCommit(config.Commit),
-			chaincmd.SimappWithSimulateEveryOperation(config.OnOperation),
-			chaincmd.SimappWithPrintAllInvariants(config.AllInvariants),
-			chaincmd.SimappWithEnable(enabled),
-			chaincmd.SimappWithVerbose(verbose),
-			chaincmd.SimappWithPeriod(period),
-			chaincmd.SimappWithGenesisTime(genesisTime),
-		))
+		simulationCommand(appPath, enabled, verbose, config, period, genesisTime, mode, options))
+}
+
+// SimulationWithOutput runs the chain simulation writing its output to w instead of the standard output.
+func (r Runner) SimulationWithOutput(
+	ctx context.Context,
+	w io.Writer,
+	appPath string,
+	enabled bool,
+	verbose bool,
+	config simulation.Config,
+	period uint,
+	genesisTime int64,
+	mode chaincmd.SimulationMode,
+	options ...chaincmd.SimappOption,
+) error {
+	return r.run(ctx, runOptions{stdout: w, stderr: w},
+		simulationCommand(appPath, enabled, verbose, config, period, genesisTime, mode, options))
+}
+
+func simulationCommand(
+	appPath string,
+	enabled bool,
+	verbose bool,
+	config simulation.Config,
+	period uint,
+	genesisTime int64,
+	mode chaincmd.SimulationMode,
+	options []chaincmd.SimappOption,
+) step.Option {
+	simappOptions := []chaincmd.SimappOption{
+		chaincmd.SimappWithGenesis(config.GenesisFile),
+		chaincmd.SimappWithParams(config.ParamsFile),
+		chaincmd.SimappWithExportParamsPath(config.ExportParamsPath),
+		chaincmd.SimappWithExportParamsHeight(config.ExportParamsHeight),
+		chaincmd.SimappWithExportStatePath(config.ExportStatePath),
+		chaincmd.SimappWithExportStatsPath(config.ExportStatsPath),
+		chaincmd.SimappWithSeed(config.Seed),
+		chaincmd.SimappWithInitialBlockHeight(config.InitialBlockHeight),
+		chaincmd.SimappWithNumBlocks(config.NumBlocks),
+		chaincmd.SimappWithBlockSize(config.BlockSize),
+		chaincmd.SimappWithLean(config.Lean),
+		chaincmd.SimappWithCommit(config.Commit),
+		chaincmd.SimappWithSimulateEveryOperation(config.OnOperation),
+		chaincmd.SimappWithPrintAllInvariants(config.AllInvariants),
+		chaincmd.SimappWithEnable(enabled),
+		chaincmd.SimappWithVerbose(verbose),
+		chaincmd.SimappWithPeriod(period),
+		chaincmd.SimappWithGenesisTime(genesisTime),
+	}
+
+	return chaincmd.SimulationModeCommand(appPath, mode, append(simappOptions, options...)...)
 }
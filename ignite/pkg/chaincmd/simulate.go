@@ -1,10 +1,12 @@
 package chaincmd
 
 import (
+	"fmt"
 	"path/filepath"
 	"strconv"
 
 	"github.com/ignite/cli/v29/ignite/pkg/cmdrunner/step"
+	"github.com/ignite/cli/v29/ignite/pkg/errors"
 	"github.com/ignite/cli/v29/ignite/pkg/gocmd"
 )
 
@@ -27,6 +29,7 @@ const (
 	optionSimappVerbose                = "-Verbose"
 	optionSimappPeriod                 = "-Period"
 	optionSimappGenesisTime            = "-GenesisTime"
+	optionSimappNumTimesToRunPerSeed   = "-NumTimesToRunPerSeed"
 
 	commandGoTest       = "test"
 	optionGoBenchmem    = "-benchmem"
@@ -34,6 +37,66 @@ const (
 	optionGoSimappBench = "-bench=^BenchmarkSimulation"
 )
 
+// SimulationMode is the kind of simulation run, each mode runs a different simulation test of the app.
+type SimulationMode string
+
+const (
+	// SimulationModeFull runs the full app simulation.
+	SimulationModeFull SimulationMode = "full"
+
+	// SimulationModeImportExport runs the simulation, exports the state and imports it into
+	// a new app to check that the stores of both apps are equal.
+	SimulationModeImportExport SimulationMode = "import-export"
+
+	// SimulationModeAfterImport runs the simulation, exports the state, imports it into
+	// a new app and runs the simulation again on the new app.
+	SimulationModeAfterImport SimulationMode = "after-import"
+
+	// SimulationModeDeterminism runs the simulation with the same seed many times and
+	// checks that the app hash is the same in each run.
+	SimulationModeDeterminism SimulationMode = "determinism"
+)
+
+// simulationTests are the names of the app tests run for each simulation mode.
+var simulationTests = map[SimulationMode]string{
+	SimulationModeImportExport: "TestAppImportExport",
+	SimulationModeAfterImport:  "TestAppSimulationAfterImport",
+	SimulationModeDeterminism:  "TestAppStateDeterminism",
+}
+
+// SimulationModes returns all the simulation modes.
+func SimulationModes() []SimulationMode {
+	return []SimulationMode{
+		SimulationModeFull,
+		SimulationModeImportExport,
+		SimulationModeAfterImport,
+		SimulationModeDeterminism,
+	}
+}
+
+// ParseSimulationMode parses the name of a simulation mode.
+func ParseSimulationMode(name string) (SimulationMode, error) {
+	for _, mode := range SimulationModes() {
+		if string(mode) == name {
+			return mode, nil
+		}
+	}
+	return "", errors.Errorf(
+		"unsupported simulation mode %q, use %s, %s, %s or %s",
+		name,
+		SimulationModeFull,
+		SimulationModeImportExport,
+		SimulationModeAfterImport,
+		SimulationModeDeterminism,
+	)
+}
+
+// Test returns the name of the app test that runs the simulation mode, or
+// an empty string for the full simulation, which runs as a benchmark.
+func (m SimulationMode) Test() string {
+	return simulationTests[m]
+}
+
 // SimappOption for the SimulateCommand.
 type SimappOption func([]string) []string
 
@@ -203,15 +266,34 @@ func SimappWithGenesisTime(genesisTime int64) SimappOption {
 	}
 }
 
+// SimappWithNumTimesToRunPerSeed provides the option to set the number of times that
+// the determinism simulation runs with the same seed.
+func SimappWithNumTimesToRunPerSeed(numTimes int) SimappOption {
+	return func(command []string) []string {
+		if numTimes > 0 {
+			return append(command, optionSimappNumTimesToRunPerSeed, strconv.Itoa(numTimes))
+		}
+		return command
+	}
+}
+
 // SimulationCommand returns the cli command for simapp tests.
 func SimulationCommand(appPath string, options ...SimappOption) step.Option {
+	return SimulationModeCommand(appPath, SimulationModeFull, options...)
+}
+
+// SimulationModeCommand returns the cli command for the simapp test of a simulation mode.
+func SimulationModeCommand(appPath string, mode SimulationMode, options ...SimappOption) step.Option {
 	command := []string{
 		commandGoTest,
 		optionGoBenchmem,
 		optionGoSimappRun,
 		optionGoSimappBench,
-		filepath.Join(appPath, "app"),
 	}
+	if test := mode.Test(); test != "" {
+		command = []string{commandGoTest, fmt.Sprintf("-run=^%s$", test)}
+	}
+	command = append(command, filepath.Join(appPath, "app"))
 
 	// Apply the options provided by the user
 	for _, applyOption := range options {
@@ -12,7 +12,7 @@ import (
 )
 
 // Sum reads files from dirPath, calculates sha256 for each file and creates a new checksum
-// file for them in outPath.
+// file for them in outPath. Subdirectories of dirPath are ignored.
 func Sum(dirPath, outPath string) error {
 	var b bytes.Buffer
 
@@ -22,6 +22,11 @@ func Sum(dirPath, outPath string) error {
 	}
 
 	for _, info := range files {
+		// Only the files of the directory are included
+		if info.IsDir() {
+			continue
+		}
+
 		path := filepath.Join(dirPath, info.Name())
 		f, err := os.Open(path)
 		if err != nil {
@@ -52,7 +57,13 @@ func Binary(binaryName string) (string, error) {
 	if err != nil {
 		return "", err
 	}
-	f, err := os.Open(binaryPath)
+
+	return File(binaryPath)
+}
+
+// File returns SHA256 hash of a file.
+func File(path string) (string, error) {
+	f, err := os.Open(path)
 	if err != nil {
 		return "", err
 	}
@@ -0,0 +1,43 @@
+package checksum_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/cli/v29/ignite/pkg/checksum"
+)
+
+func TestSum(t *testing.T) {
+	// Arrange
+	dir := t.TempDir()
+	outPath := filepath.Join(t.TempDir(), "checksum.txt")
+
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "mars_linux_amd64.tar.gz"), []byte("hello"), 0o644))
+	require.NoError(t, os.MkdirAll(filepath.Join(dir, "cosmovisor", "upgrades"), 0o755))
+
+	// Act
+	err := checksum.Sum(dir, outPath)
+
+	// Assert
+	require.NoError(t, err)
+
+	bz, err := os.ReadFile(outPath)
+	require.NoError(t, err)
+	require.Equal(t, "2cf24dba5fb0a30e26e83b2ac5b9e29e1b161e5c1fa7425e73043362938b9824  mars_linux_amd64.tar.gz\n", string(bz))
+}
+
+func TestFile(t *testing.T) {
+	// Arrange
+	path := filepath.Join(t.TempDir(), "marsd")
+	require.NoError(t, os.WriteFile(path, []byte("hello"), 0o755))
+
+	// Act
+	sum, err := checksum.File(path)
+
+	// Assert
+	require.NoError(t, err)
+	require.Equal(t, "2cf24dba5fb0a30e26e83b2ac5b9e29e1b161e5c1fa7425e73043362938b9824", sum)
+}
@@ -0,0 +1,77 @@
+package checksum
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/pem"
+	"os"
+
+	"github.com/ignite/cli/v29/ignite/pkg/errors"
+)
+
+// SignatureExt is the extension of the signature files created by Sign.
+const SignatureExt = ".sig"
+
+// Sign signs the file in path with the PEM encoded PKCS #8 private key in keyPath and
+// writes the raw signature to a new file with the same path and the ".sig" extension.
+// Ed25519 keys sign the file contents while ECDSA and RSA keys sign its SHA256 digest,
+// which allows to verify the signature using tools like OpenSSL:
+//
+//	openssl pkeyutl -verify -pubin -inkey key.pub -rawin -in file -sigfile file.sig
+//	openssl dgst -sha256 -verify key.pub -signature file.sig file
+func Sign(path, keyPath string) (signaturePath string, err error) {
+	key, err := readPrivateKey(keyPath)
+	if err != nil {
+		return "", err
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return "", err
+	}
+
+	var signature []byte
+	switch k := key.(type) {
+	case ed25519.PrivateKey:
+		signature = ed25519.Sign(k, data)
+	case *ecdsa.PrivateKey, *rsa.PrivateKey:
+		digest := sha256.Sum256(data)
+		signature, err = k.(crypto.Signer).Sign(rand.Reader, digest[:], crypto.SHA256)
+		if err != nil {
+			return "", err
+		}
+	default:
+		return "", errors.Errorf("unsupported signing key type %T", key)
+	}
+
+	signaturePath = path + SignatureExt
+	if err := os.WriteFile(signaturePath, signature, 0o644); err != nil {
+		return "", err
+	}
+
+	return signaturePath, nil
+}
+
+func readPrivateKey(path string) (crypto.PrivateKey, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, errors.Errorf("signing key %s is not PEM encoded", path)
+	}
+
+	key, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, errors.Errorf("invalid signing key %s: %w", path, err)
+	}
+
+	return key, nil
+}
@@ -0,0 +1,103 @@
+package checksum_test
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/pem"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/cli/v29/ignite/pkg/checksum"
+)
+
+func TestSign(t *testing.T) {
+	data := []byte("2cf24dba5fb0a30e26e83b2ac5b9e29e1b161e5c1fa7425e73043362938b9824  mars_linux_amd64.tar.gz\n")
+	digest := sha256.Sum256(data)
+
+	_, edKey, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+
+	ecKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	cases := []struct {
+		name   string
+		key    crypto.Signer
+		verify func(t *testing.T, signature []byte)
+	}{
+		{
+			name: "ed25519",
+			key:  edKey,
+			verify: func(t *testing.T, signature []byte) {
+				require.True(t, ed25519.Verify(edKey.Public().(ed25519.PublicKey), data, signature))
+			},
+		},
+		{
+			name: "ecdsa",
+			key:  ecKey,
+			verify: func(t *testing.T, signature []byte) {
+				require.True(t, ecdsa.VerifyASN1(&ecKey.PublicKey, digest[:], signature))
+			},
+		},
+		{
+			name: "rsa",
+			key:  rsaKey,
+			verify: func(t *testing.T, signature []byte) {
+				require.NoError(t, rsa.VerifyPKCS1v15(&rsaKey.PublicKey, crypto.SHA256, digest[:], signature))
+			},
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			dir := t.TempDir()
+			path := filepath.Join(dir, "release_checksum")
+			keyPath := filepath.Join(dir, "key.pem")
+
+			der, err := x509.MarshalPKCS8PrivateKey(tt.key)
+			require.NoError(t, err)
+			require.NoError(t, os.WriteFile(path, data, 0o644))
+			require.NoError(t, os.WriteFile(keyPath, pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der}), 0o600))
+
+			// Act
+			signaturePath, err := checksum.Sign(path, keyPath)
+
+			// Assert
+			require.NoError(t, err)
+			require.Equal(t, path+".sig", signaturePath)
+
+			signature, err := os.ReadFile(signaturePath)
+			require.NoError(t, err)
+			tt.verify(t, signature)
+		})
+	}
+}
+
+func TestSignInvalidKey(t *testing.T) {
+	// Arrange
+	dir := t.TempDir()
+	path := filepath.Join(dir, "release_checksum")
+	keyPath := filepath.Join(dir, "key.pem")
+
+	require.NoError(t, os.WriteFile(path, []byte("checksum"), 0o644))
+	require.NoError(t, os.WriteFile(keyPath, []byte("not a key"), 0o600))
+
+	// Act
+	_, err := checksum.Sign(path, keyPath)
+
+	// Assert
+	require.ErrorContains(t, err, "is not PEM encoded")
+}
@@ -0,0 +1,54 @@
+package crashview
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/ignite/cli/v29/ignite/pkg/cliui/colors"
+)
+
+type Option func(*Crash)
+
+// Crash displays a crashed process together with the output that explains the crash.
+type Crash struct {
+	Title   string
+	Trace   string
+	LogPath string
+}
+
+// WithLogPath sets the path of the file that contains the process logs.
+func WithLogPath(path string) Option {
+	return func(c *Crash) {
+		c.LogPath = path
+	}
+}
+
+func NewCrash(title, trace string, options ...Option) Crash {
+	c := Crash{
+		Title: title,
+		Trace: trace,
+	}
+
+	for _, apply := range options {
+		apply(&c)
+	}
+
+	return c
+}
+
+func (c Crash) String() string {
+	var b strings.Builder
+
+	b.WriteString(colors.Error(c.Title))
+
+	// The trace is not wrapped to keep the stack trace lines readable
+	if trace := strings.TrimSpace(c.Trace); trace != "" {
+		fmt.Fprintf(&b, "\n\n%s", trace)
+	}
+
+	if c.LogPath != "" {
+		fmt.Fprintf(&b, "\n\n%s %s", colors.Faint("Logs:"), c.LogPath)
+	}
+
+	return b.String()
+}
@@ -0,0 +1,35 @@
+package crashview_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ignite/cli/v29/ignite/pkg/cliui/colors"
+	"github.com/ignite/cli/v29/ignite/pkg/cliui/view/crashview"
+)
+
+func TestCrashString(t *testing.T) {
+	tests := []struct {
+		name  string
+		crash crashview.Crash
+		want  string
+	}{
+		{
+			name:  "crash with trace and log path",
+			crash: crashview.NewCrash("node crashed", "panic: boom\n\ngoroutine 1 [running]:\n", crashview.WithLogPath("/logs/alice.log")),
+			want:  colors.Error("node crashed") + "\n\npanic: boom\n\ngoroutine 1 [running]:\n\n" + colors.Faint("Logs:") + " /logs/alice.log",
+		},
+		{
+			name:  "crash without trace",
+			crash: crashview.NewCrash("node crashed", " \n"),
+			want:  colors.Error("node crashed"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.crash.String())
+		})
+	}
+}
@@ -74,7 +74,7 @@ func FindImplementation(modulePath string, interfaceList []string) (found []stri
 		for _, f := range pkg.Files {
 			files = append(files, f)
 		}
-		found = append(found, findImplementationInFiles(files, interfaceList)...)
+		found = append(found, FindImplementationInFiles(files, interfaceList)...)
 	}
 
 	return found, nil
@@ -105,8 +105,9 @@ func FindImplementationInFile(n ast.Node, interfaceList []string) (found []strin
 	return found
 }
 
-// findImplementationInFiles find all struct implements the interfaceList into a list of ast.File.
-func findImplementationInFiles(files []*ast.File, interfaceList []string) (found []string) {
+// FindImplementationInFiles find all struct implements the interfaceList into a list of ast.File.
+// Use it to find the implementations of a package whose methods are declared in different files.
+func FindImplementationInFiles(files []*ast.File, interfaceList []string) (found []string) {
 	// collect all structs under path to find out the ones that satisfies the implementation
 	structImplementations := make(map[string]implementation)
 
@@ -259,7 +260,7 @@ func FindAppFilePath(chainRoot string) (path string, err error) {
 			return err
 		}
 
-		currFound := findImplementationInFiles([]*ast.File{f}, AppImplementation)
+		currFound := FindImplementationInFiles([]*ast.File{f}, AppImplementation)
 		if len(currFound) > 0 {
 			found = append(found, path)
 		}
@@ -1,7 +1,10 @@
 package cosmosbuf
 
 import (
+	"bufio"
+	"bytes"
 	"context"
+	"encoding/json"
 	"fmt"
 	"os"
 	"path/filepath"
@@ -10,6 +13,7 @@ import (
 	"golang.org/x/sync/errgroup"
 
 	"github.com/ignite/cli/v29/ignite/pkg/cmdrunner/exec"
+	"github.com/ignite/cli/v29/ignite/pkg/cmdrunner/step"
 	"github.com/ignite/cli/v29/ignite/pkg/cosmosver"
 	"github.com/ignite/cli/v29/ignite/pkg/errors"
 	"github.com/ignite/cli/v29/ignite/pkg/protoanalysis"
@@ -27,6 +31,17 @@ type (
 		sdkProtoDir string
 		cache       *protoanalysis.Cache
 	}
+
+	// Annotation is a problem found in a proto file by the lint or breaking commands.
+	Annotation struct {
+		Path        string `json:"path"`
+		StartLine   int    `json:"start_line"`
+		StartColumn int    `json:"start_column"`
+		EndLine     int    `json:"end_line"`
+		EndColumn   int    `json:"end_column"`
+		Type        string `json:"type"`
+		Message     string `json:"message"`
+	}
 )
 
 const (
@@ -36,12 +51,18 @@ const (
 	flagErrorFormat = "error-format"
 	flagLogFormat   = "log-format"
 	flagOnly        = "only"
+	flagAgainst     = "against"
 	fmtJSON         = "json"
 
+	// exitCodeFileAnnotation is the exit code of buf when annotations are found.
+	exitCodeFileAnnotation = 100
+
 	// CMDGenerate generate command.
 	CMDGenerate Command = "generate"
 	CMDExport   Command = "export"
 	CMDMod      Command = "mod"
+	CMDLint     Command = "lint"
+	CMDBreaking Command = "breaking"
 )
 
 var (
@@ -49,6 +70,8 @@ var (
 		CMDGenerate: {},
 		CMDExport:   {},
 		CMDMod:      {},
+		CMDLint:     {},
+		CMDBreaking: {},
 	}
 
 	// ErrInvalidCommand indicates an invalid command name.
@@ -211,6 +234,48 @@ func (b Buf) Generate(
 	return g.Wait()
 }
 
+// Lint runs the buf Lint command for the files in the proto directory
+// and returns the annotations of the lint rules that failed.
+func (b Buf) Lint(ctx context.Context, protoDir string) ([]Annotation, error) {
+	flags := map[string]string{
+		flagErrorFormat: fmtJSON,
+	}
+
+	cmd, err := b.generateCommand(CMDLint, flags, protoDir)
+	if err != nil {
+		return nil, err
+	}
+
+	return b.runAnnotationsCommand(ctx, cmd...)
+}
+
+// Breaking runs the buf Breaking command to check the files in the proto directory
+// for breaking changes against another input, like a git ref of the repository,
+// and returns the annotations of the breaking changes.
+func (b Buf) Breaking(ctx context.Context, protoDir, against string) ([]Annotation, error) {
+	flags := map[string]string{
+		flagAgainst:     against,
+		flagErrorFormat: fmtJSON,
+	}
+
+	cmd, err := b.generateCommand(CMDBreaking, flags, protoDir)
+	if err != nil {
+		return nil, err
+	}
+
+	return b.runAnnotationsCommand(ctx, cmd...)
+}
+
+// GitInput returns the buf input of a directory of a git repository at a git ref.
+// The directory is relative to the repository root.
+func GitInput(repoPath, ref, dir string) string {
+	input := fmt.Sprintf("%s#ref=%s", filepath.Join(repoPath, ".git"), ref)
+	if dir != "" && dir != "." {
+		input = fmt.Sprintf("%s,subdir=%s", input, filepath.ToSlash(dir))
+	}
+	return input
+}
+
 // Cleanup deletes temporary files and directories.
 func (b Buf) Cleanup() error {
 	if b.sdkProtoDir != "" {
@@ -227,6 +292,43 @@ func (b Buf) runCommand(ctx context.Context, cmd ...string) error {
 	return exec.Exec(ctx, cmd, execOpts...)
 }
 
+// runAnnotationsCommand runs a buf CLI command that reports file annotations using JSON.
+func (b Buf) runAnnotationsCommand(ctx context.Context, cmd ...string) ([]Annotation, error) {
+	var stdout bytes.Buffer
+
+	err := exec.Exec(ctx, cmd, exec.StepOption(step.Stdout(&stdout)), exec.IncludeStdLogsToError())
+
+	// Buf exits with a specific code when the command finds annotations
+	var exitErr *exec.ExitError
+	if err != nil && (!errors.As(err, &exitErr) || exitErr.ExitCode() != exitCodeFileAnnotation) {
+		return nil, err
+	}
+
+	return parseAnnotations(stdout.Bytes())
+}
+
+// parseAnnotations parses the file annotations reported by buf, one JSON object per line.
+func parseAnnotations(output []byte) ([]Annotation, error) {
+	var annotations []Annotation
+
+	s := bufio.NewScanner(bytes.NewReader(output))
+	for s.Scan() {
+		line := bytes.TrimSpace(s.Bytes())
+		if len(line) == 0 {
+			continue
+		}
+
+		var a Annotation
+		if err := json.Unmarshal(line, &a); err != nil {
+			return nil, errors.Errorf("invalid buf annotation %q: %w", line, err)
+		}
+
+		annotations = append(annotations, a)
+	}
+
+	return annotations, s.Err()
+}
+
 // generateCommand generate the buf CLI command.
 func (b Buf) generateCommand(
 	c Command,
@@ -46,3 +46,41 @@ func TestFindSDKPath(t *testing.T) {
 		})
 	}
 }
+
+func TestParseAnnotations(t *testing.T) {
+	output := `{"path":"blog/v1/tx.proto","start_line":4,"start_column":3,"end_line":4,"end_column":20,"type":"FIELD_LOWER_SNAKE_CASE","message":"Field name \"postID\" should be lower_snake_case, such as \"post_id\"."}
+
+{"path":"blog/v1/query.proto","start_line":1,"start_column":1,"end_line":1,"end_column":1,"type":"FILE_NO_DELETE","message":"Previously present file \"blog/v1/query.proto\" was deleted."}
+`
+
+	got, err := parseAnnotations([]byte(output))
+	require.NoError(t, err)
+	require.Equal(t, []Annotation{
+		{
+			Path:        "blog/v1/tx.proto",
+			StartLine:   4,
+			StartColumn: 3,
+			EndLine:     4,
+			EndColumn:   20,
+			Type:        "FIELD_LOWER_SNAKE_CASE",
+			Message:     `Field name "postID" should be lower_snake_case, such as "post_id".`,
+		},
+		{
+			Path:        "blog/v1/query.proto",
+			StartLine:   1,
+			StartColumn: 1,
+			EndLine:     1,
+			EndColumn:   1,
+			Type:        "FILE_NO_DELETE",
+			Message:     `Previously present file "blog/v1/query.proto" was deleted.`,
+		},
+	}, got)
+
+	_, err = parseAnnotations([]byte("proto/blog/v1/tx.proto:4:3:invalid"))
+	require.Error(t, err)
+}
+
+func TestGitInput(t *testing.T) {
+	require.Equal(t, "/app/.git#ref=main,subdir=proto", GitInput("/app", "main", "proto"))
+	require.Equal(t, "/app/.git#ref=v1.0.0", GitInput("/app", "v1.0.0", "."))
+}
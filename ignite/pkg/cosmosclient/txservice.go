@@ -23,12 +23,53 @@ func (s TxService) Gas() uint64 {
 	return s.txBuilder.GetTx().GetGas()
 }
 
+// Sequence is the sequence of the account used to sign this tx.
+func (s TxService) Sequence() uint64 {
+	return s.txFactory.Sequence()
+}
+
 // Broadcast signs and broadcasts this tx.
 // If faucet is enabled and if the "from" account doesn't have enough funds, is
 // it automatically filled with the default amount, and the tx is broadcasted
 // again. Note that this may still end with the same error if the amount is
 // greater than the amount dumped by the faucet.
 func (s TxService) Broadcast(ctx context.Context) (Response, error) {
+	resp, err := s.broadcast(ctx)
+	if err != nil {
+		return Response{}, err
+	}
+
+	res, err := s.client.WaitForTx(ctx, resp.TxHash)
+	if err != nil {
+		return Response{}, err
+	}
+	// NOTE(tb) second and third parameters are omitted:
+	// - second parameter represents the tx and should be of type sdktypes.Any,
+	// but it is very ugly to decode, not sure if it's worth it (see sdk code
+	// x/auth/query.go method makeTxResult)
+	// - third parameter represents the timestamp of the tx, which must be
+	// fetched from the block itself. So it requires another API call to
+	// fetch the block from res.Height, not sure if it's worth it too.
+	resp = sdktypes.NewResponseResultTx(res, nil, "")
+
+	return Response{
+		Codec:      s.clientContext.Codec,
+		TxResponse: resp,
+	}, handleBroadcastResult(resp, err)
+}
+
+// BroadcastSync signs and broadcasts this tx without waiting for it to be included
+// in a block. The hash of the tx is returned once the tx is accepted in the mempool.
+func (s TxService) BroadcastSync(ctx context.Context) (string, error) {
+	resp, err := s.broadcast(ctx)
+	if err != nil {
+		return "", err
+	}
+	return resp.TxHash, nil
+}
+
+// broadcast validates, signs and broadcasts this tx in sync mode.
+func (s TxService) broadcast(ctx context.Context) (*sdktypes.TxResponse, error) {
 	defer s.client.lockBech32Prefix()()
 
 	// validate msgs.
@@ -38,42 +79,26 @@ func (s TxService) Broadcast(ctx context.Context) (Response, error) {
 			continue
 		}
 		if err := msg.ValidateBasic(); err != nil {
-			return Response{}, errors.WithStack(err)
+			return nil, errors.WithStack(err)
 		}
 	}
 
 	accountName := s.clientContext.GetFromName()
 	if err := s.client.signer.Sign(ctx, s.txFactory, accountName, s.txBuilder, true); err != nil {
-		return Response{}, errors.WithStack(err)
+		return nil, errors.WithStack(err)
 	}
 
 	txBytes, err := s.clientContext.TxConfig.TxEncoder()(s.txBuilder.GetTx())
 	if err != nil {
-		return Response{}, errors.WithStack(err)
+		return nil, errors.WithStack(err)
 	}
 
 	resp, err := s.clientContext.BroadcastTx(txBytes)
 	if err := handleBroadcastResult(resp, err); err != nil {
-		return Response{}, err
+		return nil, err
 	}
 
-	res, err := s.client.WaitForTx(ctx, resp.TxHash)
-	if err != nil {
-		return Response{}, err
-	}
-	// NOTE(tb) second and third parameters are omitted:
-	// - second parameter represents the tx and should be of type sdktypes.Any,
-	// but it is very ugly to decode, not sure if it's worth it (see sdk code
-	// x/auth/query.go method makeTxResult)
-	// - third parameter represents the timestamp of the tx, which must be
-	// fetched from the block itself. So it requires another API call to
-	// fetch the block from res.Height, not sure if it's worth it too.
-	resp = sdktypes.NewResponseResultTx(res, nil, "")
-
-	return Response{
-		Codec:      s.clientContext.Codec,
-		TxResponse: resp,
-	}, handleBroadcastResult(resp, err)
+	return resp, nil
 }
 
 // EncodeJSON encodes the transaction as a json string.
@@ -202,3 +202,49 @@ func TestTxServiceBroadcast(t *testing.T) {
 		})
 	}
 }
+
+func TestTxServiceBroadcastSync(t *testing.T) {
+	var (
+		goCtx       = context.Background()
+		accountName = "bob"
+		passphrase  = "passphrase"
+		txHash      = []byte{1, 2, 3}
+	)
+	r, err := cosmosaccount.NewInMemory()
+	require.NoError(t, err)
+	a, _, err := r.Create(accountName)
+	require.NoError(t, err)
+	key, err := r.Export(accountName, passphrase)
+	require.NoError(t, err)
+	sdkaddr, err := a.Record.GetAddress()
+	require.NoError(t, err)
+	msg := &banktypes.MsgSend{
+		FromAddress: sdkaddr.String(),
+		ToAddress:   "cosmos1k8e50d2d8xkdfw9c4et3m45llh69e7xzw6uzga",
+		Amount: sdktypes.NewCoins(
+			sdktypes.NewCoin("token", math.NewIntFromUint64(1)),
+		),
+	}
+
+	// The tx is not confirmed, so no Tx calls are expected
+	c := newClient(t, func(s suite) {
+		s.expectPrepareFactory(sdkaddr)
+		s.signer.EXPECT().
+			Sign(goCtx, mock.Anything, "bob", mock.Anything, true).
+			Return(nil)
+		s.rpcClient.EXPECT().
+			BroadcastTxSync(mock.Anything, mock.Anything).
+			Return(&ctypes.ResultBroadcastTx{
+				Hash: txHash,
+			}, nil)
+	})
+	account, err := c.AccountRegistry.Import(accountName, key, passphrase)
+	require.NoError(t, err)
+	txService, err := c.CreateTx(goCtx, account, msg)
+	require.NoError(t, err)
+
+	hash, err := txService.BroadcastSync(goCtx)
+
+	require.NoError(t, err)
+	require.Equal(t, "010203", hash)
+}
@@ -0,0 +1,111 @@
+package cosmoslint
+
+import (
+	"bufio"
+	"io"
+	"os"
+	"path"
+	"strconv"
+	"strings"
+
+	"github.com/ignite/cli/v29/ignite/pkg/errors"
+)
+
+// DefaultAllowlistFile is the name of the allowlist file that is loaded from the app directory.
+const DefaultAllowlistFile = ".determinism-allowlist"
+
+type (
+	// Allowlist is a list of issues that are allowed and must not be reported.
+	Allowlist []AllowlistEntry
+
+	// AllowlistEntry allows the issues of the files that match a path pattern.
+	AllowlistEntry struct {
+		// Path is the pattern of the file paths relative to the app directory,
+		// with the syntax of path.Match.
+		Path string
+
+		// Line is the line of the allowed issues, or zero to allow them in any line.
+		Line int
+
+		// Rule is the rule of the allowed issues, or empty to allow all the rules.
+		Rule string
+	}
+)
+
+// ParseAllowlist parses an allowlist with one entry per line using the "path[:line] [rule]"
+// format, where path is a file path pattern like "x/*/keeper/*.go". Empty lines and lines
+// starting with "#" are ignored.
+func ParseAllowlist(r io.Reader) (Allowlist, error) {
+	var (
+		allowlist Allowlist
+		s         = bufio.NewScanner(r)
+		n         int
+	)
+	for s.Scan() {
+		n++
+
+		line := strings.TrimSpace(s.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		fields := strings.Fields(line)
+		if len(fields) > 2 {
+			return nil, errors.Errorf("invalid allowlist entry at line %d: %q", n, line)
+		}
+
+		var entry AllowlistEntry
+		if len(fields) == 2 {
+			entry.Rule = fields[1]
+		}
+
+		entry.Path = fields[0]
+		if p, lineNum, ok := strings.Cut(fields[0], ":"); ok {
+			num, err := strconv.Atoi(lineNum)
+			if err != nil || num < 1 {
+				return nil, errors.Errorf("invalid line number at line %d of the allowlist: %q", n, lineNum)
+			}
+			entry.Path = p
+			entry.Line = num
+		}
+
+		if _, err := path.Match(entry.Path, ""); err != nil {
+			return nil, errors.Errorf("invalid path pattern at line %d of the allowlist: %q", n, entry.Path)
+		}
+
+		allowlist = append(allowlist, entry)
+	}
+
+	if err := s.Err(); err != nil {
+		return nil, err
+	}
+
+	return allowlist, nil
+}
+
+// LoadAllowlist loads an allowlist file.
+func LoadAllowlist(filePath string) (Allowlist, error) {
+	f, err := os.Open(filePath)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	return ParseAllowlist(f)
+}
+
+// Allows checks if an issue is allowed by any of the entries of the allowlist.
+func (a Allowlist) Allows(issue Issue) bool {
+	for _, e := range a {
+		if e.Line != 0 && e.Line != issue.Line {
+			continue
+		}
+		if e.Rule != "" && e.Rule != issue.Rule {
+			continue
+		}
+		if ok, _ := path.Match(e.Path, issue.Path); ok {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,83 @@
+package cosmoslint_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/cli/v29/ignite/pkg/cosmoslint"
+)
+
+func TestParseAllowlist(t *testing.T) {
+	cases := []struct {
+		name    string
+		content string
+		want    cosmoslint.Allowlist
+		err     string
+	}{
+		{
+			name: "valid allowlist",
+			content: `# Allowed issues
+
+x/mars/keeper/keeper.go
+x/mars/keeper/msg_server.go:12
+x/*/types/*.go float
+  x/mars/module/module.go:7 net  
+`,
+			want: cosmoslint.Allowlist{
+				{Path: "x/mars/keeper/keeper.go"},
+				{Path: "x/mars/keeper/msg_server.go", Line: 12},
+				{Path: "x/*/types/*.go", Rule: "float"},
+				{Path: "x/mars/module/module.go", Line: 7, Rule: "net"},
+			},
+		},
+		{
+			name:    "empty allowlist",
+			content: "# no entries\n",
+		},
+		{
+			name:    "too many fields",
+			content: "x/mars/keeper/keeper.go float net",
+			err:     `invalid allowlist entry at line 1: "x/mars/keeper/keeper.go float net"`,
+		},
+		{
+			name:    "invalid line number",
+			content: "\nx/mars/keeper/keeper.go:first",
+			err:     `invalid line number at line 2 of the allowlist: "first"`,
+		},
+		{
+			name:    "invalid path pattern",
+			content: "x/[mars/keeper.go",
+			err:     `invalid path pattern at line 1 of the allowlist: "x/[mars/keeper.go"`,
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			// Act
+			allowlist, err := cosmoslint.ParseAllowlist(strings.NewReader(tt.content))
+
+			// Assert
+			if tt.err != "" {
+				require.EqualError(t, err, tt.err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tt.want, allowlist)
+		})
+	}
+}
+
+func TestAllowlistAllows(t *testing.T) {
+	allowlist := cosmoslint.Allowlist{
+		{Path: "x/*/types/*.go", Rule: "float"},
+		{Path: "x/mars/keeper/keeper.go", Line: 10},
+	}
+
+	require.True(t, allowlist.Allows(cosmoslint.Issue{Rule: "float", Path: "x/mars/types/math.go", Line: 3}))
+	require.False(t, allowlist.Allows(cosmoslint.Issue{Rule: "rand", Path: "x/mars/types/math.go", Line: 3}))
+	require.True(t, allowlist.Allows(cosmoslint.Issue{Rule: "rand", Path: "x/mars/keeper/keeper.go", Line: 10}))
+	require.False(t, allowlist.Allows(cosmoslint.Issue{Rule: "rand", Path: "x/mars/keeper/keeper.go", Line: 11}))
+}
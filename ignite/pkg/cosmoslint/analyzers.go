@@ -0,0 +1,309 @@
+package cosmoslint
+
+import (
+	"go/ast"
+	"go/token"
+	"strconv"
+)
+
+const (
+	// keeperDir is the name of the directory of the module keepers.
+	keeperDir = "keeper"
+
+	// msgServerType is the name of the type that implements the message handlers of a module.
+	msgServerType = "msgServer"
+)
+
+var (
+	// MapRange reports the iteration of maps in the state machine.
+	// The iteration order of maps is random, so the state changes done while
+	// iterating a map can be different in each node.
+	MapRange = Analyzer{
+		Name: "maprange",
+		Doc:  "map iteration in state machine code is non-deterministic",
+		run:  runMapRange,
+	}
+
+	// TimeNow reports the use of the local time in the module keepers.
+	// The local time is different in each node, the block time must be used instead.
+	TimeNow = Analyzer{
+		Name: "timenow",
+		Doc:  "keepers must use the block time instead of the local time",
+		run:  runTimeNow,
+	}
+
+	// Float reports the use of floating point numbers in the module message handlers.
+	// Floating point arithmetic can have different results depending on the platform.
+	Float = Analyzer{
+		Name: "float",
+		Doc:  "floating point arithmetic in message handlers is non-deterministic",
+		run:  runFloat,
+	}
+)
+
+// runMapRange reports the range statements over values that are declared as maps.
+// The analysis is syntactic, so only maps declared in the same package are
+// detected. Loops that only collect the map keys to sort them are allowed.
+func runMapRange(p *pass) {
+	fields, globals := mapDecls(p.pkg)
+
+	for _, decl := range p.file.Decls {
+		fn, ok := decl.(*ast.FuncDecl)
+		if !ok || fn.Body == nil {
+			continue
+		}
+
+		locals := mapLocals(fn)
+
+		ast.Inspect(fn.Body, func(n ast.Node) bool {
+			rs, ok := n.(*ast.RangeStmt)
+			if ok && isMapRange(rs, fields, globals, locals) {
+				p.report(rs, "iteration over a map has a random order, sort the map keys before iterating")
+			}
+			return true
+		})
+	}
+}
+
+// runTimeNow reports the calls to time.Now in the module keepers.
+func runTimeNow(p *pass) {
+	if p.dir() != keeperDir {
+		return
+	}
+
+	name, ok := importName(p.file, "time")
+	if !ok {
+		return
+	}
+
+	ast.Inspect(p.file, func(n ast.Node) bool {
+		call, ok := n.(*ast.CallExpr)
+		if !ok {
+			return true
+		}
+
+		sel, ok := call.Fun.(*ast.SelectorExpr)
+		if !ok || sel.Sel.Name != "Now" {
+			return true
+		}
+
+		if pkg, ok := sel.X.(*ast.Ident); ok && pkg.Name == name {
+			p.report(call, "time.Now returns the local time of the node, use the block time of the context instead")
+		}
+
+		return true
+	})
+}
+
+// runFloat reports the floating point types and literals used in the message handlers.
+func runFloat(p *pass) {
+	for _, decl := range p.file.Decls {
+		fn, ok := decl.(*ast.FuncDecl)
+		if !ok || fn.Body == nil || receiverType(fn) != msgServerType {
+			continue
+		}
+
+		ast.Inspect(fn, func(n ast.Node) bool {
+			switch n := n.(type) {
+			case *ast.Ident:
+				if n.Name == "float32" || n.Name == "float64" {
+					p.report(n, n.Name+" is used in the message handler "+fn.Name.Name+", use sdk.Dec or sdk.Int instead")
+				}
+			case *ast.BasicLit:
+				if n.Kind == token.FLOAT {
+					p.report(n, "floating point literal is used in the message handler "+fn.Name.Name+", use sdk.Dec or sdk.Int instead")
+				}
+			}
+			return true
+		})
+	}
+}
+
+// mapDecls returns the names of the struct fields and the package variables
+// that are declared as maps in the files of a package.
+func mapDecls(files []*ast.File) (fields, globals map[string]struct{}) {
+	fields = make(map[string]struct{})
+	globals = make(map[string]struct{})
+
+	for _, file := range files {
+		for _, decl := range file.Decls {
+			gen, ok := decl.(*ast.GenDecl)
+			if !ok {
+				continue
+			}
+
+			for _, spec := range gen.Specs {
+				switch spec := spec.(type) {
+				case *ast.TypeSpec:
+					st, ok := spec.Type.(*ast.StructType)
+					if !ok {
+						continue
+					}
+					for _, f := range st.Fields.List {
+						if isMapType(f.Type) {
+							addNames(fields, f.Names)
+						}
+					}
+				case *ast.ValueSpec:
+					addMapValueNames(globals, spec)
+				}
+			}
+		}
+	}
+
+	return fields, globals
+}
+
+// isMapRange checks if a range statement iterates a map declared as a field, package
+// variable or local of the function. Loops that only collect the map keys are excluded.
+func isMapRange(rs *ast.RangeStmt, fields, globals, locals map[string]struct{}) bool {
+	if collectsKeys(rs) {
+		return false
+	}
+
+	switch x := rs.X.(type) {
+	case *ast.Ident:
+		_, isLocal := locals[x.Name]
+		_, isGlobal := globals[x.Name]
+		return isLocal || isGlobal
+	case *ast.SelectorExpr:
+		_, isField := fields[x.Sel.Name]
+		return isField
+	default:
+		return isMapExpr(x)
+	}
+}
+
+// mapLocals returns the names of the parameters and variables of a function that are declared as maps.
+func mapLocals(fn *ast.FuncDecl) map[string]struct{} {
+	names := make(map[string]struct{})
+
+	for _, list := range []*ast.FieldList{fn.Recv, fn.Type.Params, fn.Type.Results} {
+		if list == nil {
+			continue
+		}
+		for _, f := range list.List {
+			if isMapType(f.Type) {
+				addNames(names, f.Names)
+			}
+		}
+	}
+
+	ast.Inspect(fn.Body, func(n ast.Node) bool {
+		switch n := n.(type) {
+		case *ast.ValueSpec:
+			addMapValueNames(names, n)
+		case *ast.AssignStmt:
+			if len(n.Lhs) != len(n.Rhs) {
+				return true
+			}
+			for i, rhs := range n.Rhs {
+				if id, ok := n.Lhs[i].(*ast.Ident); ok && isMapExpr(rhs) {
+					names[id.Name] = struct{}{}
+				}
+			}
+		}
+		return true
+	})
+
+	return names
+}
+
+// addMapValueNames adds the names of a variable declaration when its type or value is a map.
+func addMapValueNames(names map[string]struct{}, spec *ast.ValueSpec) {
+	if spec.Type != nil {
+		if isMapType(spec.Type) {
+			addNames(names, spec.Names)
+		}
+		return
+	}
+
+	for i, v := range spec.Values {
+		if i < len(spec.Names) && isMapExpr(v) {
+			names[spec.Names[i].Name] = struct{}{}
+		}
+	}
+}
+
+func addNames(names map[string]struct{}, idents []*ast.Ident) {
+	for _, id := range idents {
+		names[id.Name] = struct{}{}
+	}
+}
+
+// isMapType checks if a type expression is a map type.
+func isMapType(expr ast.Expr) bool {
+	_, ok := expr.(*ast.MapType)
+	return ok
+}
+
+// isMapExpr checks if an expression creates a map using a composite literal or make.
+func isMapExpr(expr ast.Expr) bool {
+	switch x := expr.(type) {
+	case *ast.CompositeLit:
+		return isMapType(x.Type)
+	case *ast.CallExpr:
+		fn, ok := x.Fun.(*ast.Ident)
+		return ok && fn.Name == "make" && len(x.Args) > 0 && isMapType(x.Args[0])
+	}
+	return false
+}
+
+// collectsKeys checks if a range statement only appends the keys to a slice, which
+// is the way to get the keys of a map to iterate it in a deterministic order.
+func collectsKeys(rs *ast.RangeStmt) bool {
+	key, ok := rs.Key.(*ast.Ident)
+	if !ok || rs.Value != nil || len(rs.Body.List) != 1 {
+		return false
+	}
+
+	assign, ok := rs.Body.List[0].(*ast.AssignStmt)
+	if !ok || len(assign.Rhs) != 1 {
+		return false
+	}
+
+	call, ok := assign.Rhs[0].(*ast.CallExpr)
+	if !ok || len(call.Args) != 2 {
+		return false
+	}
+
+	fn, ok := call.Fun.(*ast.Ident)
+	if !ok || fn.Name != "append" {
+		return false
+	}
+
+	arg, ok := call.Args[1].(*ast.Ident)
+	return ok && arg.Name == key.Name
+}
+
+// importName returns the name used by a file to refer to an imported package.
+func importName(file *ast.File, path string) (string, bool) {
+	for _, imp := range file.Imports {
+		p, err := strconv.Unquote(imp.Path.Value)
+		if err != nil || p != path {
+			continue
+		}
+		if imp.Name != nil {
+			return imp.Name.Name, true
+		}
+		return path, true
+	}
+	return "", false
+}
+
+// receiverType returns the name of the receiver type of a method.
+func receiverType(fn *ast.FuncDecl) string {
+	if fn.Recv == nil || len(fn.Recv.List) == 0 {
+		return ""
+	}
+
+	expr := fn.Recv.List[0].Type
+	if star, ok := expr.(*ast.StarExpr); ok {
+		expr = star.X
+	}
+
+	if id, ok := expr.(*ast.Ident); ok {
+		return id.Name
+	}
+	return ""
+}
@@ -0,0 +1,268 @@
+// Package cosmoslint provides static analyzers that detect code which is unsafe
+// to run in the state machine of a Cosmos SDK blockchain, and the reports used to
+// present the issues found by the chain linters.
+package cosmoslint
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"io/fs"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/ignite/cli/v29/ignite/pkg/errors"
+)
+
+// ToolName is the name of the tool that reports the issues found by the Cosmos analyzers.
+const ToolName = "cosmoslint"
+
+// modulesDir is the directory of the app that contains the state machine code of the modules.
+const modulesDir = "x"
+
+// skipDirs are module directories that don't contain state machine code.
+var skipDirs = map[string]struct{}{
+	"client":     {},
+	"simulation": {},
+	"testutil":   {},
+	"testdata":   {},
+}
+
+type (
+	// Issue is a problem found in the source code by a linter.
+	Issue struct {
+		// Tool is the name of the linter tool that found the issue.
+		Tool string `json:"tool"`
+
+		// Rule is the name of the check that reported the issue.
+		Rule string `json:"rule"`
+
+		// Path is the path of the file relative to the app directory.
+		Path string `json:"path"`
+
+		// Line and Column are the position of the issue in the file.
+		Line   int `json:"line"`
+		Column int `json:"column,omitempty"`
+
+		// Message describes the issue.
+		Message string `json:"message"`
+	}
+
+	// Analyzer checks the Go files of the modules for code that is unsafe to run in the state machine.
+	Analyzer struct {
+		// Name is the name of the analyzer used as rule of the issues.
+		Name string
+
+		// Doc describes the check done by the analyzer.
+		Doc string
+
+		run func(*pass)
+	}
+
+	// pass is the analysis of a Go file of a package.
+	pass struct {
+		analyzer Analyzer
+		fset     *token.FileSet
+		pkg      []*ast.File
+		file     *ast.File
+		path     string
+		nolint   map[int][]string
+		issues   []Issue
+	}
+)
+
+// Analyzers returns all the Cosmos analyzers.
+func Analyzers() []Analyzer {
+	return []Analyzer{MapRange, TimeNow, Float}
+}
+
+// AnalyzerByName returns the Cosmos analyzer with the given name.
+func AnalyzerByName(name string) (Analyzer, bool) {
+	for _, a := range Analyzers() {
+		if a.Name == name {
+			return a, true
+		}
+	}
+	return Analyzer{}, false
+}
+
+// Run analyzes the modules of the app with the analyzers and returns the issues
+// found sorted by position. Tests, generated files and module directories that
+// are not part of the state machine, like the CLI client, are skipped.
+// Issues can be ignored using a "//nolint" or "//nolint:<analyzer>" comment in
+// the line where the issue is reported.
+func Run(appPath string, analyzers ...Analyzer) ([]Issue, error) {
+	pkgs, err := modulePackages(appPath)
+	if err != nil {
+		return nil, err
+	}
+
+	var issues []Issue
+	for _, paths := range pkgs {
+		pkgIssues, err := analyzePackage(appPath, paths, analyzers)
+		if err != nil {
+			return nil, err
+		}
+		issues = append(issues, pkgIssues...)
+	}
+
+	SortIssues(issues)
+
+	return issues, nil
+}
+
+// modulePackages returns the paths of the Go files with state machine code of the
+// app modules grouped by package, in the order in which the directories are walked.
+func modulePackages(appPath string) ([][]string, error) {
+	root := filepath.Join(appPath, modulesDir)
+
+	var (
+		dirs  []string
+		files = make(map[string][]string)
+	)
+	err := filepath.WalkDir(root, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			if errors.Is(err, fs.ErrNotExist) && path == root {
+				return filepath.SkipDir
+			}
+			return err
+		}
+
+		if d.IsDir() {
+			if _, ok := skipDirs[d.Name()]; ok {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+
+		if filepath.Ext(path) != ".go" || strings.HasSuffix(path, "_test.go") {
+			return nil
+		}
+
+		dir := filepath.Dir(path)
+		if _, ok := files[dir]; !ok {
+			dirs = append(dirs, dir)
+		}
+		files[dir] = append(files[dir], path)
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	pkgs := make([][]string, len(dirs))
+	for i, dir := range dirs {
+		pkgs[i] = files[dir]
+	}
+
+	return pkgs, nil
+}
+
+// analyzePackage analyzes the files of a package with the analyzers.
+func analyzePackage(appPath string, paths []string, analyzers []Analyzer) ([]Issue, error) {
+	fset := token.NewFileSet()
+
+	var files []*ast.File
+	for _, path := range paths {
+		file, err := parser.ParseFile(fset, path, nil, parser.ParseComments)
+		if err != nil {
+			return nil, err
+		}
+
+		if !ast.IsGenerated(file) {
+			files = append(files, file)
+		}
+	}
+
+	var issues []Issue
+	for _, file := range files {
+		relPath, err := filepath.Rel(appPath, fset.Position(file.Package).Filename)
+		if err != nil {
+			return nil, err
+		}
+
+		nolint := nolintLines(fset, file)
+		for _, a := range analyzers {
+			p := &pass{
+				analyzer: a,
+				fset:     fset,
+				pkg:      files,
+				file:     file,
+				path:     filepath.ToSlash(relPath),
+				nolint:   nolint,
+			}
+			a.run(p)
+			issues = append(issues, p.issues...)
+		}
+	}
+
+	return issues, nil
+}
+
+// SortIssues sorts issues by file position.
+func SortIssues(issues []Issue) {
+	sort.SliceStable(issues, func(i, j int) bool {
+		a, b := issues[i], issues[j]
+		if a.Path != b.Path {
+			return a.Path < b.Path
+		}
+		if a.Line != b.Line {
+			return a.Line < b.Line
+		}
+		return a.Column < b.Column
+	})
+}
+
+// report adds an issue at the position of a node unless the line ignores the analyzer.
+func (p *pass) report(node ast.Node, message string) {
+	pos := p.fset.Position(node.Pos())
+
+	if names, ok := p.nolint[pos.Line]; ok {
+		if len(names) == 0 {
+			return
+		}
+		for _, name := range names {
+			if name == p.analyzer.Name {
+				return
+			}
+		}
+	}
+
+	p.issues = append(p.issues, Issue{
+		Tool:    ToolName,
+		Rule:    p.analyzer.Name,
+		Path:    p.path,
+		Line:    pos.Line,
+		Column:  pos.Column,
+		Message: message,
+	})
+}
+
+// dir returns the name of the directory of the file.
+func (p *pass) dir() string {
+	return filepath.Base(filepath.Dir(p.path))
+}
+
+// nolintLines returns the lines with a nolint comment and the analyzers that they ignore.
+// Lines that ignore all the analyzers don't have analyzer names.
+func nolintLines(fset *token.FileSet, file *ast.File) map[int][]string {
+	lines := make(map[int][]string)
+	for _, group := range file.Comments {
+		for _, c := range group.List {
+			directive, _, _ := strings.Cut(strings.TrimPrefix(c.Text, "//"), " ")
+			if directive != "nolint" && !strings.HasPrefix(directive, "nolint:") {
+				continue
+			}
+
+			var names []string
+			if list, ok := strings.CutPrefix(directive, "nolint:"); ok {
+				names = strings.Split(list, ",")
+			}
+
+			lines[fset.Position(c.Pos()).Line] = names
+		}
+	}
+	return lines
+}
@@ -0,0 +1,84 @@
+package cosmoslint_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/cli/v29/ignite/pkg/cosmoslint"
+)
+
+func TestRun(t *testing.T) {
+	issue := func(rule, path string, line, column int, message string) cosmoslint.Issue {
+		return cosmoslint.Issue{
+			Tool:    cosmoslint.ToolName,
+			Rule:    rule,
+			Path:    path,
+			Line:    line,
+			Column:  column,
+			Message: message,
+		}
+	}
+
+	const (
+		mapMsg   = "iteration over a map has a random order, sort the map keys before iterating"
+		timeMsg  = "time.Now returns the local time of the node, use the block time of the context instead"
+		floatMsg = "is used in the message handler CreatePost, use sdk.Dec or sdk.Int instead"
+	)
+
+	cases := []struct {
+		name      string
+		analyzers []cosmoslint.Analyzer
+		want      []cosmoslint.Issue
+	}{
+		{
+			name:      "all analyzers",
+			analyzers: cosmoslint.Analyzers(),
+			want: []cosmoslint.Issue{
+				issue("maprange", "x/blog/keeper/genesis.go", 7, 2, mapMsg),
+				issue("maprange", "x/blog/keeper/keeper.go", 15, 2, mapMsg),
+				issue("maprange", "x/blog/keeper/keeper.go", 22, 2, mapMsg),
+				issue("timenow", "x/blog/keeper/keeper.go", 29, 9, timeMsg),
+				issue("float", "x/blog/keeper/msg_server.go", 23, 9, "float64 "+floatMsg),
+				issue("float", "x/blog/keeper/msg_server.go", 23, 26, "floating point literal "+floatMsg),
+				issue("maprange", "x/blog/module/module.go", 8, 2, mapMsg),
+			},
+		},
+		{
+			name:      "time analyzer",
+			analyzers: []cosmoslint.Analyzer{cosmoslint.TimeNow},
+			want: []cosmoslint.Issue{
+				issue("timenow", "x/blog/keeper/keeper.go", 29, 9, timeMsg),
+			},
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			// Act
+			issues, err := cosmoslint.Run("testdata/app", tt.analyzers...)
+
+			// Assert
+			require.NoError(t, err)
+			require.Equal(t, tt.want, issues)
+		})
+	}
+}
+
+func TestRunWithoutModules(t *testing.T) {
+	// Act
+	issues, err := cosmoslint.Run(t.TempDir(), cosmoslint.Analyzers()...)
+
+	// Assert
+	require.NoError(t, err)
+	require.Empty(t, issues)
+}
+
+func TestAnalyzerByName(t *testing.T) {
+	a, ok := cosmoslint.AnalyzerByName("maprange")
+	require.True(t, ok)
+	require.Equal(t, cosmoslint.MapRange.Name, a.Name)
+
+	_, ok = cosmoslint.AnalyzerByName("unknown")
+	require.False(t, ok)
+}
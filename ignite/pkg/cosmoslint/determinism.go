@@ -0,0 +1,570 @@
+package cosmoslint
+
+import (
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"path/filepath"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/ignite/cli/v29/ignite/pkg/cosmosanalysis"
+	"github.com/ignite/cli/v29/ignite/pkg/goanalysis"
+	"github.com/ignite/cli/v29/ignite/pkg/gomodulepath"
+)
+
+// DeterminismToolName is the name of the tool that reports the non-deterministic
+// code found in the state machine of the modules.
+const DeterminismToolName = "determinism"
+
+// msgServerRegistration is the name of the function generated for the message services
+// of the modules to register their message servers.
+const msgServerRegistration = "RegisterMsgServer"
+
+// Rules of the non-deterministic code reported by CheckDeterminism.
+const (
+	RuleMapRange  = "maprange"
+	RuleGoroutine = "goroutine"
+	RuleTimeNow   = "timenow"
+	RuleRand      = "rand"
+	RuleFloat     = "float"
+	RuleOS        = "os"
+	RuleNet       = "net"
+)
+
+var (
+	// appModuleMethods are the methods implemented by the module types of the app modules.
+	appModuleMethods = []string{"IsAppModule"}
+
+	// blockMethods are the methods of the module types called at the beginning and the end of each block.
+	blockMethods = []string{"PreBlock", "BeginBlock", "EndBlock"}
+
+	// randPkgs are the packages that generate random values.
+	randPkgs = map[string]struct{}{
+		"math/rand":    {},
+		"math/rand/v2": {},
+		"crypto/rand":  {},
+	}
+
+	// osPkgs are the packages that access the operating system of the node.
+	osPkgs = map[string]struct{}{
+		"os":      {},
+		"os/exec": {},
+	}
+
+	// netPkgs are the packages that access the network.
+	netPkgs = map[string]struct{}{
+		"net":      {},
+		"net/http": {},
+		"net/rpc":  {},
+	}
+
+	// statePrefixes are the prefixes of the names of the functions that are
+	// assumed to write to the state, like the store and the collections methods
+	// or the methods of the bank keeper.
+	statePrefixes = []string{
+		"Set",
+		"Delete",
+		"Remove",
+		"Insert",
+		"Update",
+		"Append",
+		"Put",
+		"Send",
+		"Mint",
+		"Burn",
+	}
+)
+
+type (
+	// determinism is the analysis of the state machine code of the app modules.
+	determinism struct {
+		appPath    string
+		modulePath string
+		fset       *token.FileSet
+		pkgs       map[string]*sourcePackage
+		funcs      []*function
+		byName     map[string]*function
+		methods    map[string][]*function
+		allowlist  Allowlist
+		issues     []Issue
+	}
+
+	// function is a function or method declared in a package of the app modules.
+	function struct {
+		// name is the name of the function, prefixed with the receiver type for methods.
+		name string
+
+		decl *ast.FuncDecl
+		file *sourceFile
+		pkg  *sourcePackage
+
+		// calls are the functions of the app modules called by the function.
+		calls []*function
+
+		// writes is true when the function writes to the state, directly or through
+		// the functions that it calls.
+		writes bool
+	}
+
+	sourcePackage struct {
+		// path is the import path of the package.
+		path string
+
+		// dir is the directory of the package relative to the app directory.
+		dir string
+
+		files []*ast.File
+
+		// fields and globals are the names of the maps declared in the package.
+		fields, globals map[string]struct{}
+	}
+
+	sourceFile struct {
+		ast     *ast.File
+		path    string
+		imports map[string]string
+		nolint  map[int][]string
+	}
+)
+
+// CheckDeterminism reports the non-deterministic code found in the state machine of the
+// app modules. The state machine code is the code reachable from the methods of the
+// message servers registered by the modules and from the methods of the module types
+// called at the beginning and the end of each block. The code reachable from them is checked for goroutines, local time, random
+// values, floating point numbers, operating system and network access, and for map
+// iterations that write to the state.
+//
+// The analysis is syntactic: method calls are resolved by name to all the methods of
+// the app modules with that name, and functions are assumed to write to the state
+// when their names start with prefixes like "Set", "Delete" or "Send".
+// Issues can be ignored with an allowlist, or using a "//nolint" or "//nolint:<rule>"
+// comment in the line where the issue is reported.
+func CheckDeterminism(appPath string, allowlist Allowlist) ([]Issue, error) {
+	modulePath, err := gomodulepath.ParseAt(appPath)
+	if err != nil {
+		return nil, err
+	}
+
+	d := &determinism{
+		appPath:    appPath,
+		modulePath: modulePath.RawPath,
+		fset:       token.NewFileSet(),
+		pkgs:       make(map[string]*sourcePackage),
+		byName:     make(map[string]*function),
+		methods:    make(map[string][]*function),
+		allowlist:  allowlist,
+	}
+
+	pkgs, err := modulePackages(appPath)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, paths := range pkgs {
+		if err := d.load(paths); err != nil {
+			return nil, err
+		}
+	}
+
+	d.link()
+
+	reachable := d.reach()
+	for _, fn := range d.funcs {
+		if entry, ok := reachable[fn]; ok {
+			d.check(fn, entry)
+		}
+	}
+
+	SortIssues(d.issues)
+
+	return d.issues, nil
+}
+
+// load parses the files of a package and adds its functions to the analysis.
+func (d *determinism) load(paths []string) error {
+	dir, err := filepath.Rel(d.appPath, filepath.Dir(paths[0]))
+	if err != nil {
+		return err
+	}
+
+	pkg := &sourcePackage{
+		path: d.modulePath + "/" + filepath.ToSlash(dir),
+		dir:  filepath.ToSlash(dir),
+	}
+
+	var files []*ast.File
+	for _, path := range paths {
+		file, err := parser.ParseFile(d.fset, path, nil, parser.ParseComments)
+		if err != nil {
+			return err
+		}
+
+		if ast.IsGenerated(file) {
+			continue
+		}
+
+		relPath, err := filepath.Rel(d.appPath, path)
+		if err != nil {
+			return err
+		}
+
+		f := &sourceFile{
+			ast:     file,
+			path:    filepath.ToSlash(relPath),
+			imports: goanalysis.FormatImports(file),
+			nolint:  nolintLines(d.fset, file),
+		}
+		files = append(files, file)
+
+		for _, decl := range file.Decls {
+			fn, ok := decl.(*ast.FuncDecl)
+			if !ok || fn.Body == nil {
+				continue
+			}
+
+			name := fn.Name.Name
+			if recv := receiverType(fn); recv != "" {
+				name = recv + "." + name
+			}
+
+			function := &function{
+				name: name,
+				decl: fn,
+				file: f,
+				pkg:  pkg,
+			}
+			d.funcs = append(d.funcs, function)
+			d.byName[pkg.path+"."+name] = function
+			if fn.Recv != nil {
+				d.methods[fn.Name.Name] = append(d.methods[fn.Name.Name], function)
+			}
+		}
+	}
+
+	pkg.files = files
+	pkg.fields, pkg.globals = mapDecls(files)
+	d.pkgs[pkg.path] = pkg
+
+	return nil
+}
+
+// link resolves the calls of each function and finds the functions that write to the state.
+func (d *determinism) link() {
+	for _, fn := range d.funcs {
+		seen := make(map[*function]struct{})
+		ast.Inspect(fn.decl.Body, func(n ast.Node) bool {
+			call, ok := n.(*ast.CallExpr)
+			if !ok {
+				return true
+			}
+
+			if isStateWrite(call) {
+				fn.writes = true
+			}
+
+			for _, callee := range d.callees(fn, call) {
+				if _, ok := seen[callee]; !ok {
+					seen[callee] = struct{}{}
+					fn.calls = append(fn.calls, callee)
+				}
+			}
+
+			return true
+		})
+	}
+
+	// Functions write to the state when they call functions that write to the state
+	for changed := true; changed; {
+		changed = false
+		for _, fn := range d.funcs {
+			if fn.writes {
+				continue
+			}
+			for _, callee := range fn.calls {
+				if callee.writes {
+					fn.writes = true
+					changed = true
+					break
+				}
+			}
+		}
+	}
+}
+
+// callees returns the functions of the app modules that can be called by a call expression.
+func (d *determinism) callees(fn *function, call *ast.CallExpr) []*function {
+	switch x := call.Fun.(type) {
+	case *ast.Ident:
+		if callee, ok := d.byName[fn.pkg.path+"."+x.Name]; ok && callee.decl.Recv == nil {
+			return []*function{callee}
+		}
+	case *ast.SelectorExpr:
+		if id, ok := x.X.(*ast.Ident); ok {
+			if path, ok := fn.file.imports[id.Name]; ok {
+				if callee, ok := d.byName[path+"."+x.Sel.Name]; ok {
+					return []*function{callee}
+				}
+				return nil
+			}
+		}
+		return d.methods[x.Sel.Name]
+	}
+	return nil
+}
+
+// reach returns the functions reachable from the entry points of the state machine,
+// mapped to the name of the first entry point from which they are reachable.
+func (d *determinism) reach() map[*function]string {
+	reachable := make(map[*function]string)
+	entries := d.entryPoints()
+
+	for _, entry := range d.funcs {
+		if _, ok := entries[entry]; !ok {
+			continue
+		}
+
+		name := fmt.Sprintf("%s in %s", entry.name, entry.pkg.dir)
+		queue := []*function{entry}
+		for len(queue) > 0 {
+			fn := queue[0]
+			queue = queue[1:]
+
+			if _, ok := reachable[fn]; ok {
+				continue
+			}
+			reachable[fn] = name
+			queue = append(queue, fn.calls...)
+		}
+	}
+
+	return reachable
+}
+
+// entryPoints returns the functions called by the app to handle the messages of the modules
+// and at the beginning and the end of each block. The message handlers are the exported methods
+// of the types registered as message servers, and the block functions are the block methods of
+// the module types.
+func (d *determinism) entryPoints() map[*function]struct{} {
+	entries := make(map[*function]struct{})
+
+	for _, pkg := range d.pkgs {
+		for _, moduleType := range cosmosanalysis.FindImplementationInFiles(pkg.files, appModuleMethods) {
+			for _, name := range blockMethods {
+				if fn, ok := d.byName[pkg.path+"."+moduleType+"."+name]; ok {
+					entries[fn] = struct{}{}
+				}
+			}
+		}
+	}
+
+	servers := d.msgServers()
+	for _, fn := range d.funcs {
+		if _, ok := servers[fn.pkg.path+"."+receiverType(fn.decl)]; ok && fn.decl.Name.IsExported() {
+			entries[fn] = struct{}{}
+		}
+	}
+
+	return entries
+}
+
+// msgServers returns the types registered as message servers by the app modules,
+// which are the types passed to the RegisterMsgServer functions of the module types.
+// The types are prefixed with the import path of their package.
+func (d *determinism) msgServers() map[string]struct{} {
+	servers := make(map[string]struct{})
+
+	for _, fn := range d.funcs {
+		ast.Inspect(fn.decl.Body, func(n ast.Node) bool {
+			call, ok := n.(*ast.CallExpr)
+			if !ok || len(call.Args) != 2 || calledName(call) != msgServerRegistration {
+				return true
+			}
+
+			for _, server := range d.exprTypes(fn, call.Args[1]) {
+				servers[server] = struct{}{}
+			}
+
+			return true
+		})
+	}
+
+	return servers
+}
+
+// exprTypes returns the types of the app modules created by an expression, prefixed with the
+// import path of their package. The types returned by the functions called by the expression
+// are resolved using the values of their return statements, like in message server constructors.
+func (d *determinism) exprTypes(fn *function, expr ast.Expr) []string {
+	switch x := expr.(type) {
+	case *ast.ParenExpr:
+		return d.exprTypes(fn, x.X)
+	case *ast.UnaryExpr:
+		return d.exprTypes(fn, x.X)
+	case *ast.CompositeLit:
+		switch t := x.Type.(type) {
+		case *ast.Ident:
+			return []string{fn.pkg.path + "." + t.Name}
+		case *ast.SelectorExpr:
+			if id, ok := t.X.(*ast.Ident); ok {
+				if path, ok := fn.file.imports[id.Name]; ok {
+					return []string{path + "." + t.Sel.Name}
+				}
+			}
+		}
+	case *ast.CallExpr:
+		var types []string
+		for _, callee := range d.callees(fn, x) {
+			// Only functions are followed because constructors are not methods
+			if callee.decl.Recv != nil {
+				continue
+			}
+
+			ast.Inspect(callee.decl.Body, func(n ast.Node) bool {
+				if _, ok := n.(*ast.FuncLit); ok {
+					return false
+				}
+				if ret, ok := n.(*ast.ReturnStmt); ok && len(ret.Results) > 0 {
+					types = append(types, d.exprTypes(callee, ret.Results[0])...)
+				}
+				return true
+			})
+		}
+		return types
+	}
+	return nil
+}
+
+// check reports the non-deterministic code of a function reachable from an entry point.
+func (d *determinism) check(fn *function, entry string) {
+	locals := mapLocals(fn.decl)
+
+	ast.Inspect(fn.decl, func(n ast.Node) bool {
+		switch n := n.(type) {
+		case *ast.GoStmt:
+			d.report(fn, n, RuleGoroutine, entry, "goroutines are scheduled in a random order")
+		case *ast.RangeStmt:
+			if isMapRange(n, fn.pkg.fields, fn.pkg.globals, locals) && d.writesState(fn, n.Body) {
+				d.report(fn, n, RuleMapRange, entry, "iteration over a map writes to the state in a random order, sort the map keys before iterating")
+			}
+		case *ast.SelectorExpr:
+			id, ok := n.X.(*ast.Ident)
+			if !ok {
+				return true
+			}
+
+			path, ok := fn.file.imports[id.Name]
+			if !ok {
+				return true
+			}
+
+			if _, ok := randPkgs[path]; ok {
+				d.report(fn, n, RuleRand, entry, fmt.Sprintf("%s.%s returns random values that are different in each node", path, n.Sel.Name))
+			}
+			if _, ok := osPkgs[path]; ok {
+				d.report(fn, n, RuleOS, entry, fmt.Sprintf("%s.%s accesses the operating system of the node", path, n.Sel.Name))
+			}
+			if _, ok := netPkgs[path]; ok {
+				d.report(fn, n, RuleNet, entry, fmt.Sprintf("%s.%s accesses the network", path, n.Sel.Name))
+			}
+			if path == "time" && n.Sel.Name == "Now" {
+				d.report(fn, n, RuleTimeNow, entry, "time.Now returns the local time of the node, use the block time of the context instead")
+			}
+		case *ast.Ident:
+			if n.Name == "float32" || n.Name == "float64" {
+				d.report(fn, n, RuleFloat, entry, n.Name+" is used, use sdk.Dec or sdk.Int instead")
+			}
+		case *ast.BasicLit:
+			if n.Kind == token.FLOAT {
+				d.report(fn, n, RuleFloat, entry, "floating point literal is used, use sdk.Dec or sdk.Int instead")
+			}
+		}
+		return true
+	})
+}
+
+// writesState checks if a node writes to the state directly or through the functions that it calls.
+func (d *determinism) writesState(fn *function, node ast.Node) bool {
+	var writes bool
+	ast.Inspect(node, func(n ast.Node) bool {
+		call, ok := n.(*ast.CallExpr)
+		if !ok || writes {
+			return !writes
+		}
+
+		if isStateWrite(call) {
+			writes = true
+			return false
+		}
+
+		for _, callee := range d.callees(fn, call) {
+			if callee.writes {
+				writes = true
+				return false
+			}
+		}
+
+		return true
+	})
+	return writes
+}
+
+// report adds an issue at the position of a node unless it's allowed by the allowlist or a nolint comment.
+func (d *determinism) report(fn *function, node ast.Node, rule, entry, message string) {
+	pos := d.fset.Position(node.Pos())
+
+	if names, ok := fn.file.nolint[pos.Line]; ok {
+		if len(names) == 0 {
+			return
+		}
+		for _, name := range names {
+			if name == rule {
+				return
+			}
+		}
+	}
+
+	issue := Issue{
+		Tool:    DeterminismToolName,
+		Rule:    rule,
+		Path:    fn.file.path,
+		Line:    pos.Line,
+		Column:  pos.Column,
+		Message: fmt.Sprintf("%s (reachable from %s)", message, entry),
+	}
+	if !d.allowlist.Allows(issue) {
+		d.issues = append(d.issues, issue)
+	}
+}
+
+// calledName returns the name of the function or method called by a call expression.
+func calledName(call *ast.CallExpr) string {
+	switch x := call.Fun.(type) {
+	case *ast.Ident:
+		return x.Name
+	case *ast.SelectorExpr:
+		return x.Sel.Name
+	}
+	return ""
+}
+
+// isStateWrite checks if the name of the called function starts with one of the
+// prefixes of the functions that write to the state.
+func isStateWrite(call *ast.CallExpr) bool {
+	name := calledName(call)
+	for _, prefix := range statePrefixes {
+		rest, ok := strings.CutPrefix(name, prefix)
+		if !ok {
+			continue
+		}
+
+		// The prefix must be a whole word of the name to avoid matching names like "Setup"
+		r, _ := utf8.DecodeRuneInString(rest)
+		if rest == "" || unicode.IsUpper(r) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,101 @@
+package cosmoslint_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/cli/v29/ignite/pkg/cosmoslint"
+)
+
+func TestCheckDeterminism(t *testing.T) {
+	issue := func(rule, path string, line, column int, message string) cosmoslint.Issue {
+		return cosmoslint.Issue{
+			Tool:    cosmoslint.DeterminismToolName,
+			Rule:    rule,
+			Path:    path,
+			Line:    line,
+			Column:  column,
+			Message: message,
+		}
+	}
+
+	const (
+		fromDistribute = " (reachable from msgServer.Distribute in x/mars/keeper)"
+		fromDraw       = " (reachable from msgServer.Draw in x/mars/keeper)"
+		fromConfig     = " (reachable from msgServer.Config in x/mars/keeper)"
+		fromEndBlock   = " (reachable from AppModule.EndBlock in x/mars/module)"
+		fromBeginBlock = " (reachable from AppModule.BeginBlock in x/venus/module)"
+		fromLaunch     = " (reachable from server.Launch in x/venus/keeper)"
+	)
+
+	all := []cosmoslint.Issue{
+		issue(
+			cosmoslint.RuleMapRange, "x/mars/keeper/keeper.go", 18, 2,
+			"iteration over a map writes to the state in a random order, sort the map keys before iterating"+fromDistribute,
+		),
+		issue(
+			cosmoslint.RuleOS, "x/mars/keeper/keeper.go", 37, 9,
+			"os.Getenv accesses the operating system of the node"+fromConfig,
+		),
+		issue(
+			cosmoslint.RuleGoroutine, "x/mars/keeper/msg_server.go", 16, 2,
+			"goroutines are scheduled in a random order"+fromDistribute,
+		),
+		issue(
+			cosmoslint.RuleRand, "x/mars/keeper/msg_server.go", 24, 9,
+			"math/rand.Intn returns random values that are different in each node"+fromDraw,
+		),
+		issue(
+			cosmoslint.RuleNet, "x/mars/module/module.go", 15, 12,
+			"net/http.Get accesses the network"+fromEndBlock,
+		),
+		issue(
+			cosmoslint.RuleFloat, "x/mars/types/expiration.go", 6, 9,
+			"float64 is used, use sdk.Dec or sdk.Int instead"+fromDraw,
+		),
+		issue(
+			cosmoslint.RuleFloat, "x/mars/types/expiration.go", 6, 29,
+			"floating point literal is used, use sdk.Dec or sdk.Int instead"+fromDraw,
+		),
+		issue(
+			cosmoslint.RuleTimeNow, "x/venus/keeper/keeper.go", 11, 9,
+			"time.Now returns the local time of the node, use the block time of the context instead"+fromBeginBlock,
+		),
+		issue(
+			cosmoslint.RuleRand, "x/venus/keeper/server.go", 18, 9,
+			"math/rand.Intn returns random values that are different in each node"+fromLaunch,
+		),
+	}
+
+	cases := []struct {
+		name      string
+		allowlist cosmoslint.Allowlist
+		want      []cosmoslint.Issue
+	}{
+		{
+			name: "without allowlist",
+			want: all,
+		},
+		{
+			name: "with allowlist",
+			allowlist: cosmoslint.Allowlist{
+				{Path: "x/mars/types/*.go", Rule: cosmoslint.RuleFloat},
+				{Path: "x/mars/keeper/msg_server.go", Line: 16},
+				{Path: "x/*/module/module.go"},
+			},
+			want: []cosmoslint.Issue{all[0], all[1], all[3], all[7], all[8]},
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			// Act
+			issues, err := cosmoslint.CheckDeterminism("testdata/determinism", tt.allowlist)
+
+			// Assert
+			require.NoError(t, err)
+			require.Equal(t, tt.want, issues)
+		})
+	}
+}
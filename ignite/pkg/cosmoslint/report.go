@@ -0,0 +1,183 @@
+package cosmoslint
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"github.com/ignite/cli/v29/ignite/pkg/errors"
+)
+
+// Format is the format of a lint report.
+type Format string
+
+const (
+	// FormatText reports one issue per line.
+	FormatText Format = "text"
+
+	// FormatJSON reports the issues as a JSON list.
+	FormatJSON Format = "json"
+
+	// FormatSARIF reports the issues using the Static Analysis Results Interchange Format,
+	// which is supported by CI services to annotate the source code.
+	FormatSARIF Format = "sarif"
+)
+
+const (
+	sarifVersion = "2.1.0"
+	sarifSchema  = "https://json.schemastore.org/sarif-2.1.0.json"
+	sarifLevel   = "error"
+)
+
+type (
+	sarifReport struct {
+		Version string     `json:"version"`
+		Schema  string     `json:"$schema"`
+		Runs    []sarifRun `json:"runs"`
+	}
+
+	sarifRun struct {
+		Tool    sarifTool     `json:"tool"`
+		Results []sarifResult `json:"results"`
+	}
+
+	sarifTool struct {
+		Driver sarifDriver `json:"driver"`
+	}
+
+	sarifDriver struct {
+		Name  string      `json:"name"`
+		Rules []sarifRule `json:"rules,omitempty"`
+	}
+
+	sarifRule struct {
+		ID string `json:"id"`
+	}
+
+	sarifResult struct {
+		RuleID    string          `json:"ruleId"`
+		Level     string          `json:"level"`
+		Message   sarifMessage    `json:"message"`
+		Locations []sarifLocation `json:"locations"`
+	}
+
+	sarifMessage struct {
+		Text string `json:"text"`
+	}
+
+	sarifLocation struct {
+		PhysicalLocation sarifPhysicalLocation `json:"physicalLocation"`
+	}
+
+	sarifPhysicalLocation struct {
+		ArtifactLocation sarifArtifactLocation `json:"artifactLocation"`
+		Region           sarifRegion           `json:"region"`
+	}
+
+	sarifArtifactLocation struct {
+		URI string `json:"uri"`
+	}
+
+	sarifRegion struct {
+		StartLine   int `json:"startLine"`
+		StartColumn int `json:"startColumn,omitempty"`
+	}
+)
+
+// ParseFormat parses the name of a report format.
+func ParseFormat(name string) (Format, error) {
+	switch f := Format(name); f {
+	case FormatText, FormatJSON, FormatSARIF:
+		return f, nil
+	}
+	return "", errors.Errorf("unsupported report format %q, use %s, %s or %s", name, FormatText, FormatJSON, FormatSARIF)
+}
+
+// WriteReport writes a report of the issues in a format.
+func WriteReport(w io.Writer, format Format, issues []Issue) error {
+	switch format {
+	case FormatText:
+		return writeText(w, issues)
+	case FormatJSON:
+		return writeJSON(w, issues)
+	case FormatSARIF:
+		return writeSARIF(w, issues)
+	}
+	return errors.Errorf("unsupported report format %q", format)
+}
+
+// writeText writes one issue per line with the "path:line:column: message (tool/rule)" format.
+func writeText(w io.Writer, issues []Issue) error {
+	for _, i := range issues {
+		pos := fmt.Sprintf("%s:%d", i.Path, i.Line)
+		if i.Column > 0 {
+			pos = fmt.Sprintf("%s:%d", pos, i.Column)
+		}
+
+		if _, err := fmt.Fprintf(w, "%s: %s (%s/%s)\n", pos, i.Message, i.Tool, i.Rule); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func writeJSON(w io.Writer, issues []Issue) error {
+	if issues == nil {
+		issues = []Issue{}
+	}
+
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+
+	return enc.Encode(issues)
+}
+
+// writeSARIF writes a SARIF report with one run for each tool that found issues.
+func writeSARIF(w io.Writer, issues []Issue) error {
+	report := sarifReport{
+		Version: sarifVersion,
+		Schema:  sarifSchema,
+		Runs:    []sarifRun{},
+	}
+
+	runs := make(map[string]int)
+	rules := make(map[string]map[string]struct{})
+	for _, i := range issues {
+		idx, ok := runs[i.Tool]
+		if !ok {
+			idx = len(report.Runs)
+			runs[i.Tool] = idx
+			rules[i.Tool] = make(map[string]struct{})
+			report.Runs = append(report.Runs, sarifRun{
+				Tool:    sarifTool{Driver: sarifDriver{Name: i.Tool}},
+				Results: []sarifResult{},
+			})
+		}
+
+		run := &report.Runs[idx]
+		if _, ok := rules[i.Tool][i.Rule]; !ok {
+			rules[i.Tool][i.Rule] = struct{}{}
+			run.Tool.Driver.Rules = append(run.Tool.Driver.Rules, sarifRule{ID: i.Rule})
+		}
+
+		run.Results = append(run.Results, sarifResult{
+			RuleID:  i.Rule,
+			Level:   sarifLevel,
+			Message: sarifMessage{Text: i.Message},
+			Locations: []sarifLocation{{
+				PhysicalLocation: sarifPhysicalLocation{
+					ArtifactLocation: sarifArtifactLocation{URI: i.Path},
+					Region: sarifRegion{
+						StartLine:   max(i.Line, 1),
+						StartColumn: i.Column,
+					},
+				},
+			}},
+		})
+	}
+
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+
+	return enc.Encode(report)
+}
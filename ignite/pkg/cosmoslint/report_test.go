@@ -0,0 +1,130 @@
+package cosmoslint_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/cli/v29/ignite/pkg/cosmoslint"
+)
+
+var issues = []cosmoslint.Issue{
+	{
+		Tool:    "golangci-lint",
+		Rule:    "errcheck",
+		Path:    "x/blog/keeper/keeper.go",
+		Line:    10,
+		Column:  2,
+		Message: "Error return value is not checked",
+	},
+	{
+		Tool:    "buf",
+		Rule:    "FIELD_LOWER_SNAKE_CASE",
+		Path:    "proto/blog/blog/v1/tx.proto",
+		Line:    4,
+		Column:  3,
+		Message: "Field name should be lower_snake_case.",
+	},
+	{
+		Tool:    "golangci-lint",
+		Rule:    "errcheck",
+		Path:    "x/blog/keeper/msg_server.go",
+		Line:    7,
+		Message: "Error return value is not checked",
+	},
+}
+
+func TestWriteReportText(t *testing.T) {
+	// Arrange
+	var b bytes.Buffer
+
+	// Act
+	err := cosmoslint.WriteReport(&b, cosmoslint.FormatText, issues)
+
+	// Assert
+	require.NoError(t, err)
+	require.Equal(
+		t,
+		`x/blog/keeper/keeper.go:10:2: Error return value is not checked (golangci-lint/errcheck)
+proto/blog/blog/v1/tx.proto:4:3: Field name should be lower_snake_case. (buf/FIELD_LOWER_SNAKE_CASE)
+x/blog/keeper/msg_server.go:7: Error return value is not checked (golangci-lint/errcheck)
+`,
+		b.String(),
+	)
+}
+
+func TestWriteReportJSON(t *testing.T) {
+	// Arrange
+	var (
+		b      bytes.Buffer
+		parsed []cosmoslint.Issue
+	)
+
+	// Act
+	err := cosmoslint.WriteReport(&b, cosmoslint.FormatJSON, issues)
+
+	// Assert
+	require.NoError(t, err)
+	require.NoError(t, json.Unmarshal(b.Bytes(), &parsed))
+	require.Equal(t, issues, parsed)
+}
+
+func TestWriteReportSARIF(t *testing.T) {
+	// Arrange
+	var (
+		b      bytes.Buffer
+		report struct {
+			Version string `json:"version"`
+			Runs    []struct {
+				Tool struct {
+					Driver struct {
+						Name  string `json:"name"`
+						Rules []struct {
+							ID string `json:"id"`
+						} `json:"rules"`
+					} `json:"driver"`
+				} `json:"tool"`
+				Results []struct {
+					RuleID    string `json:"ruleId"`
+					Locations []struct {
+						PhysicalLocation struct {
+							ArtifactLocation struct {
+								URI string `json:"uri"`
+							} `json:"artifactLocation"`
+							Region struct {
+								StartLine int `json:"startLine"`
+							} `json:"region"`
+						} `json:"physicalLocation"`
+					} `json:"locations"`
+				} `json:"results"`
+			} `json:"runs"`
+		}
+	)
+
+	// Act
+	err := cosmoslint.WriteReport(&b, cosmoslint.FormatSARIF, issues)
+
+	// Assert
+	require.NoError(t, err)
+	require.NoError(t, json.Unmarshal(b.Bytes(), &report))
+	require.Equal(t, "2.1.0", report.Version)
+	require.Len(t, report.Runs, 2)
+	require.Equal(t, "golangci-lint", report.Runs[0].Tool.Driver.Name)
+	require.Len(t, report.Runs[0].Tool.Driver.Rules, 1)
+	require.Len(t, report.Runs[0].Results, 2)
+	require.Equal(t, "x/blog/keeper/msg_server.go", report.Runs[0].Results[1].Locations[0].PhysicalLocation.ArtifactLocation.URI)
+	require.Equal(t, "buf", report.Runs[1].Tool.Driver.Name)
+	require.Equal(t, "FIELD_LOWER_SNAKE_CASE", report.Runs[1].Results[0].RuleID)
+	require.Equal(t, 4, report.Runs[1].Results[0].Locations[0].PhysicalLocation.Region.StartLine)
+}
+
+func TestParseFormat(t *testing.T) {
+	f, err := cosmoslint.ParseFormat("sarif")
+	require.NoError(t, err)
+	require.Equal(t, cosmoslint.FormatSARIF, f)
+
+	_, err = cosmoslint.ParseFormat("xml")
+	require.EqualError(t, err, `unsupported report format "xml", use text, json or sarif`)
+}
@@ -0,0 +1,6 @@
+package cli
+
+func Flags(flags map[string]string) {
+	for range flags {
+	}
+}
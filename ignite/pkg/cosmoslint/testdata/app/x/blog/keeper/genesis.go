@@ -0,0 +1,15 @@
+package keeper
+
+func (k Keeper) ExportGenesis() []string {
+	posts := make(map[uint64]string)
+
+	var list []string
+	for _, p := range posts {
+		list = append(list, p)
+	}
+
+	for range map[string]int{"a": 1} { //nolint
+	}
+
+	return list
+}
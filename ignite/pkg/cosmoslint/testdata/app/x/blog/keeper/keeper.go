@@ -0,0 +1,34 @@
+package keeper
+
+import (
+	"time"
+)
+
+var defaultParams = map[string]string{}
+
+type Keeper struct {
+	authority string
+	hooks     map[string]func()
+}
+
+func (k Keeper) Hooks() {
+	for _, h := range k.hooks {
+		h()
+	}
+}
+
+func (k Keeper) Params() []string {
+	var params []string
+	for _, v := range defaultParams {
+		params = append(params, v)
+	}
+	return params
+}
+
+func (k Keeper) Now() time.Time {
+	return time.Now()
+}
+
+func (k Keeper) Ignored() time.Time {
+	return time.Now() //nolint:timenow
+}
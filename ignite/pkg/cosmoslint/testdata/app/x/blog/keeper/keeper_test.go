@@ -0,0 +1,7 @@
+package keeper
+
+import "time"
+
+func testNow() time.Time {
+	return time.Now()
+}
@@ -0,0 +1,33 @@
+package keeper
+
+import (
+	"sort"
+)
+
+type msgServer struct {
+	Keeper
+}
+
+func (k msgServer) CreatePost(amounts map[string]uint64) (uint64, error) {
+	keys := make([]string, 0, len(amounts))
+	for key := range amounts {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	var total uint64
+	for _, key := range keys {
+		total += amounts[key]
+	}
+
+	fee := float64(total) * 0.01
+	return total - uint64(fee), nil
+}
+
+func sum(values []float64) float64 {
+	var total float64
+	for _, v := range values {
+		total += v
+	}
+	return total
+}
@@ -0,0 +1,12 @@
+package module
+
+import (
+	t "time"
+)
+
+func BeginBlock(votes map[string]bool) t.Time {
+	for voter := range votes {
+		_ = voter
+	}
+	return t.Now()
+}
@@ -0,0 +1,3 @@
+module example.com/mars
+
+go 1.21
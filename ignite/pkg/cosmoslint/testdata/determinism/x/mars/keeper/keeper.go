@@ -0,0 +1,38 @@
+package keeper
+
+import (
+	"os"
+	"time"
+)
+
+type Keeper struct {
+	balances map[string]uint64
+	store    map[string]uint64
+}
+
+func (k Keeper) SetBalance(addr string, amount uint64) {
+	k.store[addr] = amount
+}
+
+func (k Keeper) Distribute() {
+	for addr, amount := range k.balances {
+		k.SetBalance(addr, amount)
+	}
+}
+
+func (k Keeper) Total() uint64 {
+	var total uint64
+	for _, amount := range k.balances {
+		total += amount
+	}
+	return total
+}
+
+func (k Keeper) Unreachable() time.Time {
+	go k.Distribute()
+	return time.Now()
+}
+
+func (k Keeper) HomeDir() string {
+	return os.Getenv("HOME")
+}
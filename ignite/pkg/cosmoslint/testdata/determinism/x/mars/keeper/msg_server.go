@@ -0,0 +1,33 @@
+package keeper
+
+import (
+	"math/rand"
+	"time"
+
+	"example.com/mars/x/mars/types"
+)
+
+type msgServer struct {
+	Keeper
+}
+
+func (k msgServer) Distribute() error {
+	k.Keeper.Distribute()
+	go k.Total()
+	return nil
+}
+
+func (k msgServer) Draw() (int, error) {
+	if types.Expired(time.Now()) { //nolint:timenow
+		return 0, nil
+	}
+	return rand.Intn(10), nil
+}
+
+func (k msgServer) Config() string {
+	return k.HomeDir()
+}
+
+func NewMsgServerImpl(keeper Keeper) types.MsgServer {
+	return &msgServer{Keeper: keeper}
+}
@@ -0,0 +1,23 @@
+package mars
+
+import (
+	"net/http"
+
+	"example.com/mars/x/mars/keeper"
+	"example.com/mars/x/mars/types"
+)
+
+type AppModule struct {
+	keeper keeper.Keeper
+}
+
+func (am AppModule) EndBlock() error {
+	_, err := http.Get("https://example.com")
+	return err
+}
+
+func (am AppModule) RegisterServices(cfg Configurator) {
+	types.RegisterMsgServer(cfg.MsgServer(), keeper.NewMsgServerImpl(am.keeper))
+}
+
+func (am AppModule) IsAppModule() {}
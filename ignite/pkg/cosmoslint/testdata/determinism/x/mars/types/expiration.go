@@ -0,0 +1,7 @@
+package types
+
+import "time"
+
+func Expired(t time.Time) bool {
+	return float64(t.Unix()) > 1.5e9
+}
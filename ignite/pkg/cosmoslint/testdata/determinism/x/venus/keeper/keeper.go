@@ -0,0 +1,12 @@
+package keeper
+
+import (
+	"context"
+	"time"
+)
+
+type Keeper struct{}
+
+func (k Keeper) Tick(_ context.Context) time.Time {
+	return time.Now()
+}
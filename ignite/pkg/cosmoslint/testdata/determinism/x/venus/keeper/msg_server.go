@@ -0,0 +1,12 @@
+package keeper
+
+import "os"
+
+// msgServer is not registered as a message server by the module.
+type msgServer struct {
+	Keeper
+}
+
+func (k msgServer) Land() string {
+	return os.Getenv("HOME")
+}
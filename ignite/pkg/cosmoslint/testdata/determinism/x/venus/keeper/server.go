@@ -0,0 +1,19 @@
+package keeper
+
+import (
+	"math/rand"
+
+	"example.com/mars/x/venus/types"
+)
+
+type server struct {
+	k Keeper
+}
+
+func NewServer(k Keeper) types.MsgServer {
+	return server{k: k}
+}
+
+func (s server) Launch() int {
+	return rand.Intn(10)
+}
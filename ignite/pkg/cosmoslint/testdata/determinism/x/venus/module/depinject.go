@@ -0,0 +1,5 @@
+package venus
+
+func (am AppModule) IsOnePerModuleType() {}
+
+func (am AppModule) IsAppModule() {}
@@ -0,0 +1,21 @@
+package venus
+
+import (
+	"context"
+
+	"example.com/mars/x/venus/keeper"
+	"example.com/mars/x/venus/types"
+)
+
+type AppModule struct {
+	keeper keeper.Keeper
+}
+
+func (am AppModule) BeginBlock(ctx context.Context) error {
+	am.keeper.Tick(ctx)
+	return nil
+}
+
+func (am AppModule) RegisterServices(cfg Configurator) {
+	types.RegisterMsgServer(cfg.MsgServer(), keeper.NewServer(am.keeper))
+}
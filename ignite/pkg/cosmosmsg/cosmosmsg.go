@@ -0,0 +1,240 @@
+// Package cosmosmsg creates Cosmos SDK messages from their proto JSON representation.
+// The message types don't need to be known at compile time because their
+// descriptors are fetched from the gRPC reflection service of a node.
+package cosmosmsg
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"sync"
+
+	"google.golang.org/grpc"
+	rpb "google.golang.org/grpc/reflection/grpc_reflection_v1alpha"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/dynamicpb"
+
+	"github.com/ignite/cli/v29/ignite/pkg/errors"
+)
+
+// typeKey is the JSON key that contains the message type URL.
+const typeKey = "@type"
+
+// ErrTypeNotFound is returned when a message type is not registered in the node.
+var ErrTypeNotFound = errors.New("message type not found")
+
+// Msg is a message that is already encoded using the protobuf binary format.
+// It implements the Cosmos SDK message interface so it can be included in
+// transactions that are signed using the direct sign mode.
+type Msg struct {
+	typeURL string
+	value   []byte
+}
+
+// NewMsg creates a new message from its type URL and its protobuf encoded value.
+func NewMsg(typeURL string, value []byte) *Msg {
+	return &Msg{
+		typeURL: "/" + strings.TrimPrefix(typeURL, "/"),
+		value:   value,
+	}
+}
+
+// TypeURL returns the type URL of the message.
+func (m *Msg) TypeURL() string {
+	return m.typeURL
+}
+
+// Reset resets the message.
+func (m *Msg) Reset() {
+	*m = Msg{}
+}
+
+// String returns the type URL of the message.
+func (m *Msg) String() string {
+	return m.typeURL
+}
+
+// ProtoMessage marks the type as a protobuf message.
+func (*Msg) ProtoMessage() {}
+
+// Marshal returns the protobuf encoded value of the message.
+func (m *Msg) Marshal() ([]byte, error) {
+	return m.value, nil
+}
+
+// XXX_MessageName returns the full name of the message type,
+// which is used to create the type URL of the message.
+func (m *Msg) XXX_MessageName() string { //nolint:revive,stylecheck
+	return strings.TrimPrefix(m.typeURL, "/")
+}
+
+// Decoder decodes messages from their proto JSON representation.
+type Decoder struct {
+	client rpb.ServerReflectionClient
+
+	mu    sync.Mutex
+	files map[string]*descriptorpb.FileDescriptorProto
+	types *dynamicpb.Types
+}
+
+// NewDecoder creates a new decoder that uses the gRPC reflection service of a node.
+func NewDecoder(conn grpc.ClientConnInterface) *Decoder {
+	return &Decoder{
+		client: rpb.NewServerReflectionClient(conn),
+		files:  make(map[string]*descriptorpb.FileDescriptorProto),
+		types:  dynamicpb.NewTypes(new(protoregistry.Files)),
+	}
+}
+
+// DecodeJSON decodes a message from its proto JSON representation.
+// The message type URL must be defined using the "@type" key.
+func (d *Decoder) DecodeJSON(ctx context.Context, bz []byte) (*Msg, error) {
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(bz, &fields); err != nil {
+		return nil, errors.Wrap(err, "invalid message JSON")
+	}
+
+	var typeURL string
+	if err := json.Unmarshal(fields[typeKey], &typeURL); err != nil || typeURL == "" {
+		return nil, errors.Errorf("message requires a %q type URL", typeKey)
+	}
+
+	// The type is not a field of the message
+	delete(fields, typeKey)
+
+	bz, err := json.Marshal(fields)
+	if err != nil {
+		return nil, err
+	}
+
+	r := resolver{ctx: ctx, decoder: d}
+	mt, err := r.FindMessageByURL(typeURL)
+	if err != nil {
+		return nil, err
+	}
+
+	msg := mt.New().Interface()
+	if err := (protojson.UnmarshalOptions{Resolver: r}).Unmarshal(bz, msg); err != nil {
+		return nil, errors.Wrapf(err, "invalid %s message", typeURL)
+	}
+
+	value, err := proto.Marshal(msg)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewMsg(typeURL, value), nil
+}
+
+// findMessage returns the type of a message and fetches its descriptor from
+// the node when the type is not known yet.
+func (d *Decoder) findMessage(ctx context.Context, name protoreflect.FullName) (protoreflect.MessageType, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	mt, err := d.types.FindMessageByName(name)
+	if err == nil {
+		return mt, nil
+	}
+
+	if !errors.Is(err, protoregistry.NotFound) {
+		return nil, err
+	}
+
+	if err := d.fetchFiles(ctx, string(name)); err != nil {
+		return nil, err
+	}
+
+	if mt, err = d.types.FindMessageByName(name); errors.Is(err, protoregistry.NotFound) {
+		return nil, errors.Wrapf(ErrTypeNotFound, "%s", name)
+	}
+
+	return mt, err
+}
+
+// fetchFiles fetches the file descriptor that defines a symbol, together with its
+// dependencies, and updates the known types with the new file descriptors.
+func (d *Decoder) fetchFiles(ctx context.Context, symbol string) error {
+	stream, err := d.client.ServerReflectionInfo(ctx)
+	if err != nil {
+		return errors.Wrap(err, "cannot connect to the node reflection service")
+	}
+
+	defer stream.CloseSend() //nolint:errcheck
+
+	err = stream.Send(&rpb.ServerReflectionRequest{
+		MessageRequest: &rpb.ServerReflectionRequest_FileContainingSymbol{
+			FileContainingSymbol: symbol,
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	res, err := stream.Recv()
+	if err != nil {
+		return err
+	}
+
+	if e := res.GetErrorResponse(); e != nil {
+		return errors.Wrapf(ErrTypeNotFound, "%s: %s", symbol, e.GetErrorMessage())
+	}
+
+	for _, bz := range res.GetFileDescriptorResponse().GetFileDescriptorProto() {
+		var fd descriptorpb.FileDescriptorProto
+		if err := proto.Unmarshal(bz, &fd); err != nil {
+			return errors.Wrap(err, "invalid file descriptor")
+		}
+
+		d.files[fd.GetName()] = &fd
+	}
+
+	set := &descriptorpb.FileDescriptorSet{}
+	for _, fd := range d.files {
+		set.File = append(set.File, fd)
+	}
+
+	// Descriptors can have dependencies that are not relevant to
+	// decode messages, like the options of other proto plugins
+	files, err := protodesc.FileOptions{AllowUnresolvable: true}.NewFiles(set)
+	if err != nil {
+		return errors.Wrap(err, "invalid file descriptors")
+	}
+
+	d.types = dynamicpb.NewTypes(files)
+
+	return nil
+}
+
+// resolver resolves the message types of the JSON values of a message,
+// for example the messages that are packed into an Any value.
+type resolver struct {
+	ctx     context.Context
+	decoder *Decoder
+}
+
+func (r resolver) FindMessageByName(name protoreflect.FullName) (protoreflect.MessageType, error) {
+	return r.decoder.findMessage(r.ctx, name)
+}
+
+func (r resolver) FindMessageByURL(url string) (protoreflect.MessageType, error) {
+	name := url
+	if i := strings.LastIndexByte(url, '/'); i >= 0 {
+		name = url[i+1:]
+	}
+
+	return r.decoder.findMessage(r.ctx, protoreflect.FullName(name))
+}
+
+func (resolver) FindExtensionByName(protoreflect.FullName) (protoreflect.ExtensionType, error) {
+	return nil, protoregistry.NotFound
+}
+
+func (resolver) FindExtensionByNumber(protoreflect.FullName, protoreflect.FieldNumber) (protoreflect.ExtensionType, error) {
+	return nil, protoregistry.NotFound
+}
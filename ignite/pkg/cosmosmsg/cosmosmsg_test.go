@@ -0,0 +1,82 @@
+package cosmosmsg_test
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	gogoproto "github.com/cosmos/gogoproto/proto"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/reflection"
+	rpb "google.golang.org/grpc/reflection/grpc_reflection_v1alpha"
+	"google.golang.org/grpc/test/bufconn"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/ignite/cli/v29/ignite/pkg/cosmosmsg"
+)
+
+func newReflectionConn(t *testing.T) *grpc.ClientConn {
+	t.Helper()
+
+	lis := bufconn.Listen(1024 * 1024)
+	srv := grpc.NewServer()
+	reflection.Register(srv)
+
+	go srv.Serve(lis) //nolint:errcheck
+	t.Cleanup(srv.Stop)
+
+	conn, err := grpc.Dial(
+		"bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return lis.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	require.NoError(t, err)
+	t.Cleanup(func() { conn.Close() })
+
+	return conn
+}
+
+func TestDecodeJSON(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	decoder := cosmosmsg.NewDecoder(newReflectionConn(t))
+	bz := []byte(`{
+		"@type": "/grpc.reflection.v1alpha.ServerReflectionRequest",
+		"host": "localhost",
+		"file_containing_symbol": "mars.blog.MsgCreatePost"
+	}`)
+
+	// Act
+	msg, err := decoder.DecodeJSON(ctx, bz)
+
+	// Assert
+	require.NoError(t, err)
+	require.Equal(t, "/grpc.reflection.v1alpha.ServerReflectionRequest", msg.TypeURL())
+
+	value, err := gogoproto.Marshal(msg)
+	require.NoError(t, err)
+
+	var got rpb.ServerReflectionRequest
+	require.NoError(t, proto.Unmarshal(value, &got))
+	require.Equal(t, "localhost", got.GetHost())
+	require.Equal(t, "mars.blog.MsgCreatePost", got.GetFileContainingSymbol())
+	require.Equal(t, "grpc.reflection.v1alpha.ServerReflectionRequest", gogoproto.MessageName(msg))
+}
+
+func TestDecodeJSONWithInvalidMessages(t *testing.T) {
+	ctx := context.Background()
+	decoder := cosmosmsg.NewDecoder(newReflectionConn(t))
+
+	_, err := decoder.DecodeJSON(ctx, []byte(`{"@type": "/mars.blog.MsgCreatePost", "title": "hello"}`))
+	require.ErrorIs(t, err, cosmosmsg.ErrTypeNotFound)
+
+	_, err = decoder.DecodeJSON(ctx, []byte(`{"title": "hello"}`))
+	require.EqualError(t, err, `message requires a "@type" type URL`)
+
+	_, err = decoder.DecodeJSON(ctx, []byte(`{"@type": "/grpc.reflection.v1alpha.ServerReflectionRequest", "title": "hello"}`))
+	require.ErrorContains(t, err, "invalid /grpc.reflection.v1alpha.ServerReflectionRequest message")
+}
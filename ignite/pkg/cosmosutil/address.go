@@ -1,6 +1,7 @@
 package cosmosutil
 
 import (
+	"github.com/cosmos/cosmos-sdk/types/address"
 	"github.com/cosmos/cosmos-sdk/types/bech32"
 
 	"github.com/ignite/cli/v29/ignite/pkg/errors"
@@ -23,3 +24,11 @@ func GetAddressPrefix(address string) (string, error) {
 	prefix, _, err := bech32.DecodeAndConvert(address)
 	return prefix, err
 }
+
+// ModuleAddress returns the bech 32 address of a module account using a prefix.
+func ModuleAddress(moduleName, prefix string) (string, error) {
+	if prefix == "" {
+		return "", errors.New("empty prefix")
+	}
+	return bech32.ConvertAndEncode(prefix, address.Module(moduleName))
+}
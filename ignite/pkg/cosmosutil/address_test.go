@@ -79,3 +79,17 @@ func TestGetPrefix(t *testing.T) {
 	_, err = cosmosutil.GetAddressPrefix("mars1c6ac48k2ur9tl3tf0cpntlw5068kvp8xf4xq37")
 	require.Error(t, err)
 }
+
+func TestModuleAddress(t *testing.T) {
+	address, err := cosmosutil.ModuleAddress("gov", "cosmos")
+	require.NoError(t, err)
+	require.Equal(t, "cosmos10d07y265gmmuvt4z0w9aw880jnsr700j6zn9kn", address)
+
+	address, err = cosmosutil.ModuleAddress("treasury", "cosmos")
+	require.NoError(t, err)
+	require.Equal(t, "cosmos1vmafl8f3s6uuzwnxkqz0eza47v6ecn0tfqrcl7", address)
+
+	// empty prefix
+	_, err = cosmosutil.ModuleAddress("gov", "")
+	require.Error(t, err)
+}
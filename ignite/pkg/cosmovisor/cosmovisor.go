@@ -0,0 +1,127 @@
+// Package cosmovisor creates the files used by Cosmovisor to upgrade a chain.
+package cosmovisor
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/ignite/cli/v29/ignite/pkg/errors"
+)
+
+const (
+	// Dir is the name of the Cosmovisor directory in the node home.
+	Dir = "cosmovisor"
+
+	// UpgradesDir is the name of the directory with the binaries of the upgrades.
+	UpgradesDir = "upgrades"
+
+	// BinDir is the name of the directory with the binary of an upgrade.
+	BinDir = "bin"
+
+	// UpgradeInfoFile is the name of the file written by the nodes to their data
+	// directory when they halt at an upgrade height, which is read by Cosmovisor.
+	UpgradeInfoFile = "upgrade-info.json"
+
+	// PlanInfoFile is the name of the file with the upgrade plan info of a release.
+	// It's named differently from the upgrade info file of the nodes to avoid
+	// confusing them when the release is extracted in a node directory.
+	PlanInfoFile = "upgrade-plan-info.json"
+)
+
+// UpgradeBinPath returns the path of the binary of an upgrade inside a Cosmovisor directory tree.
+// The binary has the ".exe" extension on Windows, where Cosmovisor expects it.
+func UpgradeBinPath(root, name, binary, goos string) string {
+	if goos == "windows" {
+		binary += ".exe"
+	}
+	return filepath.Join(root, Dir, UpgradesDir, name, BinDir, binary)
+}
+
+// UpgradeInfo is the info of an upgrade plan, which contains the URLs to
+// download the upgrade binaries for each platform. Cosmovisor uses it to
+// download the upgrade binary when the upgrade height is reached.
+type UpgradeInfo struct {
+	Binaries map[string]string `json:"binaries"`
+}
+
+// NewUpgradeInfo creates a new upgrade plan info without binaries.
+func NewUpgradeInfo() UpgradeInfo {
+	return UpgradeInfo{Binaries: make(map[string]string)}
+}
+
+// AddBinary adds the URL of the upgrade binary of a platform.
+// The SHA256 checksum of the binary file is added to the URL to
+// allow Cosmovisor to verify the binary once it's downloaded.
+func (i UpgradeInfo) AddBinary(goos, goarch, binaryURL, sha256 string) error {
+	u, err := url.Parse(binaryURL)
+	if err != nil {
+		return errors.Errorf("invalid binary URL %s: %w", binaryURL, err)
+	}
+
+	// The checksum is not escaped to keep the URL readable
+	checksum := "checksum=sha256:" + sha256
+	if u.RawQuery == "" {
+		u.RawQuery = checksum
+	} else {
+		u.RawQuery += "&" + checksum
+	}
+
+	i.Binaries[Platform(goos, goarch)] = u.String()
+
+	return nil
+}
+
+// Platforms returns the platforms with an upgrade binary.
+func (i UpgradeInfo) Platforms() []string {
+	platforms := make([]string, 0, len(i.Binaries))
+	for p := range i.Binaries {
+		platforms = append(platforms, p)
+	}
+
+	sort.Strings(platforms)
+
+	return platforms
+}
+
+// String returns the JSON representation of the upgrade plan info,
+// which is the value used as info of the governance upgrade proposal.
+func (i UpgradeInfo) String() string {
+	bz, _ := json.Marshal(i)
+	return string(bz)
+}
+
+// Save writes the upgrade plan info to a file.
+func (i UpgradeInfo) Save(path string) error {
+	bz, err := json.MarshalIndent(i, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(path, append(bz, '\n'), 0o644)
+}
+
+// Platform returns the name used by Cosmovisor for the platform of a Go target.
+func Platform(goos, goarch string) string {
+	return fmt.Sprintf("%s/%s", goos, goarch)
+}
+
+// FileURL returns a file URL for a local file, which can be used as binary URL
+// to test upgrades locally when the binaries are not published.
+func FileURL(path string) (string, error) {
+	path, err := filepath.Abs(path)
+	if err != nil {
+		return "", err
+	}
+
+	return (&url.URL{Scheme: "file", Path: filepath.ToSlash(path)}).String(), nil
+}
+
+// JoinURL joins a base URL and a file name.
+func JoinURL(baseURL, name string) string {
+	return strings.TrimSuffix(baseURL, "/") + "/" + url.PathEscape(name)
+}
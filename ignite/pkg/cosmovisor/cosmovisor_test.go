@@ -0,0 +1,48 @@
+package cosmovisor_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/cli/v29/ignite/pkg/cosmovisor"
+)
+
+func TestUpgradeBinPath(t *testing.T) {
+	got := cosmovisor.UpgradeBinPath("release", "v2", "marsd", "linux")
+	require.Equal(t, filepath.Join("release", "cosmovisor", "upgrades", "v2", "bin", "marsd"), got)
+
+	got = cosmovisor.UpgradeBinPath("release", "v2", "marsd", "windows")
+	require.Equal(t, filepath.Join("release", "cosmovisor", "upgrades", "v2", "bin", "marsd.exe"), got)
+}
+
+func TestUpgradeInfo(t *testing.T) {
+	// Arrange
+	info := cosmovisor.NewUpgradeInfo()
+	path := filepath.Join(t.TempDir(), cosmovisor.PlanInfoFile)
+
+	// Act
+	err := info.AddBinary(
+		"linux",
+		"amd64",
+		cosmovisor.JoinURL("https://example.com/releases/v2/", "mars_linux_amd64.tar.gz"),
+		"2cf24dba5fb0a30e26e83b2ac5b9e29e1b161e5c1fa7425e73043362938b9824",
+	)
+	require.NoError(t, err)
+	require.NoError(t, info.AddBinary("darwin", "arm64", "file:///tmp/mars_darwin_arm64.tar.gz", "aa"))
+	require.NoError(t, info.Save(path))
+
+	// Assert
+	require.Equal(t, []string{"darwin/arm64", "linux/amd64"}, info.Platforms())
+	require.Equal(
+		t,
+		"https://example.com/releases/v2/mars_linux_amd64.tar.gz?checksum=sha256:2cf24dba5fb0a30e26e83b2ac5b9e29e1b161e5c1fa7425e73043362938b9824",
+		info.Binaries["linux/amd64"],
+	)
+
+	bz, err := os.ReadFile(path)
+	require.NoError(t, err)
+	require.JSONEq(t, info.String(), string(bz))
+}
@@ -13,6 +13,7 @@ type ProgressIndication uint8
 
 const (
 	GroupError = "error"
+	GroupCrash = "crash"
 )
 
 const (
@@ -54,6 +54,8 @@ const (
 	EnvGOMOD = "GOMOD"
 	// EnvGOOS represents GOOS variable.
 	EnvGOOS = "GOOS"
+	// EnvGOVERSION represents GOVERSION variable.
+	EnvGOVERSION = "GOVERSION"
 
 	// FlagGcflags represents gcflags go flag.
 	FlagGcflags = "-gcflags"
@@ -69,6 +71,10 @@ const (
 	FlagModValueReadOnly = "readonly"
 	// FlagOut represents out go flag.
 	FlagOut = "-o"
+	// FlagTrimpath represents trimpath go flag.
+	FlagTrimpath = "-trimpath"
+	// FlagBuildVCSTrue represents buildvcs go flag to always stamp binaries with version control information.
+	FlagBuildVCSTrue = "-buildvcs=true"
 )
 
 // Env returns the value of `go env name`.
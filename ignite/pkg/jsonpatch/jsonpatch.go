@@ -0,0 +1,490 @@
+// Package jsonpatch applies patch operations to decoded JSON documents.
+// The document values are the ones produced by decoding JSON into an
+// interface{}, which are objects as map[string]interface{} and arrays
+// as []interface{}.
+//
+// Patch paths use a subset of the JSONPath syntax:
+//
+//	$.app_state.bank.denom_metadata[0].display
+//	app_state.bank.denom_metadata[?(@.base=='stake')].display
+//	app_state['gov'].params
+//
+// The leading "$" is optional. Keys are separated by dots or written between
+// brackets and quotes, array elements are selected by index, where negative
+// indexes count from the end of the array, and filters select all the array
+// elements with a field equal to a value.
+package jsonpatch
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/ignite/cli/v29/ignite/pkg/errors"
+)
+
+// Op defines a patch operation.
+type Op string
+
+const (
+	// OpAdd adds a value to an object or inserts it in an array at the given index.
+	// An existing object value is overwritten.
+	OpAdd Op = "add"
+
+	// OpReplace replaces an existing value.
+	OpReplace Op = "replace"
+
+	// OpRemove removes an existing value.
+	OpRemove Op = "remove"
+
+	// OpAppend appends a value to an existing array.
+	OpAppend Op = "append"
+
+	// OpMerge deep merges an object into an existing object.
+	OpMerge Op = "merge"
+)
+
+var (
+	// ErrInvalidPath is returned when a patch path can't be parsed.
+	ErrInvalidPath = errors.New("invalid path")
+
+	// ErrPathNotFound is returned when a patch path doesn't match any value.
+	ErrPathNotFound = errors.New("path not found")
+)
+
+// Patch defines a patch operation to apply to a JSON document.
+type Patch struct {
+	// Op is the patch operation.
+	Op Op
+
+	// Path is the JSONPath of the values to patch.
+	Path string
+
+	// Value is the value used by the operation, it is ignored when removing values.
+	Value interface{}
+}
+
+// Validate checks that the patch operation and path are valid.
+func (p Patch) Validate() error {
+	switch p.Op {
+	case OpAdd, OpReplace, OpAppend:
+		if p.Value == nil {
+			return errors.Errorf("%s operation requires a value", p.Op)
+		}
+	case OpMerge:
+		if _, ok := p.Value.(map[string]interface{}); !ok {
+			return errors.Errorf("%s operation requires an object value", p.Op)
+		}
+	case OpRemove:
+	default:
+		return errors.Errorf(
+			"invalid operation %q, use %s, %s, %s, %s or %s",
+			p.Op,
+			OpAdd,
+			OpReplace,
+			OpRemove,
+			OpAppend,
+			OpMerge,
+		)
+	}
+
+	tokens, err := parsePath(p.Path)
+	if err != nil {
+		return err
+	}
+
+	if len(tokens) == 0 {
+		if p.Op == OpAdd || p.Op == OpRemove {
+			return errors.Errorf("%s operation can't target the document root", p.Op)
+		}
+		return nil
+	}
+
+	if last := tokens[len(tokens)-1]; p.Op == OpAdd && last.kind == tokenFilter {
+		return errors.Errorf("%s operation can't target a filter, use %s instead", p.Op, OpAppend)
+	}
+
+	return nil
+}
+
+// Apply applies the patch to a decoded JSON document and returns the patched document.
+// The document might be modified in place.
+func (p Patch) Apply(doc interface{}) (interface{}, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	// Decode the value as JSON to use the same types as the document
+	value, err := normalize(p.Value)
+	if err != nil {
+		return nil, err
+	}
+	p.Value = value
+
+	tokens, err := parsePath(p.Path)
+	if err != nil {
+		return nil, err
+	}
+
+	return p.apply(doc, tokens)
+}
+
+// Apply applies patches in order to a JSON document and returns the patched JSON document.
+func Apply(doc []byte, patches ...Patch) ([]byte, error) {
+	var v interface{}
+	if err := unmarshal(doc, &v); err != nil {
+		return nil, err
+	}
+
+	for i, p := range patches {
+		var err error
+		if v, err = p.Apply(v); err != nil {
+			return nil, errors.Errorf("patch #%d (%s %s): %w", i+1, p.Op, p.Path, err)
+		}
+	}
+
+	return json.Marshal(v)
+}
+
+func (p Patch) apply(node interface{}, tokens []token) (interface{}, error) {
+	if len(tokens) == 0 {
+		return p.applyToTarget(node)
+	}
+
+	t, rest := tokens[0], tokens[1:]
+	switch t.kind {
+	case tokenKey:
+		obj, ok := node.(map[string]interface{})
+		if !ok {
+			return nil, errors.Errorf("can't select key %q of a value that is not an object", t.key)
+		}
+
+		child, exists := obj[t.key]
+		if len(rest) == 0 {
+			switch p.Op {
+			case OpAdd:
+				obj[t.key] = p.Value
+				return obj, nil
+			case OpRemove:
+				if !exists {
+					return nil, errors.Wrapf(ErrPathNotFound, "key %q not found", t.key)
+				}
+
+				delete(obj, t.key)
+				return obj, nil
+			}
+		}
+
+		if !exists {
+			return nil, errors.Wrapf(ErrPathNotFound, "key %q not found", t.key)
+		}
+
+		child, err := p.apply(child, rest)
+		if err != nil {
+			return nil, err
+		}
+
+		obj[t.key] = child
+		return obj, nil
+	case tokenIndex:
+		arr, ok := node.([]interface{})
+		if !ok {
+			return nil, errors.Errorf("can't select index %d of a value that is not an array", t.index)
+		}
+
+		i := t.index
+		if i < 0 {
+			i += len(arr)
+		}
+
+		if len(rest) == 0 {
+			switch p.Op {
+			case OpAdd:
+				// Adding at the index after the last element appends the value
+				if i < 0 || i > len(arr) {
+					return nil, errors.Wrapf(ErrPathNotFound, "index %d out of range", t.index)
+				}
+
+				arr = append(arr, nil)
+				copy(arr[i+1:], arr[i:])
+				arr[i] = p.Value
+				return arr, nil
+			case OpRemove:
+				if i < 0 || i >= len(arr) {
+					return nil, errors.Wrapf(ErrPathNotFound, "index %d out of range", t.index)
+				}
+
+				return append(arr[:i], arr[i+1:]...), nil
+			}
+		}
+
+		if i < 0 || i >= len(arr) {
+			return nil, errors.Wrapf(ErrPathNotFound, "index %d out of range", t.index)
+		}
+
+		child, err := p.apply(arr[i], rest)
+		if err != nil {
+			return nil, err
+		}
+
+		arr[i] = child
+		return arr, nil
+	case tokenFilter:
+		arr, ok := node.([]interface{})
+		if !ok {
+			return nil, errors.Errorf("can't filter a value that is not an array: %s", t)
+		}
+
+		var (
+			matched bool
+			result  = make([]interface{}, 0, len(arr))
+		)
+
+		for _, el := range arr {
+			if !t.match(el) {
+				result = append(result, el)
+				continue
+			}
+
+			matched = true
+			if len(rest) == 0 && p.Op == OpRemove {
+				continue
+			}
+
+			child, err := p.apply(el, rest)
+			if err != nil {
+				return nil, err
+			}
+
+			result = append(result, child)
+		}
+
+		if !matched {
+			return nil, errors.Wrapf(ErrPathNotFound, "no element matches %s", t)
+		}
+
+		return result, nil
+	}
+
+	return nil, errors.Errorf("unknown path token %s", t)
+}
+
+func (p Patch) applyToTarget(node interface{}) (interface{}, error) {
+	switch p.Op {
+	case OpReplace:
+		return p.Value, nil
+	case OpAppend:
+		arr, ok := node.([]interface{})
+		if !ok {
+			return nil, errors.New("can't append to a value that is not an array")
+		}
+
+		return append(arr, p.Value), nil
+	case OpMerge:
+		obj, ok := node.(map[string]interface{})
+		if !ok {
+			return nil, errors.New("can't merge into a value that is not an object")
+		}
+
+		return merge(obj, p.Value.(map[string]interface{})), nil
+	}
+
+	return nil, errors.Errorf("%s operation can't target the selected value", p.Op)
+}
+
+// merge deep merges the src object into the dst object.
+// Nested objects are merged and any other src value overwrites the dst value.
+func merge(dst, src map[string]interface{}) map[string]interface{} {
+	for k, v := range src {
+		srcObj, srcOK := v.(map[string]interface{})
+		dstObj, dstOK := dst[k].(map[string]interface{})
+		if srcOK && dstOK {
+			dst[k] = merge(dstObj, srcObj)
+		} else {
+			dst[k] = v
+		}
+	}
+
+	return dst
+}
+
+// normalize converts a value to the types used by a decoded JSON document.
+func normalize(v interface{}) (interface{}, error) {
+	if v == nil {
+		return nil, nil
+	}
+
+	bz, err := json.Marshal(v)
+	if err != nil {
+		return nil, errors.Errorf("invalid patch value: %w", err)
+	}
+
+	var value interface{}
+	return value, unmarshal(bz, &value)
+}
+
+// unmarshal decodes JSON keeping numbers as json.Number to avoid losing precision.
+func unmarshal(bz []byte, v interface{}) error {
+	d := json.NewDecoder(strings.NewReader(string(bz)))
+	d.UseNumber()
+	return d.Decode(v)
+}
+
+type tokenKind int
+
+const (
+	tokenKey tokenKind = iota
+	tokenIndex
+	tokenFilter
+)
+
+type token struct {
+	kind  tokenKind
+	key   string
+	index int
+
+	// filter field and value
+	field string
+	value string
+}
+
+func (t token) String() string {
+	switch t.kind {
+	case tokenIndex:
+		return fmt.Sprintf("[%d]", t.index)
+	case tokenFilter:
+		return fmt.Sprintf("[?(@.%s=='%s')]", t.field, t.value)
+	default:
+		return t.key
+	}
+}
+
+// match checks if an array element matches a filter token.
+func (t token) match(el interface{}) bool {
+	obj, ok := el.(map[string]interface{})
+	if !ok {
+		return false
+	}
+
+	v, ok := obj[t.field]
+	if !ok {
+		return false
+	}
+
+	return fmt.Sprint(v) == t.value
+}
+
+// parsePath parses a JSONPath into tokens.
+func parsePath(path string) ([]token, error) {
+	path = strings.TrimSpace(path)
+	if path == "" {
+		return nil, errors.Wrap(ErrInvalidPath, "empty path")
+	}
+
+	if strings.HasPrefix(path, ".") {
+		return nil, errors.Wrapf(ErrInvalidPath, "%s: path can't start with '.'", path)
+	}
+
+	s := strings.TrimPrefix(path, "$")
+
+	var tokens []token
+	for len(s) > 0 {
+		switch {
+		case s[0] == '.':
+			s = s[1:]
+			if s == "" || s[0] == '.' || s[0] == '[' {
+				return nil, errors.Wrapf(ErrInvalidPath, "%s: missing key after '.'", path)
+			}
+		case s[0] == '[':
+			end := closingBracket(s)
+			if end == -1 {
+				return nil, errors.Wrapf(ErrInvalidPath, "%s: missing ']'", path)
+			}
+
+			t, err := parseBracket(s[1:end])
+			if err != nil {
+				return nil, errors.Wrapf(ErrInvalidPath, "%s: %s", path, err)
+			}
+
+			tokens = append(tokens, t)
+			s = s[end+1:]
+			continue
+		case len(tokens) > 0:
+			return nil, errors.Wrapf(ErrInvalidPath, "%s: expected '.' or '['", path)
+		}
+
+		end := strings.IndexAny(s, ".[")
+		if end == -1 {
+			end = len(s)
+		}
+
+		tokens = append(tokens, token{kind: tokenKey, key: s[:end]})
+		s = s[end:]
+	}
+
+	return tokens, nil
+}
+
+// closingBracket returns the index of the bracket that closes the bracket at the beginning of s.
+// Brackets between quotes are ignored.
+func closingBracket(s string) int {
+	var quote byte
+	for i := 1; i < len(s); i++ {
+		switch c := s[i]; {
+		case quote != 0:
+			if c == quote {
+				quote = 0
+			}
+		case c == '\'' || c == '"':
+			quote = c
+		case c == ']':
+			return i
+		}
+	}
+
+	return -1
+}
+
+func parseBracket(s string) (token, error) {
+	s = strings.TrimSpace(s)
+
+	if key, ok := unquote(s); ok {
+		return token{kind: tokenKey, key: key}, nil
+	}
+
+	if strings.HasPrefix(s, "?(") && strings.HasSuffix(s, ")") {
+		expr := strings.TrimSpace(s[2 : len(s)-1])
+		field, value, ok := strings.Cut(expr, "==")
+		if !ok {
+			return token{}, errors.Errorf("filter %q must compare a field using '=='", s)
+		}
+
+		field = strings.TrimSpace(field)
+		if !strings.HasPrefix(field, "@.") || len(field) == 2 {
+			return token{}, errors.Errorf("filter %q must compare a field of the element using '@.<field>'", s)
+		}
+
+		value = strings.TrimSpace(value)
+		if v, ok := unquote(value); ok {
+			value = v
+		}
+
+		return token{kind: tokenFilter, field: field[2:], value: value}, nil
+	}
+
+	index, err := strconv.Atoi(s)
+	if err != nil {
+		return token{}, errors.Errorf("invalid array index %q", s)
+	}
+
+	return token{kind: tokenIndex, index: index}, nil
+}
+
+func unquote(s string) (string, bool) {
+	if len(s) >= 2 && (s[0] == '\'' || s[0] == '"') && s[len(s)-1] == s[0] {
+		return s[1 : len(s)-1], true
+	}
+
+	return "", false
+}
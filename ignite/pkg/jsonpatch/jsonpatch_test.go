@@ -0,0 +1,189 @@
+package jsonpatch_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/cli/v29/ignite/pkg/jsonpatch"
+)
+
+const doc = `{
+  "chain_id": "mars",
+  "app_state": {
+    "bank": {
+      "denom_metadata": [
+        {"base": "stake", "display": "stake"},
+        {"base": "utoken", "display": "utoken"}
+      ]
+    },
+    "gov": {
+      "params": {"quorum": "0.334", "voting_period": "172800s"}
+    },
+    "mint": {
+      "minter": {"inflation": "0.13"}
+    }
+  }
+}`
+
+func TestApply(t *testing.T) {
+	tests := []struct {
+		name    string
+		patches []jsonpatch.Patch
+		want    string
+		err     error
+	}{
+		{
+			name: "add object key",
+			patches: []jsonpatch.Patch{
+				{Op: jsonpatch.OpAdd, Path: "$.app_state.gov.params.expedited_voting_period", Value: "60s"},
+			},
+			want: `{"chain_id":"mars","app_state":{"bank":{"denom_metadata":[{"base":"stake","display":"stake"},{"base":"utoken","display":"utoken"}]},"gov":{"params":{"expedited_voting_period":"60s","quorum":"0.334","voting_period":"172800s"}},"mint":{"minter":{"inflation":"0.13"}}}}`,
+		},
+		{
+			name: "add array element",
+			patches: []jsonpatch.Patch{
+				{Op: jsonpatch.OpAdd, Path: "app_state.bank.denom_metadata[0]", Value: map[string]interface{}{"base": "uatom"}},
+			},
+			want: `{"chain_id":"mars","app_state":{"bank":{"denom_metadata":[{"base":"uatom"},{"base":"stake","display":"stake"},{"base":"utoken","display":"utoken"}]},"gov":{"params":{"quorum":"0.334","voting_period":"172800s"}},"mint":{"minter":{"inflation":"0.13"}}}}`,
+		},
+		{
+			name: "replace filtered element field",
+			patches: []jsonpatch.Patch{
+				{Op: jsonpatch.OpReplace, Path: "app_state.bank.denom_metadata[?(@.base=='utoken')].display", Value: "token"},
+			},
+			want: `{"chain_id":"mars","app_state":{"bank":{"denom_metadata":[{"base":"stake","display":"stake"},{"base":"utoken","display":"token"}]},"gov":{"params":{"quorum":"0.334","voting_period":"172800s"}},"mint":{"minter":{"inflation":"0.13"}}}}`,
+		},
+		{
+			name: "remove filtered element",
+			patches: []jsonpatch.Patch{
+				{Op: jsonpatch.OpRemove, Path: `app_state.bank.denom_metadata[?(@.base=="stake")]`},
+			},
+			want: `{"chain_id":"mars","app_state":{"bank":{"denom_metadata":[{"base":"utoken","display":"utoken"}]},"gov":{"params":{"quorum":"0.334","voting_period":"172800s"}},"mint":{"minter":{"inflation":"0.13"}}}}`,
+		},
+		{
+			name: "remove key and last element",
+			patches: []jsonpatch.Patch{
+				{Op: jsonpatch.OpRemove, Path: "app_state['mint']"},
+				{Op: jsonpatch.OpRemove, Path: "app_state.bank.denom_metadata[-1]"},
+			},
+			want: `{"chain_id":"mars","app_state":{"bank":{"denom_metadata":[{"base":"stake","display":"stake"}]},"gov":{"params":{"quorum":"0.334","voting_period":"172800s"}}}}`,
+		},
+		{
+			name: "append and merge",
+			patches: []jsonpatch.Patch{
+				{Op: jsonpatch.OpAppend, Path: "app_state.bank.denom_metadata", Value: map[string]interface{}{"base": "uatom"}},
+				{Op: jsonpatch.OpMerge, Path: "app_state.gov", Value: map[string]interface{}{
+					"params": map[string]interface{}{"quorum": "0.5", "min_deposit": []interface{}{}},
+				}},
+			},
+			want: `{"chain_id":"mars","app_state":{"bank":{"denom_metadata":[{"base":"stake","display":"stake"},{"base":"utoken","display":"utoken"},{"base":"uatom"}]},"gov":{"params":{"min_deposit":[],"quorum":"0.5","voting_period":"172800s"}},"mint":{"minter":{"inflation":"0.13"}}}}`,
+		},
+		{
+			name: "keep number precision",
+			patches: []jsonpatch.Patch{
+				{Op: jsonpatch.OpReplace, Path: "chain_id", Value: uint64(12345678901234567890)},
+			},
+			want: `{"chain_id":12345678901234567890,"app_state":{"bank":{"denom_metadata":[{"base":"stake","display":"stake"},{"base":"utoken","display":"utoken"}]},"gov":{"params":{"quorum":"0.334","voting_period":"172800s"}},"mint":{"minter":{"inflation":"0.13"}}}}`,
+		},
+		{
+			name: "missing key",
+			patches: []jsonpatch.Patch{
+				{Op: jsonpatch.OpReplace, Path: "app_state.staking.params", Value: "x"},
+			},
+			err: jsonpatch.ErrPathNotFound,
+		},
+		{
+			name: "filter without matches",
+			patches: []jsonpatch.Patch{
+				{Op: jsonpatch.OpRemove, Path: "app_state.bank.denom_metadata[?(@.base=='uatom')]"},
+			},
+			err: jsonpatch.ErrPathNotFound,
+		},
+		{
+			name: "invalid path",
+			patches: []jsonpatch.Patch{
+				{Op: jsonpatch.OpRemove, Path: "app_state..bank"},
+			},
+			err: jsonpatch.ErrInvalidPath,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := jsonpatch.Apply([]byte(doc), tt.patches...)
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.JSONEq(t, tt.want, string(got))
+		})
+	}
+}
+
+func TestApplyErrorNamesPatch(t *testing.T) {
+	_, err := jsonpatch.Apply(
+		[]byte(doc),
+		jsonpatch.Patch{Op: jsonpatch.OpReplace, Path: "chain_id", Value: "venus"},
+		jsonpatch.Patch{Op: jsonpatch.OpAppend, Path: "app_state.gov", Value: "x"},
+	)
+
+	require.EqualError(t, err, "patch #2 (append app_state.gov): can't append to a value that is not an array")
+}
+
+func TestPatchValidate(t *testing.T) {
+	tests := []struct {
+		name  string
+		patch jsonpatch.Patch
+		err   string
+	}{
+		{
+			name:  "valid",
+			patch: jsonpatch.Patch{Op: jsonpatch.OpRemove, Path: "app_state.bank.denom_metadata[?(@.base=='stake')]"},
+		},
+		{
+			name:  "invalid operation",
+			patch: jsonpatch.Patch{Op: "move", Path: "chain_id"},
+			err:   `invalid operation "move", use add, replace, remove, append or merge`,
+		},
+		{
+			name:  "missing value",
+			patch: jsonpatch.Patch{Op: jsonpatch.OpReplace, Path: "chain_id"},
+			err:   "replace operation requires a value",
+		},
+		{
+			name:  "merge value is not an object",
+			patch: jsonpatch.Patch{Op: jsonpatch.OpMerge, Path: "app_state", Value: "x"},
+			err:   "merge operation requires an object value",
+		},
+		{
+			name:  "add to a filter",
+			patch: jsonpatch.Patch{Op: jsonpatch.OpAdd, Path: "app_state.bank.denom_metadata[?(@.base=='stake')]", Value: "x"},
+			err:   "add operation can't target a filter, use append instead",
+		},
+		{
+			name:  "remove root",
+			patch: jsonpatch.Patch{Op: jsonpatch.OpRemove, Path: "$"},
+			err:   "remove operation can't target the document root",
+		},
+		{
+			name:  "invalid index",
+			patch: jsonpatch.Patch{Op: jsonpatch.OpRemove, Path: "app_state.bank.denom_metadata[first]"},
+			err:   `app_state.bank.denom_metadata[first]: invalid array index "first": invalid path`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.patch.Validate()
+			if tt.err == "" {
+				require.NoError(t, err)
+				return
+			}
+
+			require.EqualError(t, err, tt.err)
+		})
+	}
+}
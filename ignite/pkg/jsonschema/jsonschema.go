@@ -0,0 +1,138 @@
+// Package jsonschema generates JSON Schema documents from the YAML representation of Go types.
+package jsonschema
+
+import (
+	"reflect"
+
+	"github.com/ignite/cli/v29/ignite/pkg/xyaml"
+)
+
+// Draft is the JSON Schema specification used by the generated schemas.
+const Draft = "https://json-schema.org/draft/2020-12/schema"
+
+// JSON value types.
+const (
+	TypeObject  = "object"
+	TypeArray   = "array"
+	TypeString  = "string"
+	TypeInteger = "integer"
+	TypeNumber  = "number"
+	TypeBoolean = "boolean"
+)
+
+// Schema defines a JSON Schema.
+type Schema struct {
+	Schema      string `json:"$schema,omitempty"`
+	ID          string `json:"$id,omitempty"`
+	Title       string `json:"title,omitempty"`
+	Description string `json:"description,omitempty"`
+	Type        string `json:"type,omitempty"`
+	Minimum     *int   `json:"minimum,omitempty"`
+
+	// Properties contains the schemas of the object fields.
+	Properties map[string]*Schema `json:"properties,omitempty"`
+
+	// AdditionalProperties is either a boolean or the schema of the values of an object.
+	AdditionalProperties interface{} `json:"additionalProperties,omitempty"`
+
+	// Items is the schema of the array items.
+	Items *Schema `json:"items,omitempty"`
+}
+
+// Option configures schema generation.
+type Option func(*Schema)
+
+// WithID sets the schema ID.
+func WithID(id string) Option {
+	return func(s *Schema) {
+		s.ID = id
+	}
+}
+
+// WithTitle sets the schema title.
+func WithTitle(title string) Option {
+	return func(s *Schema) {
+		s.Title = title
+	}
+}
+
+// WithDescription sets the schema description.
+func WithDescription(description string) Option {
+	return func(s *Schema) {
+		s.Description = description
+	}
+}
+
+// Reflect generates a JSON Schema for the YAML representation of the type of v.
+// Struct fields are named using their YAML tags and objects created from structs
+// don't allow additional properties, so unknown keys are reported by validators.
+// Types that decode YAML using custom logic allow any value of their kind.
+func Reflect(v interface{}, options ...Option) *Schema {
+	s := reflectType(reflect.TypeOf(v), make(map[reflect.Type]bool))
+	s.Schema = Draft
+
+	for _, apply := range options {
+		apply(s)
+	}
+
+	return s
+}
+
+func reflectType(t reflect.Type, parents map[reflect.Type]bool) *Schema {
+	if t == nil {
+		return &Schema{}
+	}
+
+	t = xyaml.Indirect(t)
+	if xyaml.IsCustomUnmarshaler(t) {
+		if t.Kind() == reflect.Map {
+			return &Schema{Type: TypeObject}
+		}
+		return &Schema{}
+	}
+
+	switch t.Kind() {
+	case reflect.Struct:
+		// Recursive types allow any value instead of being expanded forever
+		if parents[t] {
+			return &Schema{Type: TypeObject}
+		}
+
+		parents[t] = true
+		defer delete(parents, t)
+
+		s := &Schema{
+			Type:                 TypeObject,
+			Properties:           make(map[string]*Schema),
+			AdditionalProperties: false,
+		}
+		for _, f := range xyaml.Fields(t) {
+			s.Properties[f.Name] = reflectType(f.Type, parents)
+		}
+		return s
+	case reflect.Map:
+		return &Schema{
+			Type:                 TypeObject,
+			AdditionalProperties: reflectType(t.Elem(), parents),
+		}
+	case reflect.Slice, reflect.Array:
+		return &Schema{
+			Type:  TypeArray,
+			Items: reflectType(t.Elem(), parents),
+		}
+	case reflect.String:
+		return &Schema{Type: TypeString}
+	case reflect.Bool:
+		return &Schema{Type: TypeBoolean}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return &Schema{Type: TypeInteger}
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		minimum := 0
+		return &Schema{Type: TypeInteger, Minimum: &minimum}
+	case reflect.Float32, reflect.Float64:
+		return &Schema{Type: TypeNumber}
+	}
+
+	// Interfaces and other kinds allow any value
+	return &Schema{}
+}
@@ -0,0 +1,74 @@
+package jsonschema_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/cli/v29/ignite/pkg/jsonschema"
+	"github.com/ignite/cli/v29/ignite/pkg/xyaml"
+)
+
+type (
+	base struct {
+		Version uint `yaml:"version"`
+	}
+
+	account struct {
+		Name    string   `yaml:"name"`
+		Coins   []string `yaml:"coins,omitempty"`
+		Ignored string   `yaml:"-"`
+	}
+
+	config struct {
+		base     `yaml:",inline"`
+		Accounts []account            `yaml:"accounts"`
+		Faucet   *struct{ Port int }  `yaml:"faucet"`
+		Genesis  xyaml.Map            `yaml:"genesis"`
+		Gas      map[string]float64   `yaml:"gas"`
+		Value    interface{}          `yaml:"value"`
+		Build    struct{ Debug bool } `yaml:"build"`
+		hidden   string               //nolint:unused
+	}
+)
+
+func TestReflect(t *testing.T) {
+	s := jsonschema.Reflect(config{}, jsonschema.WithTitle("Config"))
+
+	got, err := json.Marshal(s)
+	require.NoError(t, err)
+	require.JSONEq(t, `{
+		"$schema": "https://json-schema.org/draft/2020-12/schema",
+		"title": "Config",
+		"type": "object",
+		"additionalProperties": false,
+		"properties": {
+			"version": {"type": "integer", "minimum": 0},
+			"accounts": {
+				"type": "array",
+				"items": {
+					"type": "object",
+					"additionalProperties": false,
+					"properties": {
+						"name": {"type": "string"},
+						"coins": {"type": "array", "items": {"type": "string"}}
+					}
+				}
+			},
+			"faucet": {
+				"type": "object",
+				"additionalProperties": false,
+				"properties": {"port": {"type": "integer"}}
+			},
+			"genesis": {"type": "object"},
+			"gas": {"type": "object", "additionalProperties": {"type": "number"}},
+			"value": {},
+			"build": {
+				"type": "object",
+				"additionalProperties": false,
+				"properties": {"debug": {"type": "boolean"}}
+			}
+		}
+	}`, string(got))
+}
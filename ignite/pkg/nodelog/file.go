@@ -0,0 +1,172 @@
+// Package nodelog captures and reads the output of blockchain nodes.
+// Logs are written to size rotated files which are read to tail, filter
+// and extract the panic traces of crashed nodes.
+package nodelog
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+)
+
+const (
+	// DefaultMaxSize is the default size in bytes of a log file before it's rotated.
+	DefaultMaxSize = 10 << 20
+
+	// DefaultMaxBackups is the default number of rotated log files to keep.
+	DefaultMaxBackups = 3
+)
+
+// File is a log file that is rotated when its size reaches a maximum.
+// Rotated files are renamed using an increasing numeric suffix, where
+// the file with the ".1" suffix contains the most recent rotated logs.
+// File is safe for concurrent use.
+type File struct {
+	path       string
+	maxSize    int64
+	maxBackups int
+
+	mu   sync.Mutex
+	file *os.File
+	size int64
+}
+
+// Option configures a log file.
+type Option func(*File)
+
+// MaxSize sets the size in bytes of the log file before it's rotated.
+func MaxSize(size int64) Option {
+	return func(f *File) {
+		f.maxSize = size
+	}
+}
+
+// MaxBackups sets the number of rotated log files to keep.
+// Old logs are discarded on rotation when it's zero.
+func MaxBackups(n int) Option {
+	return func(f *File) {
+		f.maxBackups = n
+	}
+}
+
+// Open opens a log file to append logs to it.
+// The file and its parent directories are created when they don't exist.
+func Open(path string, options ...Option) (*File, error) {
+	f := &File{
+		path:       path,
+		maxSize:    DefaultMaxSize,
+		maxBackups: DefaultMaxBackups,
+	}
+
+	for _, apply := range options {
+		apply(f)
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return nil, err
+	}
+
+	if err := f.open(); err != nil {
+		return nil, err
+	}
+
+	return f, nil
+}
+
+// Path returns the path of the log file.
+func (f *File) Path() string {
+	return f.path
+}
+
+// Write implements io.Writer.
+func (f *File) Write(p []byte) (int, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if f.file == nil {
+		return 0, os.ErrClosed
+	}
+
+	if f.size > 0 && f.size+int64(len(p)) > f.maxSize {
+		if err := f.rotate(); err != nil {
+			return 0, err
+		}
+	}
+
+	n, err := f.file.Write(p)
+	f.size += int64(n)
+
+	return n, err
+}
+
+// Close closes the log file.
+func (f *File) Close() error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if f.file == nil {
+		return nil
+	}
+
+	err := f.file.Close()
+	f.file = nil
+
+	return err
+}
+
+func (f *File) open() error {
+	file, err := os.OpenFile(f.path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o644)
+	if err != nil {
+		return err
+	}
+
+	info, err := file.Stat()
+	if err != nil {
+		file.Close()
+		return err
+	}
+
+	f.file = file
+	f.size = info.Size()
+
+	return nil
+}
+
+// rotate renames the current log file to keep it as the most recent backup
+// and opens a new empty log file.
+func (f *File) rotate() error {
+	if err := f.file.Close(); err != nil {
+		return err
+	}
+
+	f.file = nil
+
+	if f.maxBackups == 0 {
+		if err := os.Remove(f.path); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+	} else {
+		// Discard the oldest backup and shift the others
+		if err := os.Remove(backupPath(f.path, f.maxBackups)); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+
+		for i := f.maxBackups - 1; i > 0; i-- {
+			err := os.Rename(backupPath(f.path, i), backupPath(f.path, i+1))
+			if err != nil && !os.IsNotExist(err) {
+				return err
+			}
+		}
+
+		if err := os.Rename(f.path, backupPath(f.path, 1)); err != nil {
+			return err
+		}
+	}
+
+	return f.open()
+}
+
+func backupPath(path string, n int) string {
+	return fmt.Sprintf("%s.%d", path, n)
+}
@@ -0,0 +1,59 @@
+package nodelog_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/cli/v29/ignite/pkg/nodelog"
+)
+
+func TestFile(t *testing.T) {
+	// Arrange
+	path := filepath.Join(t.TempDir(), "logs", "alice.log")
+
+	f, err := nodelog.Open(path, nodelog.MaxSize(10), nodelog.MaxBackups(2))
+	require.NoError(t, err)
+
+	// Act
+	for _, s := range []string{"line 1\n", "line 2\n", "line 3\n", "line 4\n"} {
+		_, err := f.Write([]byte(s))
+		require.NoError(t, err)
+	}
+
+	require.NoError(t, f.Close())
+
+	// Assert
+	read := func(name string) string {
+		bz, err := os.ReadFile(filepath.Join(filepath.Dir(path), name))
+		require.NoError(t, err)
+		return string(bz)
+	}
+
+	require.Equal(t, path, f.Path())
+	require.Equal(t, "line 4\n", read("alice.log"))
+	require.Equal(t, "line 3\n", read("alice.log.1"))
+	require.Equal(t, "line 2\n", read("alice.log.2"))
+	require.NoFileExists(t, path+".3")
+}
+
+func TestFileAppend(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "alice.log")
+	require.NoError(t, os.WriteFile(path, []byte("line 1\n"), 0o644))
+
+	f, err := nodelog.Open(path)
+	require.NoError(t, err)
+
+	_, err = f.Write([]byte("line 2\n"))
+	require.NoError(t, err)
+	require.NoError(t, f.Close())
+
+	_, err = f.Write([]byte("line 3\n"))
+	require.ErrorIs(t, err, os.ErrClosed)
+
+	bz, err := os.ReadFile(path)
+	require.NoError(t, err)
+	require.Equal(t, "line 1\nline 2\n", string(bz))
+}
@@ -0,0 +1,278 @@
+package nodelog
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"io"
+	"os"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/ignite/cli/v29/ignite/pkg/errors"
+)
+
+const (
+	// tailChunkSize is the number of bytes read at once from the end of a log file.
+	tailChunkSize = 64 << 10
+
+	// maxPanicLines is the maximum number of lines of a panic trace.
+	maxPanicLines = 100
+)
+
+// FollowInterval is the time to wait before checking for new logs while following a log file.
+var FollowInterval = 250 * time.Millisecond
+
+// Level defines the severity of a log line.
+type Level int
+
+// Log levels sorted by severity.
+const (
+	LevelTrace Level = iota
+	LevelDebug
+	LevelInfo
+	LevelWarn
+	LevelError
+	LevelFatal
+)
+
+var (
+	// levelNames contains the names of the levels that can be used as filter.
+	levelNames = map[string]Level{
+		"trace": LevelTrace,
+		"debug": LevelDebug,
+		"info":  LevelInfo,
+		"warn":  LevelWarn,
+		"error": LevelError,
+		"fatal": LevelFatal,
+	}
+
+	// levelTags contains the level names written by the nodes, both using the
+	// plain text format (e.g. "3:04PM INF message") and the JSON format.
+	levelTags = map[string]Level{
+		"TRC":   LevelTrace,
+		"DBG":   LevelDebug,
+		"INF":   LevelInfo,
+		"WRN":   LevelWarn,
+		"ERR":   LevelError,
+		"FTL":   LevelFatal,
+		"PNC":   LevelFatal,
+		"trace": LevelTrace,
+		"debug": LevelDebug,
+		"info":  LevelInfo,
+		"warn":  LevelWarn,
+		"error": LevelError,
+		"fatal": LevelFatal,
+		"panic": LevelFatal,
+	}
+
+	reANSI      = regexp.MustCompile(`\x1b\[[0-9;]*m`)
+	reJSONLevel = regexp.MustCompile(`"level"\s*:\s*"(\w+)"`)
+)
+
+// ParseLevel returns the log level for a level name.
+func ParseLevel(name string) (Level, error) {
+	level, ok := levelNames[strings.ToLower(name)]
+	if !ok {
+		return 0, errors.Errorf("invalid log level %q, expected one of: trace, debug, info, warn, error, fatal", name)
+	}
+
+	return level, nil
+}
+
+// Filter selects log lines.
+type Filter struct {
+	// MinLevel is the minimum level of the selected lines.
+	// Lines without a level, like the lines of a panic trace, are always selected.
+	MinLevel Level
+
+	// Pattern, when not nil, selects only the lines that match it.
+	Pattern *regexp.Regexp
+}
+
+// Match checks if a log line is selected by the filter.
+func (f Filter) Match(line string) bool {
+	line = reANSI.ReplaceAllString(line, "")
+
+	if level, ok := lineLevel(line); ok && level < f.MinLevel {
+		return false
+	}
+
+	return f.Pattern == nil || f.Pattern.MatchString(line)
+}
+
+func lineLevel(line string) (Level, bool) {
+	if m := reJSONLevel.FindStringSubmatch(line); m != nil {
+		level, ok := levelTags[m[1]]
+		return level, ok
+	}
+
+	// The level is written after the time in the plain text format
+	fields := strings.Fields(line)
+	for i := 0; i < len(fields) && i < 2; i++ {
+		if level, ok := levelTags[fields[i]]; ok && strings.ToUpper(fields[i]) == fields[i] {
+			return level, true
+		}
+	}
+
+	return 0, false
+}
+
+// Tail returns the last n lines of a log file.
+// All the lines are returned when n is not greater than zero.
+func Tail(path string, n int) ([]string, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	info, err := file.Stat()
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		offset = info.Size()
+		buf    []byte
+	)
+
+	// Read the file backwards until it contains enough complete lines.
+	// A line is complete when a previous line break is found.
+	for offset > 0 && (n <= 0 || bytes.Count(buf, []byte{'\n'}) <= n) {
+		size := min(tailChunkSize, offset)
+		offset -= size
+
+		chunk := make([]byte, size)
+		if _, err := file.ReadAt(chunk, offset); err != nil {
+			return nil, err
+		}
+
+		buf = append(chunk, buf...)
+	}
+
+	s := strings.TrimRight(string(buf), "\n")
+	if s == "" {
+		return nil, nil
+	}
+
+	lines := strings.Split(s, "\n")
+	if n > 0 && len(lines) > n {
+		lines = lines[len(lines)-n:]
+	}
+
+	return lines, nil
+}
+
+// Follow calls fn for every line appended to a log file until the context is canceled.
+// When the log file is rotated the new file is followed from its beginning.
+func Follow(ctx context.Context, path string, fn func(line string)) error {
+	file, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer func() { file.Close() }()
+
+	if _, err := file.Seek(0, io.SeekEnd); err != nil {
+		return err
+	}
+
+	var (
+		r       = bufio.NewReader(file)
+		partial strings.Builder
+		ticker  = time.NewTicker(FollowInterval)
+	)
+
+	defer ticker.Stop()
+
+	// readLines reads the complete lines that are available and keeps
+	// the incomplete last line until the rest of it is written.
+	readLines := func() error {
+		for {
+			s, err := r.ReadString('\n')
+			partial.WriteString(s)
+
+			if errors.Is(err, io.EOF) {
+				return nil
+			}
+			if err != nil {
+				return err
+			}
+
+			fn(strings.TrimRight(partial.String(), "\r\n"))
+			partial.Reset()
+		}
+	}
+
+	for {
+		if err := readLines(); err != nil {
+			return err
+		}
+
+		if rotated, err := isRotated(file, path); err != nil {
+			return err
+		} else if rotated {
+			// Read the logs written before the rotation
+			if err := readLines(); err != nil {
+				return err
+			}
+
+			next, err := os.Open(path)
+			if err != nil {
+				return err
+			}
+
+			file.Close()
+			file = next
+			r.Reset(file)
+
+			continue
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// isRotated checks if the file opened for a log path was rotated.
+// The file is not considered rotated until the new log file is created.
+func isRotated(file *os.File, path string) (bool, error) {
+	current, err := os.Stat(path)
+	if os.IsNotExist(err) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+
+	opened, err := file.Stat()
+	if err != nil {
+		return false, err
+	}
+
+	return !os.SameFile(opened, current), nil
+}
+
+// LastPanic returns the trace of the last panic found in the log lines.
+// An empty string is returned when the lines don't contain a panic.
+func LastPanic(lines []string) string {
+	for i := len(lines) - 1; i >= 0; i-- {
+		line := reANSI.ReplaceAllString(lines[i], "")
+		if !strings.HasPrefix(line, "panic: ") && !strings.HasPrefix(line, "fatal error: ") {
+			continue
+		}
+
+		trace := lines[i:]
+		if len(trace) > maxPanicLines {
+			trace = trace[:maxPanicLines]
+		}
+
+		return strings.TrimSpace(reANSI.ReplaceAllString(strings.Join(trace, "\n"), ""))
+	}
+
+	return ""
+}
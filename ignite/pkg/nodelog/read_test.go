@@ -0,0 +1,179 @@
+package nodelog_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/cli/v29/ignite/pkg/nodelog"
+)
+
+func TestTail(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "alice.log")
+
+	var logs strings.Builder
+	for i := 1; i <= 20000; i++ {
+		fmt.Fprintf(&logs, "line %d\n", i)
+	}
+
+	require.NoError(t, os.WriteFile(path, []byte(logs.String()), 0o644))
+
+	lines, err := nodelog.Tail(path, 3)
+	require.NoError(t, err)
+	require.Equal(t, []string{"line 19998", "line 19999", "line 20000"}, lines)
+
+	lines, err = nodelog.Tail(path, 0)
+	require.NoError(t, err)
+	require.Len(t, lines, 20000)
+	require.Equal(t, "line 1", lines[0])
+
+	require.NoError(t, os.WriteFile(path, nil, 0o644))
+
+	lines, err = nodelog.Tail(path, 3)
+	require.NoError(t, err)
+	require.Empty(t, lines)
+}
+
+func TestFollow(t *testing.T) {
+	// Arrange
+	nodelog.FollowInterval = time.Millisecond
+	path := filepath.Join(t.TempDir(), "alice.log")
+
+	f, err := nodelog.Open(path, nodelog.MaxSize(16))
+	require.NoError(t, err)
+	defer f.Close()
+
+	_, err = f.Write([]byte("before follow\n"))
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	lines := make(chan string)
+	errc := make(chan error)
+
+	go func() {
+		errc <- nodelog.Follow(ctx, path, func(line string) { lines <- line })
+	}()
+
+	// Wait for the file to be opened before writing new lines
+	time.Sleep(50 * time.Millisecond)
+
+	// Act & Assert: the log file is rotated before the first and third lines
+	writes := []struct {
+		data string
+		want string
+	}{
+		{data: "line 1\n", want: "line 1"},
+		{data: "line 2\n", want: "line 2"},
+		{data: "line "},
+		{data: "3\n", want: "line 3"},
+	}
+
+	for _, w := range writes {
+		_, err = f.Write([]byte(w.data))
+		require.NoError(t, err)
+
+		if w.want != "" {
+			require.Equal(t, w.want, <-lines)
+		}
+	}
+
+	cancel()
+	require.NoError(t, <-errc)
+}
+
+func TestFilter(t *testing.T) {
+	cases := []struct {
+		name   string
+		filter nodelog.Filter
+		line   string
+		want   bool
+	}{
+		{
+			name: "no filter",
+			line: "3:04PM DBG message",
+			want: true,
+		},
+		{
+			name:   "plain text level",
+			filter: nodelog.Filter{MinLevel: nodelog.LevelInfo},
+			line:   "3:04PM INF committed state module=state",
+			want:   true,
+		},
+		{
+			name:   "plain text level below minimum",
+			filter: nodelog.Filter{MinLevel: nodelog.LevelInfo},
+			line:   "3:04PM DBG received proposal module=consensus",
+			want:   false,
+		},
+		{
+			name:   "colored plain text level",
+			filter: nodelog.Filter{MinLevel: nodelog.LevelError},
+			line:   "\x1b[90m3:04PM\x1b[0m \x1b[32mINF\x1b[0m committed state",
+			want:   false,
+		},
+		{
+			name:   "json level",
+			filter: nodelog.Filter{MinLevel: nodelog.LevelWarn},
+			line:   `{"level":"error","module":"p2p","message":"dial failed"}`,
+			want:   true,
+		},
+		{
+			name:   "line without level",
+			filter: nodelog.Filter{MinLevel: nodelog.LevelError},
+			line:   "panic: runtime error: index out of range",
+			want:   true,
+		},
+		{
+			name:   "pattern",
+			filter: nodelog.Filter{Pattern: regexp.MustCompile(`module=(state|p2p)`)},
+			line:   "3:04PM INF committed state module=state",
+			want:   true,
+		},
+		{
+			name:   "pattern mismatch",
+			filter: nodelog.Filter{Pattern: regexp.MustCompile(`module=p2p`)},
+			line:   "3:04PM INF committed state module=state",
+			want:   false,
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.want, tt.filter.Match(tt.line))
+		})
+	}
+}
+
+func TestParseLevel(t *testing.T) {
+	level, err := nodelog.ParseLevel("WARN")
+	require.NoError(t, err)
+	require.Equal(t, nodelog.LevelWarn, level)
+
+	_, err = nodelog.ParseLevel("verbose")
+	require.EqualError(t, err, `invalid log level "verbose", expected one of: trace, debug, info, warn, error, fatal`)
+}
+
+func TestLastPanic(t *testing.T) {
+	lines := []string{
+		"3:04PM INF starting node",
+		"panic: first",
+		"3:05PM INF starting node",
+		"3:05PM INF committed state",
+		"panic: runtime error: invalid memory address or nil pointer dereference",
+		"[signal SIGSEGV: segmentation violation code=0x1 addr=0x0 pc=0x1]",
+		"",
+		"goroutine 1 [running]:",
+		"github.com/mars/x/blog/keeper.Keeper.EndBlock(...)",
+		"\t/mars/x/blog/keeper/abci.go:12 +0x1a",
+	}
+
+	require.Equal(t, strings.Join(lines[4:], "\n"), nodelog.LastPanic(lines))
+	require.Empty(t, nodelog.LastPanic(lines[2:4]))
+}
@@ -551,3 +551,23 @@ func TestGetNextId(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, 6, NextUniqueID(m))
 }
+
+func TestHasEnum(t *testing.T) {
+	f, err := parseStringProto(`syntax = "proto3"
+
+	enum Status {
+		STATUS_UNSPECIFIED = 0;
+		STATUS_ACTIVE = 1;
+	}
+
+	message Hello {
+		enum Nested {
+			NESTED_UNSPECIFIED = 0;
+		}
+	}
+	`)
+	require.NoError(t, err)
+	require.True(t, HasEnum(f, "Status"))
+	require.False(t, HasEnum(f, "Nested"))
+	require.False(t, HasEnum(f, "DoesNotExist"))
+}
@@ -277,3 +277,43 @@ func HasImport(f *proto.Proto, path string) bool {
 	_, err := GetImportByPath(f, path)
 	return err == nil
 }
+
+// GetEnumByName returns the top-level enum with the given name or nil if not found.
+// Only traverses in proto.Proto since nested enums are not considered:
+//
+//	f, _ := ParseProtoPath("foo.proto")
+//	e := GetEnumByName(f, "Status")
+//	e.Name // "Status"
+func GetEnumByName(f *proto.Proto, name string) (node *proto.Enum, err error) {
+	found := false
+	node, err = nil, nil
+	Apply(f,
+		func(c *Cursor) bool {
+			if e, ok := c.Node().(*proto.Enum); ok {
+				if e.Name == name {
+					found = true
+					node = e
+				}
+				return false
+			}
+			// keep looking while we're in a proto.Proto.
+			_, ok := c.Node().(*proto.Proto)
+			return ok
+		},
+		// return immediately iff found.
+		func(*Cursor) bool { return !found })
+	if found {
+		return
+	}
+	return nil, errors.Errorf("enum %s not found", name)
+}
+
+// HasEnum returns true if the given top-level enum is found in the given file.
+//
+//	f, _ := ParseProtoPath("foo.proto")
+//	// true if 'foo.proto' contains enum Status { ... }
+//	r := HasEnum(f, "Status")
+func HasEnum(f *proto.Proto, name string) bool {
+	_, err := GetEnumByName(f, name)
+	return err == nil
+}
@@ -0,0 +1,105 @@
+// Package provenance creates in-toto statements with SLSA build provenance,
+// which describe how a set of artifacts were built.
+package provenance
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"os"
+	"path/filepath"
+)
+
+const (
+	// StatementType is the type of in-toto statements.
+	StatementType = "https://in-toto.io/Statement/v1"
+
+	// PredicateType is the type of SLSA build provenance predicates.
+	PredicateType = "https://slsa.dev/provenance/v1"
+
+	// DigestSHA256 is the name of SHA256 digests.
+	DigestSHA256 = "sha256"
+
+	// DigestGitCommit is the name of Git commit digests.
+	DigestGitCommit = "gitCommit"
+)
+
+type (
+	// Statement is an in-toto statement about a set of artifacts.
+	Statement struct {
+		Type          string    `json:"_type"`
+		Subject       []Subject `json:"subject"`
+		PredicateType string    `json:"predicateType"`
+		Predicate     Predicate `json:"predicate"`
+	}
+
+	// Subject is an artifact described by a statement.
+	Subject struct {
+		Name   string            `json:"name"`
+		Digest map[string]string `json:"digest"`
+	}
+
+	// Predicate is a SLSA build provenance.
+	Predicate struct {
+		BuildDefinition BuildDefinition `json:"buildDefinition"`
+		RunDetails      RunDetails      `json:"runDetails"`
+	}
+
+	// BuildDefinition describes the inputs of a build.
+	BuildDefinition struct {
+		BuildType            string                 `json:"buildType"`
+		ExternalParameters   map[string]interface{} `json:"externalParameters"`
+		InternalParameters   map[string]interface{} `json:"internalParameters,omitempty"`
+		ResolvedDependencies []ResourceDescriptor   `json:"resolvedDependencies,omitempty"`
+	}
+
+	// ResourceDescriptor describes a resource used by a build, like its source code.
+	ResourceDescriptor struct {
+		Name   string            `json:"name,omitempty"`
+		URI    string            `json:"uri,omitempty"`
+		Digest map[string]string `json:"digest,omitempty"`
+	}
+
+	// RunDetails describes the build execution.
+	RunDetails struct {
+		Builder Builder `json:"builder"`
+	}
+
+	// Builder identifies the tool that executed the build.
+	Builder struct {
+		ID      string            `json:"id"`
+		Version map[string]string `json:"version,omitempty"`
+	}
+)
+
+// NewStatement creates a new statement with the build provenance of a set of artifacts.
+func NewStatement(subjects []Subject, definition BuildDefinition, builder Builder) Statement {
+	return Statement{
+		Type:          StatementType,
+		Subject:       subjects,
+		PredicateType: PredicateType,
+		Predicate: Predicate{
+			BuildDefinition: definition,
+			RunDetails:      RunDetails{Builder: builder},
+		},
+	}
+}
+
+// FileSubject returns a subject for a file, which is named using the name of the file.
+func FileSubject(path string) (Subject, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return Subject{}, err
+	}
+	defer f.Close()
+
+	h := sha256.New()
+	if _, err := io.Copy(h, f); err != nil {
+		return Subject{}, err
+	}
+
+	return Subject{
+		Name:   filepath.Base(path),
+		Digest: map[string]string{DigestSHA256: hex.EncodeToString(h.Sum(nil))},
+	}, nil
+}
@@ -0,0 +1,54 @@
+package provenance_test
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/cli/v29/ignite/pkg/provenance"
+)
+
+func TestNewStatement(t *testing.T) {
+	// Arrange
+	path := filepath.Join(t.TempDir(), "mars_linux_amd64.tar.gz")
+	require.NoError(t, os.WriteFile(path, []byte("hello"), 0o644))
+
+	subject, err := provenance.FileSubject(path)
+	require.NoError(t, err)
+
+	// Act
+	s := provenance.NewStatement(
+		[]provenance.Subject{subject},
+		provenance.BuildDefinition{
+			BuildType:          "https://example.com/build/v1",
+			ExternalParameters: map[string]interface{}{"targets": []string{"linux:amd64"}},
+			ResolvedDependencies: []provenance.ResourceDescriptor{
+				{Name: "github.com/mars/mars", Digest: map[string]string{provenance.DigestGitCommit: "aae48b7f"}},
+			},
+		},
+		provenance.Builder{ID: "https://example.com/builder"},
+	)
+
+	// Assert
+	got, err := json.Marshal(s)
+	require.NoError(t, err)
+	require.JSONEq(t, `{
+		"_type": "https://in-toto.io/Statement/v1",
+		"subject": [{
+			"name": "mars_linux_amd64.tar.gz",
+			"digest": {"sha256": "2cf24dba5fb0a30e26e83b2ac5b9e29e1b161e5c1fa7425e73043362938b9824"}
+		}],
+		"predicateType": "https://slsa.dev/provenance/v1",
+		"predicate": {
+			"buildDefinition": {
+				"buildType": "https://example.com/build/v1",
+				"externalParameters": {"targets": ["linux:amd64"]},
+				"resolvedDependencies": [{"name": "github.com/mars/mars", "digest": {"gitCommit": "aae48b7f"}}]
+			},
+			"runDetails": {"builder": {"id": "https://example.com/builder"}}
+		}
+	}`, string(got))
+}
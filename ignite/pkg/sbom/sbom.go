@@ -0,0 +1,163 @@
+// Package sbom creates CycloneDX software bill of materials for Go modules.
+package sbom
+
+import (
+	"sort"
+	"time"
+
+	"golang.org/x/mod/modfile"
+	"golang.org/x/mod/module"
+)
+
+const (
+	// BOMFormat is the format of the generated SBOM documents.
+	BOMFormat = "CycloneDX"
+
+	// SpecVersion is the version of the CycloneDX specification used by the generated SBOM documents.
+	SpecVersion = "1.5"
+)
+
+// Component types.
+const (
+	TypeApplication = "application"
+	TypeLibrary     = "library"
+)
+
+type (
+	// BOM is a CycloneDX SBOM document.
+	BOM struct {
+		BOMFormat    string       `json:"bomFormat"`
+		SpecVersion  string       `json:"specVersion"`
+		Version      int          `json:"version"`
+		Metadata     Metadata     `json:"metadata"`
+		Components   []Component  `json:"components"`
+		Dependencies []Dependency `json:"dependencies"`
+	}
+
+	// Metadata contains the details of the SBOM document and of the described component.
+	Metadata struct {
+		Timestamp string    `json:"timestamp,omitempty"`
+		Tools     *Tools    `json:"tools,omitempty"`
+		Component Component `json:"component"`
+	}
+
+	// Tools contains the tools used to create the SBOM document.
+	Tools struct {
+		Components []Component `json:"components"`
+	}
+
+	// Component is a software component.
+	Component struct {
+		Type    string `json:"type"`
+		BOMRef  string `json:"bom-ref,omitempty"`
+		Name    string `json:"name"`
+		Version string `json:"version,omitempty"`
+		PURL    string `json:"purl,omitempty"`
+	}
+
+	// Dependency defines the components that a component depends on.
+	Dependency struct {
+		Ref       string   `json:"ref"`
+		DependsOn []string `json:"dependsOn"`
+	}
+)
+
+// Option configures SBOM documents.
+type Option func(*BOM)
+
+// WithTimestamp sets the time when the SBOM document was created.
+func WithTimestamp(t time.Time) Option {
+	return func(b *BOM) {
+		b.Metadata.Timestamp = t.UTC().Format(time.RFC3339)
+	}
+}
+
+// WithTool adds a tool used to create the SBOM document.
+func WithTool(name, version string) Option {
+	return func(b *BOM) {
+		if b.Metadata.Tools == nil {
+			b.Metadata.Tools = &Tools{}
+		}
+
+		b.Metadata.Tools.Components = append(b.Metadata.Tools.Components, Component{
+			Type:    TypeApplication,
+			Name:    name,
+			Version: version,
+		})
+	}
+}
+
+// FromModFile creates an SBOM document for the application built from a Go module.
+// The module requirements are the components of the application, and replaced
+// modules are described using their replacement. Components are sorted by path
+// to always create the same document for the same module file.
+func FromModFile(f *modfile.File, version string, options ...Option) BOM {
+	main := newComponent(TypeApplication, module.Version{Path: f.Module.Mod.Path, Version: version})
+	bom := BOM{
+		BOMFormat:   BOMFormat,
+		SpecVersion: SpecVersion,
+		Version:     1,
+		Metadata:    Metadata{Component: main},
+		Components:  []Component{},
+	}
+
+	replacements := make(map[string]module.Version)
+	for _, r := range f.Replace {
+		// Replacements of specific versions are only applied to the required version
+		if r.Old.Version == "" {
+			replacements[r.Old.Path] = r.New
+		} else {
+			replacements[r.Old.String()] = r.New
+		}
+	}
+
+	direct := Dependency{Ref: main.BOMRef, DependsOn: []string{}}
+	for _, req := range f.Require {
+		mod := req.Mod
+		if r, ok := replacements[mod.String()]; ok {
+			mod = r
+		} else if r, ok := replacements[mod.Path]; ok {
+			mod = r
+		}
+
+		c := newComponent(TypeLibrary, mod)
+		bom.Components = append(bom.Components, c)
+
+		if !req.Indirect {
+			direct.DependsOn = append(direct.DependsOn, c.BOMRef)
+		}
+	}
+
+	sort.Slice(bom.Components, func(i, j int) bool {
+		return bom.Components[i].BOMRef < bom.Components[j].BOMRef
+	})
+	sort.Strings(direct.DependsOn)
+
+	bom.Dependencies = []Dependency{direct}
+
+	for _, apply := range options {
+		apply(&bom)
+	}
+
+	return bom
+}
+
+func newComponent(componentType string, mod module.Version) Component {
+	return Component{
+		Type:    componentType,
+		BOMRef:  PURL(mod),
+		Name:    mod.Path,
+		Version: mod.Version,
+		PURL:    PURL(mod),
+	}
+}
+
+// PURL returns the package URL of a Go module.
+func PURL(mod module.Version) string {
+	purl := "pkg:golang/" + mod.Path
+	if mod.Version != "" {
+		purl += "@" + mod.Version
+	}
+
+	return purl
+}
@@ -0,0 +1,91 @@
+package sbom_test
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"golang.org/x/mod/modfile"
+
+	"github.com/ignite/cli/v29/ignite/pkg/sbom"
+)
+
+const goMod = `module github.com/mars/mars
+
+go 1.21
+
+require (
+	github.com/cosmos/cosmos-sdk v0.50.1
+	cosmossdk.io/math v1.2.0
+	github.com/gogo/protobuf v1.3.2 // indirect
+)
+
+replace github.com/gogo/protobuf => github.com/regen-network/protobuf v1.3.3-alpha.regen.1
+`
+
+func TestFromModFile(t *testing.T) {
+	// Arrange
+	f, err := modfile.Parse("go.mod", []byte(goMod), nil)
+	require.NoError(t, err)
+
+	// Act
+	bom := sbom.FromModFile(
+		f,
+		"v1.0.0",
+		sbom.WithTimestamp(time.Unix(1714564800, 0)),
+		sbom.WithTool("ignite", "v29.0.0"),
+	)
+
+	// Assert
+	got, err := json.Marshal(bom)
+	require.NoError(t, err)
+	require.JSONEq(t, `{
+		"bomFormat": "CycloneDX",
+		"specVersion": "1.5",
+		"version": 1,
+		"metadata": {
+			"timestamp": "2024-05-01T12:00:00Z",
+			"tools": {"components": [{"type": "application", "name": "ignite", "version": "v29.0.0"}]},
+			"component": {
+				"type": "application",
+				"bom-ref": "pkg:golang/github.com/mars/mars@v1.0.0",
+				"name": "github.com/mars/mars",
+				"version": "v1.0.0",
+				"purl": "pkg:golang/github.com/mars/mars@v1.0.0"
+			}
+		},
+		"components": [
+			{
+				"type": "library",
+				"bom-ref": "pkg:golang/cosmossdk.io/math@v1.2.0",
+				"name": "cosmossdk.io/math",
+				"version": "v1.2.0",
+				"purl": "pkg:golang/cosmossdk.io/math@v1.2.0"
+			},
+			{
+				"type": "library",
+				"bom-ref": "pkg:golang/github.com/cosmos/cosmos-sdk@v0.50.1",
+				"name": "github.com/cosmos/cosmos-sdk",
+				"version": "v0.50.1",
+				"purl": "pkg:golang/github.com/cosmos/cosmos-sdk@v0.50.1"
+			},
+			{
+				"type": "library",
+				"bom-ref": "pkg:golang/github.com/regen-network/protobuf@v1.3.3-alpha.regen.1",
+				"name": "github.com/regen-network/protobuf",
+				"version": "v1.3.3-alpha.regen.1",
+				"purl": "pkg:golang/github.com/regen-network/protobuf@v1.3.3-alpha.regen.1"
+			}
+		],
+		"dependencies": [
+			{
+				"ref": "pkg:golang/github.com/mars/mars@v1.0.0",
+				"dependsOn": [
+					"pkg:golang/cosmossdk.io/math@v1.2.0",
+					"pkg:golang/github.com/cosmos/cosmos-sdk@v0.50.1"
+				]
+			}
+		]
+	}`, string(got))
+}
@@ -0,0 +1,87 @@
+package simcampaign
+
+import (
+	"embed"
+	htmltemplate "html/template"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+	"text/template"
+)
+
+//go:embed templates/*
+var templates embed.FS
+
+type (
+	// summary is the data used to render the reports of a campaign.
+	summary struct {
+		Total            int
+		Passed           int
+		Failed           []Run
+		BrokenInvariants []brokenInvariant
+		Operations       []OperationStats
+	}
+
+	brokenInvariant struct {
+		Name  string
+		Seeds []int64
+	}
+)
+
+var funcs = map[string]any{
+	"join": joinSeeds,
+	"cell": markdownCell,
+}
+
+// WriteMarkdown writes a Markdown summary of the campaign with the failed seeds, their
+// reproducer commands, the broken invariants and the success rates of the operations.
+func WriteMarkdown(w io.Writer, c Campaign) error {
+	t, err := template.New("summary.md.tpl").Funcs(funcs).ParseFS(templates, "templates/summary.md.tpl")
+	if err != nil {
+		return err
+	}
+	return t.Execute(w, newSummary(c))
+}
+
+// WriteHTML writes an HTML summary of the campaign with the same content as the Markdown summary.
+func WriteHTML(w io.Writer, c Campaign) error {
+	t, err := htmltemplate.New("summary.html.tpl").Funcs(funcs).ParseFS(templates, "templates/summary.html.tpl")
+	if err != nil {
+		return err
+	}
+	return t.Execute(w, newSummary(c))
+}
+
+func newSummary(c Campaign) summary {
+	failed := c.Failed()
+
+	var invariants []brokenInvariant
+	for name, seeds := range c.BrokenInvariants() {
+		invariants = append(invariants, brokenInvariant{Name: name, Seeds: seeds})
+	}
+	sort.Slice(invariants, func(i, j int) bool {
+		return invariants[i].Name < invariants[j].Name
+	})
+
+	return summary{
+		Total:            len(c.Runs),
+		Passed:           len(c.Runs) - len(failed),
+		Failed:           failed,
+		BrokenInvariants: invariants,
+		Operations:       c.OperationStats(),
+	}
+}
+
+func joinSeeds(seeds []int64) string {
+	s := make([]string, len(seeds))
+	for i, seed := range seeds {
+		s[i] = strconv.FormatInt(seed, 10)
+	}
+	return strings.Join(s, ", ")
+}
+
+// markdownCell escapes the pipes of a value to use it in a Markdown table cell.
+func markdownCell(s string) string {
+	return strings.ReplaceAll(s, "|", `\|`)
+}
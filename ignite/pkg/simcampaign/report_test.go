@@ -0,0 +1,74 @@
+package simcampaign_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/cli/v29/ignite/pkg/simcampaign"
+)
+
+var campaign = simcampaign.Campaign{
+	Runs: []simcampaign.Run{
+		{
+			Seed:   1,
+			Passed: true,
+			Stats: simcampaign.Stats{
+				"bank": {"/cosmos.bank.v1beta1.MsgSend": {"ok": 3, "failure": 1}},
+			},
+		},
+		{
+			Seed:             2,
+			BrokenInvariants: []string{"bank/total supply"},
+			Reproducer:       "ignite chain simulate --seed 2",
+		},
+		{
+			Seed:       3,
+			Failure:    "panic: a | b",
+			Reproducer: "ignite chain simulate --seed 3",
+		},
+	},
+}
+
+func TestWriteMarkdown(t *testing.T) {
+	// Arrange
+	var buf bytes.Buffer
+
+	// Act
+	err := simcampaign.WriteMarkdown(&buf, campaign)
+
+	// Assert
+	require.NoError(t, err)
+	require.Equal(t, "# Simulation summary\n\n"+
+		"1 of 3 simulations passed.\n\n"+
+		"## Failed seeds\n\n"+
+		"| Seed | Failure | Reproducer |\n"+
+		"| ---- | ------- | ---------- |\n"+
+		"| 2 | broken invariants: bank/total supply | `ignite chain simulate --seed 2` |\n"+
+		"| 3 | panic: a \\| b | `ignite chain simulate --seed 3` |\n\n"+
+		"## Broken invariants\n\n"+
+		"| Invariant | Seeds |\n"+
+		"| --------- | ----- |\n"+
+		"| bank/total supply | 2 |\n\n"+
+		"## Operations\n\n"+
+		"| Module | Operation | OK | Failure | Success rate |\n"+
+		"| ------ | --------- | -- | ------- | ------------ |\n"+
+		"| bank | /cosmos.bank.v1beta1.MsgSend | 3 | 1 | 75.0% |\n",
+		buf.String())
+}
+
+func TestWriteHTML(t *testing.T) {
+	// Arrange
+	var buf bytes.Buffer
+
+	// Act
+	err := simcampaign.WriteHTML(&buf, campaign)
+
+	// Assert
+	require.NoError(t, err)
+	require.Contains(t, buf.String(), "<p>1 of 3 simulations passed.</p>")
+	require.Contains(t, buf.String(), "<td><code>ignite chain simulate --seed 2</code></td>")
+	require.Contains(t, buf.String(), "<tr><td>bank/total supply</td><td>2</td></tr>")
+	require.Contains(t, buf.String(), "<td>75.0%</td>")
+}
@@ -0,0 +1,252 @@
+// Package simcampaign provides the types to run the simulation of a chain with many
+// seeds and to summarize the results of the simulations.
+package simcampaign
+
+import (
+	"bufio"
+	"encoding/json"
+	"io"
+	"os"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/ignite/cli/v29/ignite/pkg/errors"
+)
+
+const (
+	// ResultOK is the result tallied in the simulation stats for the operations that succeed.
+	ResultOK = "ok"
+
+	// ResultFailure is the result tallied in the simulation stats for the operations that fail.
+	ResultFailure = "failure"
+)
+
+// reInvariant matches the invariant broken messages printed by the simulations.
+var reInvariant = regexp.MustCompile(`invariant broken:\s*(\S+):\s*(.+?)\s+invariant`)
+
+type (
+	// Stats are the operation stats exported by a simulation. They count the results
+	// of each operation of each module, indexed by module, operation and result.
+	Stats map[string]map[string]map[string]int
+
+	// Run is the result of the simulation of a seed.
+	Run struct {
+		// Seed is the random seed of the simulation.
+		Seed int64
+
+		// Passed is true when the simulation succeeds.
+		Passed bool
+
+		// BrokenInvariants are the invariants broken by the simulation, with the "module/invariant" format.
+		BrokenInvariants []string
+
+		// Failure describes why the simulation failed when no invariant was broken.
+		Failure string
+
+		// Duration is the time that the simulation took to run.
+		Duration time.Duration
+
+		// Dir is the directory with the log and the exported files of the simulation.
+		Dir string
+
+		// Reproducer is the command to run again the simulation of the seed.
+		Reproducer string
+
+		// Stats are the operation stats exported by the simulation.
+		Stats Stats
+	}
+
+	// Campaign are the results of the simulations of many seeds.
+	Campaign struct {
+		Runs []Run
+	}
+
+	// OperationStats are the results of an operation in all the simulations of a campaign.
+	OperationStats struct {
+		Module    string
+		Operation string
+		OK        int
+		Failure   int
+	}
+)
+
+// ParseSeeds parses a list of seeds separated by comma where each item is a seed or an
+// inclusive range of seeds, e.g. "1..50" or "1,7,10..20".
+func ParseSeeds(s string) ([]int64, error) {
+	var (
+		seeds []int64
+		seen  = make(map[int64]struct{})
+	)
+	add := func(seed int64) {
+		if _, ok := seen[seed]; !ok {
+			seen[seed] = struct{}{}
+			seeds = append(seeds, seed)
+		}
+	}
+
+	for _, item := range strings.Split(s, ",") {
+		item = strings.TrimSpace(item)
+
+		from, to, isRange := strings.Cut(item, "..")
+		first, err := strconv.ParseInt(from, 10, 64)
+		if err != nil {
+			return nil, errors.Errorf("invalid seed %q", from)
+		}
+
+		if !isRange {
+			add(first)
+			continue
+		}
+
+		last, err := strconv.ParseInt(to, 10, 64)
+		if err != nil {
+			return nil, errors.Errorf("invalid seed %q", to)
+		}
+		if last < first {
+			return nil, errors.Errorf("invalid seed range %q, the first seed is greater than the last one", item)
+		}
+
+		for seed := first; seed <= last; seed++ {
+			add(seed)
+		}
+	}
+
+	return seeds, nil
+}
+
+// ParseLog parses the output of a failed simulation and returns the broken invariants
+// or a description of the failure when no invariant was broken.
+func ParseLog(r io.Reader) (brokenInvariants []string, failure string, err error) {
+	var (
+		s        = bufio.NewScanner(r)
+		seen     = make(map[string]struct{})
+		lastLine string
+	)
+
+	// Simulations can print long lines like the exported state
+	s.Buffer(make([]byte, 0, bufio.MaxScanTokenSize), 10*1024*1024)
+
+	for s.Scan() {
+		line := strings.TrimSpace(s.Text())
+		if line == "" {
+			continue
+		}
+
+		if m := reInvariant.FindStringSubmatch(line); m != nil {
+			invariant := m[1] + "/" + m[2]
+			if _, ok := seen[invariant]; !ok {
+				seen[invariant] = struct{}{}
+				brokenInvariants = append(brokenInvariants, invariant)
+			}
+			continue
+		}
+
+		// The first panic or error message is usually the cause of the failure
+		if failure == "" && (strings.HasPrefix(line, "panic:") || strings.Contains(line, "Error:")) {
+			failure = line
+		}
+
+		if !strings.HasPrefix(line, "FAIL") && !strings.HasPrefix(line, "exit status") {
+			lastLine = line
+		}
+	}
+
+	if err := s.Err(); err != nil {
+		return nil, "", err
+	}
+
+	if len(brokenInvariants) > 0 {
+		return brokenInvariants, "", nil
+	}
+	if failure == "" {
+		failure = lastLine
+	}
+
+	return nil, failure, nil
+}
+
+// LoadStats loads the operation stats exported by a simulation.
+func LoadStats(path string) (Stats, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var stats Stats
+	if err := json.Unmarshal(data, &stats); err != nil {
+		return nil, errors.Errorf("invalid simulation stats %s: %w", path, err)
+	}
+
+	return stats, nil
+}
+
+// Failed returns the simulations of the campaign that failed.
+func (c Campaign) Failed() []Run {
+	var runs []Run
+	for _, r := range c.Runs {
+		if !r.Passed {
+			runs = append(runs, r)
+		}
+	}
+	return runs
+}
+
+// BrokenInvariants returns the broken invariants of the campaign and the seeds that broke them.
+func (c Campaign) BrokenInvariants() map[string][]int64 {
+	invariants := make(map[string][]int64)
+	for _, r := range c.Runs {
+		for _, name := range r.BrokenInvariants {
+			invariants[name] = append(invariants[name], r.Seed)
+		}
+	}
+	return invariants
+}
+
+// OperationStats returns the results of each operation in all the simulations of
+// the campaign, sorted by module and operation.
+func (c Campaign) OperationStats() []OperationStats {
+	index := make(map[[2]string]int)
+
+	var stats []OperationStats
+	for _, r := range c.Runs {
+		for module, operations := range r.Stats {
+			for operation, results := range operations {
+				key := [2]string{module, operation}
+				i, ok := index[key]
+				if !ok {
+					i = len(stats)
+					index[key] = i
+					stats = append(stats, OperationStats{Module: module, Operation: operation})
+				}
+
+				stats[i].OK += results[ResultOK]
+				stats[i].Failure += results[ResultFailure]
+			}
+		}
+	}
+
+	sort.Slice(stats, func(i, j int) bool {
+		if stats[i].Module != stats[j].Module {
+			return stats[i].Module < stats[j].Module
+		}
+		return stats[i].Operation < stats[j].Operation
+	})
+
+	return stats
+}
+
+// Total returns the number of times that the operation was executed.
+func (s OperationStats) Total() int {
+	return s.OK + s.Failure
+}
+
+// SuccessRate returns the percentage of executions of the operation that succeeded.
+func (s OperationStats) SuccessRate() float64 {
+	if s.Total() == 0 {
+		return 0
+	}
+	return float64(s.OK) * 100 / float64(s.Total())
+}
@@ -0,0 +1,125 @@
+package simcampaign_test
+
+import (
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/cli/v29/ignite/pkg/simcampaign"
+)
+
+func TestParseSeeds(t *testing.T) {
+	cases := []struct {
+		name  string
+		seeds string
+		want  []int64
+		err   string
+	}{
+		{
+			name:  "single seed",
+			seeds: "42",
+			want:  []int64{42},
+		},
+		{
+			name:  "range of seeds",
+			seeds: "1..5",
+			want:  []int64{1, 2, 3, 4, 5},
+		},
+		{
+			name:  "list of seeds and ranges",
+			seeds: "7, 1..3,2,10..11",
+			want:  []int64{7, 1, 2, 3, 10, 11},
+		},
+		{
+			name:  "invalid seed",
+			seeds: "1,a",
+			err:   `invalid seed "a"`,
+		},
+		{
+			name:  "invalid range end",
+			seeds: "1..",
+			err:   `invalid seed ""`,
+		},
+		{
+			name:  "inverted range",
+			seeds: "5..1",
+			err:   `invalid seed range "5..1", the first seed is greater than the last one`,
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			// Act
+			seeds, err := simcampaign.ParseSeeds(tt.seeds)
+
+			// Assert
+			if tt.err != "" {
+				require.EqualError(t, err, tt.err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tt.want, seeds)
+		})
+	}
+}
+
+func TestParseLog(t *testing.T) {
+	// Arrange
+	f, err := os.Open("testdata/invariant.log")
+	require.NoError(t, err)
+	defer f.Close()
+
+	// Act
+	invariants, failure, err := simcampaign.ParseLog(f)
+
+	// Assert
+	require.NoError(t, err)
+	require.Equal(t, []string{"bank/total supply"}, invariants)
+	require.Empty(t, failure)
+}
+
+func TestParseLogFailure(t *testing.T) {
+	// Arrange
+	log := `Starting SimulateFromSeed with randomness created with seed 3
+panic: failed to create post: out of gas
+FAIL	github.com/ignite/mars/app	1.002s
+exit status 1
+`
+
+	// Act
+	invariants, failure, err := simcampaign.ParseLog(strings.NewReader(log))
+
+	// Assert
+	require.NoError(t, err)
+	require.Empty(t, invariants)
+	require.Equal(t, "panic: failed to create post: out of gas", failure)
+}
+
+func TestCampaignOperationStats(t *testing.T) {
+	// Arrange
+	stats, err := simcampaign.LoadStats("testdata/stats.json")
+	require.NoError(t, err)
+
+	c := simcampaign.Campaign{
+		Runs: []simcampaign.Run{
+			{Seed: 1, Passed: true, Stats: stats},
+			{Seed: 2, Passed: true, Stats: simcampaign.Stats{
+				"bank": {"/cosmos.bank.v1beta1.MsgSend": {"ok": 2, "failure": 8}},
+			}},
+		},
+	}
+
+	// Act
+	operations := c.OperationStats()
+
+	// Assert
+	require.Equal(t, []simcampaign.OperationStats{
+		{Module: "bank", Operation: "/cosmos.bank.v1beta1.MsgSend", OK: 20, Failure: 10},
+		{Module: "blog", Operation: "/blog.blog.v1.MsgCreatePost", OK: 9, Failure: 1},
+	}, operations)
+	require.Equal(t, 30, operations[0].Total())
+	require.InDelta(t, 66.6, operations[0].SuccessRate(), 0.1)
+}
@@ -0,0 +1,48 @@
+<!DOCTYPE html>
+<html lang="en">
+<head>
+  <meta charset="utf-8">
+  <title>Simulation summary</title>
+  <style>
+    body { font-family: sans-serif; margin: 2em; }
+    table { border-collapse: collapse; margin-bottom: 2em; }
+    th, td { border: 1px solid #ccc; padding: 0.3em 0.8em; text-align: left; }
+    .failed { color: #c0392b; }
+  </style>
+</head>
+<body>
+  <h1>Simulation summary</h1>
+  <p>{{ .Passed }} of {{ .Total }} simulations passed.</p>
+  {{- if .Failed }}
+  <h2>Failed seeds</h2>
+  <table>
+    <tr><th>Seed</th><th>Failure</th><th>Reproducer</th></tr>
+    {{- range .Failed }}
+    <tr>
+      <td class="failed">{{ .Seed }}</td>
+      <td>{{ if .BrokenInvariants }}broken invariants: {{ range $i, $name := .BrokenInvariants }}{{ if $i }}, {{ end }}{{ $name }}{{ end }}{{ else }}{{ .Failure }}{{ end }}</td>
+      <td><code>{{ .Reproducer }}</code></td>
+    </tr>
+    {{- end }}
+  </table>
+  {{- end }}
+  {{- if .BrokenInvariants }}
+  <h2>Broken invariants</h2>
+  <table>
+    <tr><th>Invariant</th><th>Seeds</th></tr>
+    {{- range .BrokenInvariants }}
+    <tr><td>{{ .Name }}</td><td>{{ join .Seeds }}</td></tr>
+    {{- end }}
+  </table>
+  {{- end }}
+  {{- if .Operations }}
+  <h2>Operations</h2>
+  <table>
+    <tr><th>Module</th><th>Operation</th><th>OK</th><th>Failure</th><th>Success rate</th></tr>
+    {{- range .Operations }}
+    <tr><td>{{ .Module }}</td><td>{{ .Operation }}</td><td>{{ .OK }}</td><td>{{ .Failure }}</td><td>{{ printf "%.1f" .SuccessRate }}%</td></tr>
+    {{- end }}
+  </table>
+  {{- end }}
+</body>
+</html>
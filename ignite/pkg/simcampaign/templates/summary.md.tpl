@@ -0,0 +1,33 @@
+# Simulation summary
+
+{{ .Passed }} of {{ .Total }} simulations passed.
+{{- if .Failed }}
+
+## Failed seeds
+
+| Seed | Failure | Reproducer |
+| ---- | ------- | ---------- |
+{{- range .Failed }}
+| {{ .Seed }} | {{ if .BrokenInvariants }}broken invariants: {{ range $i, $name := .BrokenInvariants }}{{ if $i }}, {{ end }}{{ $name }}{{ end }}{{ else }}{{ cell .Failure }}{{ end }} | `{{ .Reproducer }}` |
+{{- end }}
+{{- end }}
+{{- if .BrokenInvariants }}
+
+## Broken invariants
+
+| Invariant | Seeds |
+| --------- | ----- |
+{{- range .BrokenInvariants }}
+| {{ .Name }} | {{ join .Seeds }} |
+{{- end }}
+{{- end }}
+{{- if .Operations }}
+
+## Operations
+
+| Module | Operation | OK | Failure | Success rate |
+| ------ | --------- | -- | ------- | ------------ |
+{{- range .Operations }}
+| {{ .Module }} | {{ .Operation }} | {{ .OK }} | {{ .Failure }} | {{ printf "%.1f" .SuccessRate }}% |
+{{- end }}
+{{- end }}
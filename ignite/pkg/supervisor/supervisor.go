@@ -0,0 +1,209 @@
+// Package supervisor restarts long-running processes when they fail
+// and stops restarting them when they keep crashing.
+package supervisor
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/ignite/cli/v29/ignite/pkg/errors"
+)
+
+const (
+	// DefaultMaxRestarts is the default number of restarts allowed within the restart window.
+	DefaultMaxRestarts = 3
+
+	// DefaultWindow is the default time window used to detect crash loops.
+	DefaultWindow = time.Minute
+
+	// DefaultMinBackoff is the default time to wait before the first restart.
+	DefaultMinBackoff = time.Second
+
+	// DefaultMaxBackoff is the default maximum time to wait before a restart.
+	DefaultMaxBackoff = 30 * time.Second
+)
+
+// Supervisor runs a process and restarts it when it fails.
+// The time to wait before a restart grows exponentially while the
+// process keeps failing, and it's reset once the process runs for
+// longer than the restart window.
+type Supervisor struct {
+	maxRestarts int
+	window      time.Duration
+	minBackoff  time.Duration
+	maxBackoff  time.Duration
+	onRestart   func(Restart)
+}
+
+// Restart contains the details of a process restart.
+type Restart struct {
+	// Count is the number of restarts within the restart window, including this one.
+	Count int
+
+	// Delay is the time to wait before the process is restarted.
+	Delay time.Duration
+
+	// Err is the error returned by the process.
+	Err error
+}
+
+// Option configures a supervisor.
+type Option func(*Supervisor)
+
+// MaxRestarts sets the number of restarts allowed within the restart window.
+func MaxRestarts(n int) Option {
+	return func(s *Supervisor) {
+		s.maxRestarts = n
+	}
+}
+
+// Window sets the time window used to detect crash loops.
+func Window(d time.Duration) Option {
+	return func(s *Supervisor) {
+		s.window = d
+	}
+}
+
+// Backoff sets the minimum and maximum times to wait before a restart.
+func Backoff(minDelay, maxDelay time.Duration) Option {
+	return func(s *Supervisor) {
+		s.minBackoff = minDelay
+		s.maxBackoff = maxDelay
+	}
+}
+
+// OnRestart sets a function that is called before every restart.
+func OnRestart(fn func(Restart)) Option {
+	return func(s *Supervisor) {
+		s.onRestart = fn
+	}
+}
+
+// New creates a new supervisor.
+func New(options ...Option) Supervisor {
+	s := Supervisor{
+		maxRestarts: DefaultMaxRestarts,
+		window:      DefaultWindow,
+		minBackoff:  DefaultMinBackoff,
+		maxBackoff:  DefaultMaxBackoff,
+	}
+
+	for _, apply := range options {
+		apply(&s)
+	}
+
+	return s
+}
+
+// Run runs a process and restarts it every time it fails until the context is canceled.
+// Run returns when the process finishes without error, when the error is permanent or
+// when it fails more times than the allowed restarts within the restart window, in
+// which case a crash loop error is returned.
+func (s Supervisor) Run(ctx context.Context, run func(context.Context) error) error {
+	var (
+		restarts []time.Time
+		delay    = s.minBackoff
+	)
+
+	for {
+		started := time.Now()
+
+		err := run(ctx)
+		if err == nil || ctx.Err() != nil {
+			return err
+		}
+
+		var permanentErr *permanentError
+		if errors.As(err, &permanentErr) {
+			return permanentErr.err
+		}
+
+		now := time.Now()
+
+		// A process that ran for a while is not considered to be crash looping
+		if now.Sub(started) > s.window {
+			delay = s.minBackoff
+		}
+
+		restarts = since(restarts, now.Add(-s.window))
+		if len(restarts) >= s.maxRestarts {
+			return &CrashLoopError{
+				Restarts: len(restarts),
+				Window:   s.window,
+				Err:      err,
+			}
+		}
+
+		restarts = append(restarts, now)
+
+		if s.onRestart != nil {
+			s.onRestart(Restart{
+				Count: len(restarts),
+				Delay: delay,
+				Err:   err,
+			})
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(delay):
+		}
+
+		delay = min(delay*2, s.maxBackoff)
+	}
+}
+
+// since returns the times that are after t.
+func since(times []time.Time, t time.Time) []time.Time {
+	for i, v := range times {
+		if v.After(t) {
+			return times[i:]
+		}
+	}
+
+	return nil
+}
+
+// CrashLoopError is returned when a process fails more times
+// than the allowed restarts within the restart window.
+type CrashLoopError struct {
+	// Restarts is the number of restarts within the restart window.
+	Restarts int
+
+	// Window is the time window used to detect the crash loop.
+	Window time.Duration
+
+	// Err is the last error returned by the process.
+	Err error
+}
+
+func (e *CrashLoopError) Error() string {
+	return fmt.Sprintf("process crashed after %d restarts within %s: %s", e.Restarts, e.Window, e.Err)
+}
+
+func (e *CrashLoopError) Unwrap() error {
+	return e.Err
+}
+
+// Permanent wraps an error to stop restarting the process when it's returned.
+func Permanent(err error) error {
+	if err == nil {
+		return nil
+	}
+
+	return &permanentError{err}
+}
+
+type permanentError struct {
+	err error
+}
+
+func (e *permanentError) Error() string {
+	return e.err.Error()
+}
+
+func (e *permanentError) Unwrap() error {
+	return e.err
+}
@@ -0,0 +1,99 @@
+package supervisor_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/cli/v29/ignite/pkg/errors"
+	"github.com/ignite/cli/v29/ignite/pkg/supervisor"
+)
+
+var errCrash = errors.New("crash")
+
+func TestRun(t *testing.T) {
+	// Arrange
+	var (
+		ctx      = context.Background()
+		runs     int
+		restarts []supervisor.Restart
+	)
+
+	s := supervisor.New(
+		supervisor.MaxRestarts(3),
+		supervisor.Backoff(time.Millisecond, 3*time.Millisecond),
+		supervisor.OnRestart(func(r supervisor.Restart) {
+			restarts = append(restarts, r)
+		}),
+	)
+
+	// Act
+	err := s.Run(ctx, func(context.Context) error {
+		runs++
+		return errCrash
+	})
+
+	// Assert
+	var loopErr *supervisor.CrashLoopError
+	require.ErrorAs(t, err, &loopErr)
+	require.ErrorIs(t, err, errCrash)
+	require.Equal(t, 3, loopErr.Restarts)
+	require.Equal(t, supervisor.DefaultWindow, loopErr.Window)
+	require.EqualError(t, err, "process crashed after 3 restarts within 1m0s: crash")
+	require.Equal(t, 4, runs)
+	require.Equal(t, []supervisor.Restart{
+		{Count: 1, Delay: time.Millisecond, Err: errCrash},
+		{Count: 2, Delay: 2 * time.Millisecond, Err: errCrash},
+		{Count: 3, Delay: 3 * time.Millisecond, Err: errCrash},
+	}, restarts)
+}
+
+func TestRunOutsideWindow(t *testing.T) {
+	// Arrange
+	var runs int
+
+	s := supervisor.New(
+		supervisor.MaxRestarts(1),
+		supervisor.Window(5*time.Millisecond),
+		supervisor.Backoff(10*time.Millisecond, 10*time.Millisecond),
+	)
+
+	// Act: restarts are never within the same window
+	err := s.Run(context.Background(), func(context.Context) error {
+		if runs++; runs == 3 {
+			return nil
+		}
+		return errCrash
+	})
+
+	// Assert
+	require.NoError(t, err)
+	require.Equal(t, 3, runs)
+}
+
+func TestRunPermanentError(t *testing.T) {
+	var runs int
+
+	err := supervisor.New().Run(context.Background(), func(context.Context) error {
+		runs++
+		return supervisor.Permanent(errCrash)
+	})
+
+	require.Equal(t, errCrash, err)
+	require.Equal(t, 1, runs)
+	require.NoError(t, supervisor.Permanent(nil))
+}
+
+func TestRunCanceled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	err := supervisor.New().Run(ctx, func(context.Context) error {
+		// Cancel while waiting to restart
+		time.AfterFunc(10*time.Millisecond, cancel)
+		return errCrash
+	})
+
+	require.ErrorIs(t, err, context.Canceled)
+}
@@ -0,0 +1,98 @@
+package tarball
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// Create writes a gzip compressed tarball with the files of a directory.
+// The tarball is reproducible: files are added in lexical order and the
+// file owners, permissions and modification times are normalized, so
+// directories with the same file contents always produce the same tarball.
+func Create(w io.Writer, dir string, modTime time.Time) error {
+	gzw := gzip.NewWriter(w)
+
+	if err := Write(gzw, dir, modTime); err != nil {
+		return err
+	}
+
+	return gzw.Close()
+}
+
+// Write writes an uncompressed tarball with the files of a directory.
+// Like Create, the tarball is reproducible, which allows to compress
+// it using other compression formats.
+func Write(w io.Writer, dir string, modTime time.Time) error {
+	tw := tar.NewWriter(w)
+
+	// WalkDir visits the files in lexical order
+	err := filepath.WalkDir(dir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		if path == dir {
+			return nil
+		}
+
+		name, err := filepath.Rel(dir, path)
+		if err != nil {
+			return err
+		}
+
+		info, err := d.Info()
+		if err != nil {
+			return err
+		}
+
+		header := &tar.Header{
+			Name:    filepath.ToSlash(name),
+			ModTime: modTime.UTC().Truncate(time.Second),
+			Format:  tar.FormatUSTAR,
+		}
+
+		switch {
+		case d.IsDir():
+			header.Typeflag = tar.TypeDir
+			header.Name += "/"
+			header.Mode = 0o755
+		case info.Mode().IsRegular():
+			header.Typeflag = tar.TypeReg
+			header.Size = info.Size()
+			header.Mode = 0o644
+			if info.Mode()&0o111 != 0 {
+				header.Mode = 0o755
+			}
+		default:
+			// Only directories and regular files are added
+			return nil
+		}
+
+		if err := tw.WriteHeader(header); err != nil {
+			return err
+		}
+
+		if header.Typeflag != tar.TypeReg {
+			return nil
+		}
+
+		f, err := os.Open(path)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+
+		_, err = io.Copy(tw, f)
+		return err
+	})
+	if err != nil {
+		return err
+	}
+
+	return tw.Close()
+}
@@ -0,0 +1,40 @@
+package tarball_test
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/cli/v29/ignite/pkg/tarball"
+)
+
+func TestCreate(t *testing.T) {
+	// Arrange
+	modTime := time.Unix(1714564800, 0)
+	createDir := func(mtime time.Time) string {
+		dir := t.TempDir()
+		require.NoError(t, os.WriteFile(filepath.Join(dir, "marsd"), []byte("binary"), 0o700))
+		require.NoError(t, os.Mkdir(filepath.Join(dir, "docs"), 0o700))
+		require.NoError(t, os.WriteFile(filepath.Join(dir, "docs", "README.md"), []byte("# Mars"), 0o600))
+		require.NoError(t, os.Chtimes(filepath.Join(dir, "marsd"), mtime, mtime))
+		return dir
+	}
+
+	// Act
+	var first, second bytes.Buffer
+	require.NoError(t, tarball.Create(&first, createDir(time.Now()), modTime))
+	require.NoError(t, tarball.Create(&second, createDir(time.Now().Add(time.Hour)), modTime))
+
+	// Assert
+	require.Equal(t, first.Bytes(), second.Bytes())
+
+	var out bytes.Buffer
+	name, err := tarball.ExtractFile(bytes.NewReader(first.Bytes()), &out, "README.md")
+	require.NoError(t, err)
+	require.Equal(t, "docs/README.md", name)
+	require.Equal(t, "# Mars", out.String())
+}
@@ -0,0 +1,153 @@
+// Package xarchive creates reproducible archives of directories using different formats.
+package xarchive
+
+import (
+	"archive/zip"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/klauspost/compress/zstd"
+
+	"github.com/ignite/cli/v29/ignite/pkg/errors"
+	"github.com/ignite/cli/v29/ignite/pkg/tarball"
+)
+
+// Format is an archive format.
+type Format string
+
+const (
+	// FormatTarGz is a gzip compressed tarball.
+	FormatTarGz Format = "tar.gz"
+
+	// FormatTarZst is a zstd compressed tarball.
+	FormatTarZst Format = "tar.zst"
+
+	// FormatZip is a zip archive.
+	FormatZip Format = "zip"
+)
+
+// Formats returns the supported archive formats.
+func Formats() []Format {
+	return []Format{FormatTarGz, FormatTarZst, FormatZip}
+}
+
+// ParseFormat parses an archive format name.
+func ParseFormat(name string) (Format, error) {
+	for _, f := range Formats() {
+		if string(f) == name {
+			return f, nil
+		}
+	}
+
+	return "", errors.Errorf("unsupported archive format %q", name)
+}
+
+// Ext returns the file extension of the format, including the leading dot.
+func (f Format) Ext() string {
+	return "." + string(f)
+}
+
+// Create writes an archive with the files of a directory.
+// Archives are reproducible: files are added in lexical order and the
+// file owners, permissions and modification times are normalized, so
+// directories with the same file contents always produce the same archive.
+func Create(w io.Writer, dir string, format Format, modTime time.Time) error {
+	switch format {
+	case FormatTarGz:
+		return tarball.Create(w, dir, modTime)
+	case FormatTarZst:
+		return createTarZst(w, dir, modTime)
+	case FormatZip:
+		return createZip(w, dir, modTime)
+	default:
+		return errors.Errorf("unsupported archive format %q", format)
+	}
+}
+
+func createTarZst(w io.Writer, dir string, modTime time.Time) error {
+	// A single encoder goroutine always produces the same output
+	zw, err := zstd.NewWriter(w, zstd.WithEncoderConcurrency(1))
+	if err != nil {
+		return err
+	}
+
+	if err := tarball.Write(zw, dir, modTime); err != nil {
+		zw.Close()
+		return err
+	}
+
+	return zw.Close()
+}
+
+func createZip(w io.Writer, dir string, modTime time.Time) error {
+	zw := zip.NewWriter(w)
+
+	// WalkDir visits the files in lexical order
+	err := filepath.WalkDir(dir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		if path == dir {
+			return nil
+		}
+
+		name, err := filepath.Rel(dir, path)
+		if err != nil {
+			return err
+		}
+
+		info, err := d.Info()
+		if err != nil {
+			return err
+		}
+
+		header := &zip.FileHeader{
+			Name:     filepath.ToSlash(name),
+			Modified: modTime.UTC().Truncate(time.Second),
+			Method:   zip.Deflate,
+		}
+
+		switch {
+		case d.IsDir():
+			header.Name += "/"
+			header.Method = zip.Store
+			header.SetMode(fs.ModeDir | 0o755)
+		case info.Mode().IsRegular():
+			header.SetMode(0o644)
+			if info.Mode()&0o111 != 0 {
+				header.SetMode(0o755)
+			}
+		default:
+			// Only directories and regular files are added
+			return nil
+		}
+
+		fw, err := zw.CreateHeader(header)
+		if err != nil {
+			return err
+		}
+
+		if strings.HasSuffix(header.Name, "/") {
+			return nil
+		}
+
+		f, err := os.Open(path)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+
+		_, err = io.Copy(fw, f)
+		return err
+	})
+	if err != nil {
+		return err
+	}
+
+	return zw.Close()
+}
@@ -0,0 +1,104 @@
+package xarchive_test
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"bytes"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/klauspost/compress/zstd"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/cli/v29/ignite/pkg/xarchive"
+)
+
+func TestParseFormat(t *testing.T) {
+	for _, f := range xarchive.Formats() {
+		got, err := xarchive.ParseFormat(string(f))
+		require.NoError(t, err)
+		require.Equal(t, f, got)
+	}
+
+	_, err := xarchive.ParseFormat("rar")
+	require.ErrorContains(t, err, `unsupported archive format "rar"`)
+}
+
+func TestCreate(t *testing.T) {
+	modTime := time.Unix(1714564800, 0)
+	createDir := func(mtime time.Time) string {
+		dir := t.TempDir()
+		require.NoError(t, os.WriteFile(filepath.Join(dir, "marsd"), []byte("binary"), 0o700))
+		require.NoError(t, os.WriteFile(filepath.Join(dir, "LICENSE"), []byte("license"), 0o600))
+		require.NoError(t, os.Chtimes(filepath.Join(dir, "marsd"), mtime, mtime))
+		return dir
+	}
+
+	cases := []struct {
+		format xarchive.Format
+		files  func(t *testing.T, data []byte) map[string]string
+	}{
+		{
+			format: xarchive.FormatTarZst,
+			files: func(t *testing.T, data []byte) map[string]string {
+				zr, err := zstd.NewReader(bytes.NewReader(data))
+				require.NoError(t, err)
+				defer zr.Close()
+
+				files := make(map[string]string)
+				tr := tar.NewReader(zr)
+				for {
+					h, err := tr.Next()
+					if err == io.EOF {
+						return files
+					}
+					require.NoError(t, err)
+					require.True(t, h.ModTime.Equal(modTime))
+
+					content, err := io.ReadAll(tr)
+					require.NoError(t, err)
+					files[h.Name] = string(content)
+				}
+			},
+		},
+		{
+			format: xarchive.FormatZip,
+			files: func(t *testing.T, data []byte) map[string]string {
+				zr, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+				require.NoError(t, err)
+
+				files := make(map[string]string)
+				for _, f := range zr.File {
+					require.True(t, f.Modified.Equal(modTime))
+
+					r, err := f.Open()
+					require.NoError(t, err)
+					content, err := io.ReadAll(r)
+					require.NoError(t, err)
+					r.Close()
+					files[f.Name] = string(content)
+				}
+				return files
+			},
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(string(tt.format), func(t *testing.T) {
+			// Act
+			var first, second bytes.Buffer
+			require.NoError(t, xarchive.Create(&first, createDir(time.Now()), tt.format, modTime))
+			require.NoError(t, xarchive.Create(&second, createDir(time.Now().Add(time.Hour)), tt.format, modTime))
+
+			// Assert
+			require.Equal(t, first.Bytes(), second.Bytes())
+			require.Equal(t, map[string]string{
+				"LICENSE": "license",
+				"marsd":   "binary",
+			}, tt.files(t, first.Bytes()))
+		})
+	}
+}
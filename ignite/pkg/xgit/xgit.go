@@ -159,3 +159,95 @@ func IsRepository(path string) (bool, error) {
 	}
 	return true, nil
 }
+
+// HeadCommitTime returns the committer time of the HEAD commit of a repository.
+func HeadCommitTime(path string) (time.Time, error) {
+	repo, err := git.PlainOpenWithOptions(path, &defaultOpenOpts)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	head, err := repo.Head()
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	commit, err := repo.CommitObject(head.Hash())
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	return commit.Committer.When, nil
+}
+
+// RepositoryRoot returns the root directory of the repository that contains path.
+func RepositoryRoot(path string) (string, error) {
+	path, err := filepath.Abs(path)
+	if err != nil {
+		return "", err
+	}
+
+	repo, err := git.PlainOpenWithOptions(path, &defaultOpenOpts)
+	if err != nil {
+		return "", err
+	}
+
+	wt, err := repo.Worktree()
+	if err != nil {
+		return "", err
+	}
+
+	return wt.Filesystem.Root(), nil
+}
+
+// CloneRef clones the local repository that contains path into dir and checks
+// out ref, which can be a tag, a branch or a hash. It returns the path inside
+// dir that matches path, which is different from dir when path is a directory
+// inside the repository.
+func CloneRef(ctx context.Context, path, ref, dir string) (string, error) {
+	path, err := filepath.Abs(path)
+	if err != nil {
+		return "", err
+	}
+
+	repo, err := git.PlainOpenWithOptions(path, &defaultOpenOpts)
+	if err != nil {
+		return "", err
+	}
+
+	wt, err := repo.Worktree()
+	if err != nil {
+		return "", err
+	}
+
+	root := wt.Filesystem.Root()
+	rel, err := filepath.Rel(root, path)
+	if err != nil {
+		return "", err
+	}
+
+	h, err := repo.ResolveRevision(plumbing.Revision(ref))
+	if err != nil {
+		return "", errors.Errorf("resolve git ref %s: %w", ref, err)
+	}
+
+	if err := Clone(ctx, root, dir); err != nil {
+		return "", err
+	}
+
+	clone, err := git.PlainOpen(dir)
+	if err != nil {
+		return "", err
+	}
+
+	cloneWt, err := clone.Worktree()
+	if err != nil {
+		return "", err
+	}
+
+	if err := cloneWt.Checkout(&git.CheckoutOptions{Hash: *h}); err != nil {
+		return "", err
+	}
+
+	return filepath.Join(dir, rel), nil
+}
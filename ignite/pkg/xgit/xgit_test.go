@@ -432,3 +432,104 @@ func TestIsRepository(t *testing.T) {
 		})
 	}
 }
+
+func TestHeadCommitTime(t *testing.T) {
+	// Arrange
+	dir := t.TempDir()
+	repo, err := git.PlainInit(dir, false)
+	require.NoError(t, err)
+
+	err = os.WriteFile(path.Join(dir, "foo"), []byte("hello"), 0o644)
+	require.NoError(t, err)
+
+	wt, err := repo.Worktree()
+	require.NoError(t, err)
+
+	_, err = wt.Add("foo")
+	require.NoError(t, err)
+
+	when := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+	_, err = wt.Commit("foo", &git.CommitOptions{
+		Author: &object.Signature{Name: "bob", Email: "bob@example.com", When: when},
+	})
+	require.NoError(t, err)
+
+	// Act
+	got, err := xgit.HeadCommitTime(dir)
+
+	// Assert
+	require.NoError(t, err)
+	require.True(t, when.Equal(got))
+
+	_, err = xgit.HeadCommitTime(t.TempDir())
+	require.ErrorIs(t, err, git.ErrRepositoryNotExists)
+}
+
+func TestCloneRef(t *testing.T) {
+	// Arrange
+	repoDir := t.TempDir()
+	repo, err := git.PlainInit(repoDir, false)
+	require.NoError(t, err)
+
+	appDir := path.Join(repoDir, "app")
+	err = os.Mkdir(appDir, 0o755)
+	require.NoError(t, err)
+
+	err = os.WriteFile(path.Join(appDir, "foo"), []byte("v1"), 0o644)
+	require.NoError(t, err)
+
+	wt, err := repo.Worktree()
+	require.NoError(t, err)
+
+	_, err = wt.Add(".")
+	require.NoError(t, err)
+
+	author := &object.Signature{Name: "bob", Email: "bob@example.com", When: time.Now()}
+	commit1, err := wt.Commit("commit1", &git.CommitOptions{Author: author})
+	require.NoError(t, err)
+
+	_, err = repo.CreateTag("v1", commit1, nil)
+	require.NoError(t, err)
+
+	err = os.WriteFile(path.Join(appDir, "foo"), []byte("v2"), 0o644)
+	require.NoError(t, err)
+
+	_, err = wt.Commit("commit2", &git.CommitOptions{All: true, Author: author})
+	require.NoError(t, err)
+
+	// Act
+	dir := t.TempDir()
+	got, err := xgit.CloneRef(context.Background(), appDir, "v1", dir)
+
+	// Assert
+	require.NoError(t, err)
+	require.Equal(t, path.Join(dir, "app"), got)
+
+	bz, err := os.ReadFile(path.Join(got, "foo"))
+	require.NoError(t, err)
+	require.Equal(t, "v1", string(bz))
+
+	_, err = xgit.CloneRef(context.Background(), appDir, "v3", t.TempDir())
+	require.Error(t, err)
+}
+
+func TestRepositoryRoot(t *testing.T) {
+	// Arrange
+	repoDir := t.TempDir()
+	_, err := git.PlainInit(repoDir, false)
+	require.NoError(t, err)
+
+	appDir := path.Join(repoDir, "app")
+	err = os.Mkdir(appDir, 0o755)
+	require.NoError(t, err)
+
+	// Act
+	root, err := xgit.RepositoryRoot(appDir)
+
+	// Assert
+	require.NoError(t, err)
+	require.Equal(t, repoDir, root)
+
+	_, err = xgit.RepositoryRoot(t.TempDir())
+	require.Error(t, err)
+}
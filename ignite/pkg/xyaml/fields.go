@@ -0,0 +1,216 @@
+package xyaml
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+var (
+	unmarshalerType         = reflect.TypeOf((*yaml.Unmarshaler)(nil)).Elem()
+	obsoleteUnmarshalerType = reflect.TypeOf((*obsoleteUnmarshaler)(nil)).Elem()
+)
+
+// obsoleteUnmarshaler is the unmarshaler interface that is still supported by YAML decoding.
+type obsoleteUnmarshaler interface {
+	UnmarshalYAML(unmarshal func(interface{}) error) error
+}
+
+// Field describes a YAML field of a struct type.
+type Field struct {
+	// Name is the YAML name of the field.
+	Name string
+
+	// Type is the Go type of the field.
+	Type reflect.Type
+
+	// OmitEmpty is true when the field is omitted from the YAML when empty.
+	OmitEmpty bool
+}
+
+// Fields returns the YAML fields of a struct type using the field tags
+// in the same way as YAML decoding does. The fields of inlined structs
+// are included and unexported or ignored fields are skipped.
+func Fields(t reflect.Type) []Field {
+	t = Indirect(t)
+	if t.Kind() != reflect.Struct {
+		return nil
+	}
+
+	var fields []Field
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		if !f.IsExported() && !f.Anonymous {
+			continue
+		}
+
+		tag := f.Tag.Get("yaml")
+		if tag == "-" {
+			continue
+		}
+
+		name, opts, _ := strings.Cut(tag, ",")
+		if strings.Contains(opts, "inline") {
+			fields = append(fields, Fields(f.Type)...)
+			continue
+		}
+
+		if name == "" {
+			name = strings.ToLower(f.Name)
+		}
+
+		fields = append(fields, Field{
+			Name:      name,
+			Type:      f.Type,
+			OmitEmpty: strings.Contains(opts, "omitempty"),
+		})
+	}
+
+	return fields
+}
+
+// Indirect returns the type pointed by pointer types.
+func Indirect(t reflect.Type) reflect.Type {
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	return t
+}
+
+// IsCustomUnmarshaler returns true when the type decodes YAML values using its own logic.
+func IsCustomUnmarshaler(t reflect.Type) bool {
+	for _, u := range []reflect.Type{unmarshalerType, obsoleteUnmarshalerType} {
+		if t.Implements(u) || reflect.PointerTo(t).Implements(u) {
+			return true
+		}
+	}
+	return false
+}
+
+// UnknownField describes a YAML key that doesn't match any field of the decoded type.
+type UnknownField struct {
+	// Name is the name of the unknown key.
+	Name string
+
+	// Path is the path of the key in the YAML document.
+	Path string
+
+	// Line and Column are the position of the key in the YAML document.
+	Line, Column int
+}
+
+func (f UnknownField) String() string {
+	return fmt.Sprintf("line %d, column %d: unknown field %q", f.Line, f.Column, f.Path)
+}
+
+// UnknownFieldsError is returned when a YAML document contains unknown keys.
+type UnknownFieldsError struct {
+	Fields []UnknownField
+}
+
+func (e UnknownFieldsError) Error() string {
+	fields := make([]string, len(e.Fields))
+	for i, f := range e.Fields {
+		fields[i] = f.String()
+	}
+
+	return strings.Join(fields, "; ")
+}
+
+// CheckKnownFields checks that all the keys of a YAML node match a field of the type of v.
+// An UnknownFieldsError with the position of every unknown key is returned otherwise.
+// Types with custom YAML decoding and interface values are not checked.
+func CheckKnownFields(node *yaml.Node, v interface{}) error {
+	var unknown []UnknownField
+	checkKnownFields(node, reflect.TypeOf(v), "", &unknown)
+
+	if len(unknown) > 0 {
+		return &UnknownFieldsError{Fields: unknown}
+	}
+
+	return nil
+}
+
+func checkKnownFields(node *yaml.Node, t reflect.Type, path string, unknown *[]UnknownField) {
+	if node == nil || t == nil {
+		return
+	}
+
+	switch node.Kind {
+	case yaml.DocumentNode:
+		for _, n := range node.Content {
+			checkKnownFields(n, t, path, unknown)
+		}
+		return
+	case yaml.AliasNode:
+		checkKnownFields(node.Alias, t, path, unknown)
+		return
+	}
+
+	t = Indirect(t)
+	if IsCustomUnmarshaler(t) {
+		return
+	}
+
+	switch t.Kind() {
+	case reflect.Struct:
+		if node.Kind != yaml.MappingNode {
+			return
+		}
+
+		fields := make(map[string]reflect.Type)
+		for _, f := range Fields(t) {
+			fields[f.Name] = f.Type
+		}
+
+		for i := 0; i+1 < len(node.Content); i += 2 {
+			key, value := node.Content[i], node.Content[i+1]
+			fieldPath := joinPath(path, key.Value)
+
+			// Merge keys are resolved by the decoder
+			if key.Tag == "!!merge" {
+				checkKnownFields(value, t, path, unknown)
+				continue
+			}
+
+			fieldType, ok := fields[key.Value]
+			if !ok {
+				*unknown = append(*unknown, UnknownField{
+					Name:   key.Value,
+					Path:   fieldPath,
+					Line:   key.Line,
+					Column: key.Column,
+				})
+				continue
+			}
+
+			checkKnownFields(value, fieldType, fieldPath, unknown)
+		}
+	case reflect.Map:
+		if node.Kind != yaml.MappingNode {
+			return
+		}
+
+		for i := 0; i+1 < len(node.Content); i += 2 {
+			key, value := node.Content[i], node.Content[i+1]
+			checkKnownFields(value, t.Elem(), joinPath(path, key.Value), unknown)
+		}
+	case reflect.Slice, reflect.Array:
+		if node.Kind != yaml.SequenceNode {
+			return
+		}
+
+		for i, n := range node.Content {
+			checkKnownFields(n, t.Elem(), fmt.Sprintf("%s[%d]", path, i), unknown)
+		}
+	}
+}
+
+func joinPath(path, key string) string {
+	if path == "" {
+		return key
+	}
+	return path + "." + key
+}
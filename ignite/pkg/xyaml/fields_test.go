@@ -0,0 +1,95 @@
+package xyaml_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
+
+	"github.com/ignite/cli/v29/ignite/pkg/xyaml"
+)
+
+type (
+	testAccount struct {
+		Name  string   `yaml:"name"`
+		Coins []string `yaml:"coins"`
+	}
+
+	testBase struct {
+		Version  uint          `yaml:"version"`
+		Accounts []testAccount `yaml:"accounts"`
+	}
+
+	testConfig struct {
+		testBase `yaml:",inline"`
+		Faucet   *struct {
+			CoinsMax []string `yaml:"coins_max"`
+		} `yaml:"faucet"`
+		Genesis    xyaml.Map              `yaml:"genesis"`
+		Validators map[string]testAccount `yaml:"validators"`
+		Ignored    string                 `yaml:"-"`
+		Extra      map[string]interface{} `yaml:"extra"`
+	}
+)
+
+func TestFields(t *testing.T) {
+	var names []string
+	for _, f := range xyaml.Fields(reflect.TypeOf(testConfig{})) {
+		names = append(names, f.Name)
+	}
+
+	require.Equal(t, []string{"version", "accounts", "faucet", "genesis", "validators", "extra"}, names)
+}
+
+func TestCheckKnownFields(t *testing.T) {
+	input := `
+version: 1
+accounts:
+  - name: alice
+    coin: ["1stake"]
+faucet:
+  coins_maxx: ["1stake"]
+genesis:
+  any: value
+validators:
+  bob:
+    name: bob
+    bonded: 1stake
+extra:
+  any: value
+ignored: value
+`
+	var node yaml.Node
+	require.NoError(t, yaml.Unmarshal([]byte(input), &node))
+
+	err := xyaml.CheckKnownFields(&node, &testConfig{})
+
+	var fieldsErr *xyaml.UnknownFieldsError
+	require.ErrorAs(t, err, &fieldsErr)
+	require.Equal(t, []xyaml.UnknownField{
+		{Name: "coin", Path: "accounts[0].coin", Line: 5, Column: 5},
+		{Name: "coins_maxx", Path: "faucet.coins_maxx", Line: 7, Column: 3},
+		{Name: "bonded", Path: "validators.bob.bonded", Line: 13, Column: 5},
+		{Name: "ignored", Path: "ignored", Line: 16, Column: 1},
+	}, fieldsErr.Fields)
+	require.EqualError(t, err, `line 5, column 5: unknown field "accounts[0].coin"; `+
+		`line 7, column 3: unknown field "faucet.coins_maxx"; `+
+		`line 13, column 5: unknown field "validators.bob.bonded"; `+
+		`line 16, column 1: unknown field "ignored"`)
+}
+
+func TestCheckKnownFieldsWithoutUnknownFields(t *testing.T) {
+	input := `
+version: 1
+accounts:
+  - &alice
+    name: alice
+  - <<: *alice
+    coins: ["1stake"]
+`
+	var node yaml.Node
+	require.NoError(t, yaml.Unmarshal([]byte(input), &node))
+
+	require.NoError(t, xyaml.CheckKnownFields(&node, &testConfig{}))
+}
@@ -0,0 +1,180 @@
+package chain
+
+import (
+	"context"
+	"fmt"
+	"os/exec"
+
+	"golang.org/x/sync/errgroup"
+
+	chainconfig "github.com/ignite/cli/v29/ignite/config/chain"
+	"github.com/ignite/cli/v29/ignite/pkg/cache"
+	chaincmdrunner "github.com/ignite/cli/v29/ignite/pkg/chaincmd/runner"
+	"github.com/ignite/cli/v29/ignite/pkg/cliui/colors"
+	"github.com/ignite/cli/v29/ignite/pkg/cliui/icons"
+	"github.com/ignite/cli/v29/ignite/pkg/cliui/view/accountview"
+	"github.com/ignite/cli/v29/ignite/pkg/errors"
+	"github.com/ignite/cli/v29/ignite/pkg/events"
+	"github.com/ignite/cli/v29/ignite/pkg/xexec"
+	"github.com/ignite/cli/v29/ignite/pkg/xurl"
+)
+
+// attach serves the chain using a node that is already running, like a node started
+// by a Docker Compose stack, instead of building, initializing and starting the chain.
+// Code is generated from the proto files, the config accounts that define a mnemonic
+// are imported into the keyring and the faucet is served for the running node.
+func (c *Chain) attach(ctx context.Context, cacheStorage cache.Storage, skipProto, generateClients bool) error {
+	conf, err := c.Config()
+	if err != nil {
+		return &CannotBuildAppError{err}
+	}
+
+	if !skipProto {
+		if err := c.generateFromConfig(ctx, cacheStorage, generateClients); err != nil {
+			return &CannotBuildAppError{err}
+		}
+	}
+
+	// The chain binary is not built but it's required to run the chain commands
+	binary, err := c.Binary()
+	if err != nil {
+		return err
+	}
+
+	if _, err := xexec.ResolveAbsPath(binary); errors.Is(err, exec.ErrNotFound) {
+		return errors.Errorf(
+			"the %s binary is required to attach to a node, install it by running \"ignite chain build\"",
+			binary,
+		)
+	} else if err != nil {
+		return err
+	}
+
+	rpcAddr, err := xurl.HTTP(c.nodeAddress())
+	if err != nil {
+		return errors.Errorf("invalid node address format: %w", err)
+	}
+
+	c.ev.Send(fmt.Sprintf("Connecting to the node %s...", rpcAddr), events.ProgressUpdate())
+
+	commands, err := c.Commands(ctx)
+	if err != nil {
+		return err
+	}
+
+	status, err := commands.Status(ctx)
+	if err != nil {
+		return errors.Errorf("cannot connect to the node %s: %w", rpcAddr, err)
+	}
+
+	// The chain ID of the running node is used instead of the one defined in the config
+	c.setChainID(status.ChainID)
+
+	if commands, err = c.Commands(ctx); err != nil {
+		return err
+	}
+
+	if err := c.importAccounts(ctx, commands, conf); err != nil {
+		return err
+	}
+
+	accounts, err := commands.ListAccounts(ctx)
+	if err != nil {
+		return err
+	}
+
+	var view accountview.Accounts
+	for _, a := range accounts {
+		view = view.Append(accountview.NewAccount(a.Name, a.Address))
+	}
+
+	c.ev.SendView(view, events.ProgressFinish())
+
+	// keep the served config to be able to detect which values change
+	c.setServedConfig(conf)
+
+	return c.startAttached(ctx, conf, rpcAddr, status.ChainID)
+}
+
+// importAccounts imports the config accounts that define a mnemonic into the keyring.
+// Accounts that already exist in the keyring are not imported again.
+func (c *Chain) importAccounts(ctx context.Context, commands chaincmdrunner.Runner, cfg *chainconfig.Config) error {
+	for _, account := range cfg.Accounts {
+		if account.Mnemonic == "" {
+			continue
+		}
+
+		_, err := commands.AddAccount(
+			ctx,
+			account.Name,
+			account.Mnemonic,
+			account.CoinType,
+			account.AccountNumber,
+			account.AddressIndex,
+		)
+		if err != nil && !errors.Is(err, chaincmdrunner.ErrAccountAlreadyExists) {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// startAttached serves the faucet of a chain attached to a running node until the serve is canceled.
+func (c *Chain) startAttached(ctx context.Context, cfg *chainconfig.Config, rpcAddr, chainID string) error {
+	g, ctx := errgroup.WithContext(ctx)
+
+	// start the faucet if enabled.
+	faucet, err := c.Faucet(ctx)
+	isFaucetEnabled := !errors.Is(err, ErrFaucetIsNotEnabled)
+
+	if isFaucetEnabled {
+		if errors.Is(err, ErrFaucetAccountDoesNotExist) {
+			return &CannotBuildAppError{errors.Wrap(err, "faucet account doesn't exist, define its mnemonic to import it")}
+		}
+		if err != nil {
+			return err
+		}
+
+		g.Go(func() error {
+			return c.serveFaucet(ctx, faucet)
+		})
+	}
+
+	// the node is not managed by the serve so it runs until the serve is canceled
+	g.Go(func() error {
+		<-ctx.Done()
+		return ctx.Err()
+	})
+
+	c.ev.Send(
+		fmt.Sprintf("Attached to node: %s (%s)", rpcAddr, chainID),
+		events.Icon(icons.Earth),
+		events.ProgressFinish(),
+	)
+
+	if isFaucetEnabled {
+		faucetAddr, _ := xurl.HTTP(chainconfig.FaucetHost(cfg))
+
+		c.ev.Send(
+			fmt.Sprintf("Token faucet: %s", faucetAddr),
+			events.Icon(icons.Earth),
+		)
+	}
+
+	appHome, _ := c.Home()
+	appBin, _ := c.AbsBinaryPath()
+
+	c.ev.Send(
+		fmt.Sprintf("Keyring directory: %s", colors.Faint(appHome)),
+		events.Icon(icons.Bullet),
+		events.Group(EvtGroupPath),
+	)
+	c.ev.Send(
+		fmt.Sprintf("App binary: %s", colors.Faint(appBin)),
+		events.Icon(icons.Bullet),
+		events.Group(EvtGroupPath),
+	)
+
+	return g.Wait()
+}
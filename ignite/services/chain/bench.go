@@ -0,0 +1,264 @@
+package chain
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"strings"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+
+	chainconfig "github.com/ignite/cli/v29/ignite/config/chain"
+	"github.com/ignite/cli/v29/ignite/config/chain/base"
+	"github.com/ignite/cli/v29/ignite/pkg/cache"
+	"github.com/ignite/cli/v29/ignite/pkg/chainbench"
+	"github.com/ignite/cli/v29/ignite/pkg/cliui/icons"
+	"github.com/ignite/cli/v29/ignite/pkg/cosmosaccount"
+	"github.com/ignite/cli/v29/ignite/pkg/cosmosmsg"
+	"github.com/ignite/cli/v29/ignite/pkg/errors"
+	"github.com/ignite/cli/v29/ignite/pkg/events"
+	"github.com/ignite/cli/v29/ignite/pkg/xurl"
+)
+
+const (
+	// DefaultBenchAccounts is the default number of accounts that broadcast transactions concurrently.
+	DefaultBenchAccounts = 10
+
+	// benchAccountPrefix is the name prefix of the accounts created for the benchmark.
+	benchAccountPrefix = "bench"
+)
+
+// defaultBenchCoins are the coins given in genesis to each bench account by default.
+var defaultBenchCoins = []string{"1000000000000stake"}
+
+type (
+	// BenchOption configures the benchmark of a chain.
+	BenchOption func(*benchOptions)
+
+	benchOptions struct {
+		duration  time.Duration
+		accounts  int
+		pipeline  int
+		buildTags []string
+	}
+)
+
+// BenchDuration sets the time during which transactions are broadcasted.
+func BenchDuration(d time.Duration) BenchOption {
+	return func(o *benchOptions) {
+		o.duration = d
+	}
+}
+
+// BenchAccounts sets the number of accounts that broadcast transactions concurrently.
+// It overrides the number of accounts defined in the config.
+func BenchAccounts(n int) BenchOption {
+	return func(o *benchOptions) {
+		o.accounts = n
+	}
+}
+
+// BenchPipeline sets the number of transactions of each account that wait for their inclusion at the same time.
+func BenchPipeline(depth int) BenchOption {
+	return func(o *benchOptions) {
+		o.pipeline = depth
+	}
+}
+
+// BenchBuildTags sets the build tags used to build the chain binary.
+func BenchBuildTags(buildTags ...string) BenchOption {
+	return func(o *benchOptions) {
+		o.buildTags = buildTags
+	}
+}
+
+// Bench benchmarks the chain using dir as a throwaway workspace.
+// The chain is built, initialized like when it's served with additional funded
+// bench accounts and started. The bench accounts broadcast transactions
+// concurrently, which are bank sends or the messages defined in the "bench"
+// section of the config, and the performance of the chain is measured.
+func (c *Chain) Bench(ctx context.Context, cacheStorage cache.Storage, dir string, options ...BenchOption) (chainbench.Result, error) {
+	o := benchOptions{
+		duration: chainbench.DefaultDuration,
+		pipeline: chainbench.DefaultPipeline,
+	}
+	for _, apply := range options {
+		apply(&o)
+	}
+
+	cfg, err := c.Config()
+	if err != nil {
+		return chainbench.Result{}, err
+	}
+
+	if o.accounts == 0 {
+		o.accounts = cfg.Bench.Accounts
+	}
+	if o.accounts == 0 {
+		o.accounts = DefaultBenchAccounts
+	}
+	if o.accounts < 0 {
+		return chainbench.Result{}, errors.New("the number of bench accounts must be positive")
+	}
+	if o.duration <= 0 {
+		return chainbench.Result{}, errors.New("the bench duration must be positive")
+	}
+	if o.pipeline <= 0 {
+		return chainbench.Result{}, errors.New("the bench pipeline depth must be positive")
+	}
+	if len(cfg.Validators) == 0 {
+		return chainbench.Result{}, errors.New("at least one validator is required to run the benchmark")
+	}
+
+	c.SetHome(filepath.Join(dir, "home"))
+
+	c.ev.Send("Building the chain...", events.ProgressStart())
+
+	if err := c.buildBinaryTo(ctx, cacheStorage, o.buildTags, filepath.Join(dir, "bin")); err != nil {
+		return chainbench.Result{}, err
+	}
+
+	c.ev.Send("Initializing the chain...", events.ProgressStart())
+
+	names, err := c.initBench(ctx, cfg, o.accounts)
+	if err != nil {
+		return chainbench.Result{}, err
+	}
+
+	var result chainbench.Result
+	err = c.runNodes(ctx, cfg, func(ctx context.Context) error {
+		c.ev.Send("Waiting for the first block to start the benchmark...", events.ProgressStart())
+
+		client, prefix, err := c.nodeClient(ctx, cfg)
+		if err != nil {
+			return err
+		}
+
+		accounts := make([]cosmosaccount.Account, len(names))
+		for i, name := range names {
+			if accounts[i], err = client.AccountRegistry.GetByName(name); err != nil {
+				return err
+			}
+		}
+
+		newMsg, closeConn, err := benchMsgFactory(cfg)
+		if err != nil {
+			return err
+		}
+		defer closeConn()
+
+		c.ev.Send(
+			fmt.Sprintf("Broadcasting transactions from %d accounts during %s...", o.accounts, o.duration),
+			events.ProgressStart(),
+		)
+
+		result, err = chainbench.Run(
+			ctx,
+			client,
+			accounts,
+			prefix,
+			newMsg,
+			chainbench.WithDuration(o.duration),
+			chainbench.WithPipeline(o.pipeline),
+		)
+
+		return err
+	})
+	if err != nil {
+		return chainbench.Result{}, err
+	}
+
+	result.Commit = c.sourceVersion.hash
+
+	c.ev.Send(
+		fmt.Sprintf("Benchmark finished, %d transactions committed", result.TxsCommitted),
+		events.Icon(icons.OK),
+		events.ProgressFinish(),
+	)
+
+	return result, nil
+}
+
+// initBench initializes the chain like when it's served and adds the funded
+// bench accounts to the genesis. The names of the bench accounts are returned.
+func (c *Chain) initBench(ctx context.Context, cfg *chainconfig.Config, accounts int) ([]string, error) {
+	coins := benchCoins(cfg.Bench)
+
+	// The bench accounts are initialized with the accounts of the config
+	benchCfg := *cfg
+	benchCfg.Accounts = append([]base.Account(nil), cfg.Accounts...)
+
+	names := make([]string, accounts)
+	for i := range names {
+		names[i] = fmt.Sprintf("%s%d", benchAccountPrefix, i)
+		benchCfg.Accounts = append(benchCfg.Accounts, base.Account{Name: names[i], Coins: coins})
+	}
+
+	if err := c.InitChain(ctx, true, true); err != nil {
+		return nil, err
+	}
+
+	if err := c.InitAccounts(ctx, &benchCfg); err != nil {
+		return nil, err
+	}
+
+	return names, c.shareGenesis(cfg)
+}
+
+// benchMsgFactory returns the factory of the messages broadcasted by the bench accounts.
+// The messages defined in the config are decoded using the gRPC reflection service of
+// the node, so the returned function closes the connection to the node.
+func benchMsgFactory(cfg *chainconfig.Config) (chainbench.MsgFactory, func(), error) {
+	if len(cfg.Bench.Messages) == 0 {
+		amount := cfg.Bench.Amount
+		if len(amount) == 0 {
+			// Bank sends transfer the smallest amount of the first coin of the bench accounts
+			coin, err := sdk.ParseCoinNormalized(benchCoins(cfg.Bench)[0])
+			if err != nil {
+				return nil, nil, err
+			}
+			amount = []string{"1" + coin.Denom}
+		}
+
+		coins, err := sdk.ParseCoinsNormalized(strings.Join(amount, ","))
+		if err != nil {
+			return nil, nil, err
+		}
+
+		return chainbench.BankSend(coins), func() {}, nil
+	}
+
+	validator, err := chainconfig.FirstValidator(cfg)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	servers, err := validator.GetServers()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	conn, err := grpc.NewClient(xurl.Address(servers.GRPC.Address), grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	newMsg, err := chainbench.MsgTemplates(cosmosmsg.NewDecoder(conn), cfg.Bench.Messages)
+	if err != nil {
+		conn.Close()
+		return nil, nil, err
+	}
+
+	return newMsg, func() { conn.Close() }, nil
+}
+
+// benchCoins returns the coins given in genesis to each bench account.
+func benchCoins(b base.Bench) []string {
+	if len(b.Coins) == 0 {
+		return defaultBenchCoins
+	}
+	return b.Coins
+}
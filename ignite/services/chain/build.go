@@ -3,24 +3,28 @@ package chain
 import (
 	"context"
 	"fmt"
-	"io"
 	"os"
 	"path/filepath"
 	"runtime"
+	"slices"
+	"strconv"
 	"strings"
+	"time"
 
-	"github.com/moby/moby/pkg/archive"
+	"golang.org/x/sync/errgroup"
 
 	"github.com/ignite/cli/v29/ignite/pkg/cache"
 	"github.com/ignite/cli/v29/ignite/pkg/checksum"
 	"github.com/ignite/cli/v29/ignite/pkg/cmdrunner"
 	"github.com/ignite/cli/v29/ignite/pkg/cmdrunner/exec"
-	"github.com/ignite/cli/v29/ignite/pkg/cmdrunner/step"
 	"github.com/ignite/cli/v29/ignite/pkg/dirchange"
 	"github.com/ignite/cli/v29/ignite/pkg/errors"
 	"github.com/ignite/cli/v29/ignite/pkg/events"
 	"github.com/ignite/cli/v29/ignite/pkg/goanalysis"
 	"github.com/ignite/cli/v29/ignite/pkg/gocmd"
+	"github.com/ignite/cli/v29/ignite/pkg/xarchive"
+	"github.com/ignite/cli/v29/ignite/pkg/xgit"
+	"github.com/ignite/cli/v29/ignite/pkg/xos"
 	"github.com/ignite/cli/v29/ignite/pkg/xstrings"
 )
 
@@ -107,40 +111,130 @@ func (c *Chain) build(
 	return gocmd.BuildPath(ctx, output, binary, path, buildFlags)
 }
 
-// BuildRelease builds binaries for a release. targets is a list
-// of GOOS:GOARCH when provided. It defaults to your system when no targets provided.
-// prefix is used as prefix to tarballs containing each target.
+// BuildRelease builds binaries for a release. targets is a list of GOOS:GOARCH
+// when provided. It defaults to the release targets defined in the config, or
+// to your system when no targets are defined. The targets are built in parallel.
+// prefix is used as prefix to the archives containing each target.
+// Besides the archives, the release contains an SBOM of the chain, the build
+// provenance of the archives and a checksum file, which can be signed.
 func (c *Chain) BuildRelease(
 	ctx context.Context,
 	cacheStorage cache.Storage,
-	buildParams []string,
+	buildTags []string,
 	output, prefix string,
-	targets ...string,
+	targets []string,
+	options ...ReleaseOption,
 ) (releasePath string, err error) {
+	o := releaseOptions{parallel: runtime.NumCPU()}
+	for _, apply := range options {
+		apply(&o)
+	}
+
 	if prefix == "" {
 		prefix = c.app.Name
 	}
+
+	cfg, err := c.Config()
+	if err != nil {
+		return "", err
+	}
+
+	if len(targets) == 0 {
+		for _, t := range cfg.Release.Targets {
+			targets = append(targets, t.Target)
+		}
+	}
 	if len(targets) == 0 {
 		targets = []string{gocmd.BuildTarget(runtime.GOOS, runtime.GOARCH)}
 	}
 
+	rb := releaseBuild{
+		ldFlags: make(map[string][]string),
+		format:  o.format,
+		upgrade: o.upgrade,
+	}
+
+	if rb.upgrade != "" {
+		if strings.ContainsAny(rb.upgrade, `/\`) {
+			return "", errors.Errorf("invalid upgrade name %q, it can't contain path separators", rb.upgrade)
+		}
+
+		// The Cosmovisor directory tree is created for the current platform when possible
+		rb.upgradeTarget = targets[0]
+		if slices.Contains(targets, gocmd.BuildTarget(runtime.GOOS, runtime.GOARCH)) {
+			rb.upgradeTarget = gocmd.BuildTarget(runtime.GOOS, runtime.GOARCH)
+		}
+	}
+
+	for _, t := range cfg.Release.Targets {
+		rb.ldFlags[t.Target] = t.LDFlags
+	}
+
+	if rb.format == "" {
+		rb.format = cfg.Release.Format
+	}
+
+	if rb.format == "" {
+		rb.format = string(xarchive.FormatTarGz)
+	} else if rb.format != releaseFormatRaw {
+		if _, err := xarchive.ParseFormat(rb.format); err != nil {
+			return "", err
+		}
+	}
+
+	name := cfg.Release.Name
+	if name == "" {
+		name = defaultReleaseName
+	}
+
+	if rb.names, err = releaseNames(name, prefix, c.sourceVersion.tag, targets); err != nil {
+		return "", err
+	}
+
+	for _, f := range cfg.Release.Files {
+		path := filepath.Join(c.app.Path, f)
+		if _, err := os.Stat(path); err != nil {
+			return "", errors.Errorf("release file %s: %w", f, err)
+		}
+
+		rb.files = append(rb.files, path)
+	}
+
 	// prepare for build.
 	if err := c.setup(); err != nil {
 		return "", err
 	}
 
-	buildFlags, err := c.preBuild(ctx, cacheStorage, buildParams...)
-	if err != nil {
+	if rb.buildFlags, err = c.preBuild(ctx, cacheStorage, buildTags...); err != nil {
 		return "", err
 	}
 
-	binary, err := c.Binary()
-	if err != nil {
+	rb.sourceDate = time.Now()
+	if o.reproducible {
+		if rb.sourceDate, err = c.sourceDateEpoch(); err != nil {
+			return "", err
+		}
+
+		// Remove the local file system paths from the binaries
+		rb.buildFlags = append(rb.buildFlags, gocmd.FlagTrimpath)
+
+		isRepo, err := xgit.IsRepository(c.app.Path)
+		if err != nil {
+			return "", err
+		}
+
+		if isRepo {
+			rb.buildFlags = append(rb.buildFlags, gocmd.FlagBuildVCSTrue)
+		}
+
+		rb.buildEnv = append(rb.buildEnv, cmdrunner.Env(EnvSourceDateEpoch, strconv.FormatInt(rb.sourceDate.Unix(), 10)))
+	}
+
+	if rb.binary, err = c.Binary(); err != nil {
 		return "", err
 	}
 
-	mainPath, err := c.discoverMain(c.app.Path)
-	if err != nil {
+	if rb.mainPath, err = c.discoverMain(c.app.Path); err != nil {
 		return "", err
 	}
 
@@ -157,54 +251,69 @@ func (c *Chain) BuildRelease(
 		return "", err
 	}
 
-	for _, t := range targets {
-		// build binary for a target, tarball it and save it under the release dir.
-		goos, goarch, err := gocmd.ParseTarget(t)
-		if err != nil {
-			return "", err
-		}
+	rb.releasePath = releasePath
 
-		out, err := os.MkdirTemp("", "")
-		if err != nil {
-			return "", err
-		}
-		defer os.RemoveAll(out)
+	// build the targets in parallel and save their archives under the release dir.
+	artifacts := make([]string, len(targets))
+	g, gCtx := errgroup.WithContext(ctx)
+	g.SetLimit(max(o.parallel, 1))
 
-		buildOptions := []exec.Option{
-			exec.StepOption(step.Env(
-				cmdrunner.Env(gocmd.EnvGOOS, goos),
-				cmdrunner.Env(gocmd.EnvGOARCH, goarch),
-			)),
-		}
+	for i, t := range targets {
+		i, t := i, t
 
-		if err := gocmd.BuildPath(ctx, out, binary, mainPath, buildFlags, buildOptions...); err != nil {
-			return "", err
-		}
+		g.Go(func() error {
+			path, err := c.buildReleaseTarget(gCtx, rb, t)
+			if err != nil {
+				return errors.Errorf("cannot build release target %s: %w", t, err)
+			}
 
-		tarr, err := archive.Tar(out, archive.Gzip)
-		if err != nil {
-			return "", err
-		}
+			artifacts[i] = path
 
-		tarName := fmt.Sprintf("%s_%s_%s.tar.gz", prefix, goos, goarch)
-		tarPath := filepath.Join(releasePath, tarName)
+			return nil
+		})
+	}
 
-		tarf, err := os.Create(tarPath)
-		if err != nil {
-			return "", err
+	if err := g.Wait(); err != nil {
+		return "", err
+	}
+
+	// raw releases don't bundle the extra files with the binaries
+	if rb.format == releaseFormatRaw {
+		for _, f := range rb.files {
+			if err := xos.CopyFile(f, filepath.Join(releasePath, filepath.Base(f))); err != nil {
+				return "", err
+			}
 		}
-		defer tarf.Close()
+	}
 
-		if _, err := io.Copy(tarf, tarr); err != nil {
+	if rb.upgrade != "" {
+		if err := writeUpgradeInfo(releasePath, o.upgradeURL, targets, artifacts); err != nil {
 			return "", err
 		}
-		tarf.Close()
+	}
+
+	if err := c.writeReleaseSBOM(releasePath, rb.sourceDate); err != nil {
+		return "", err
+	}
+
+	if err := c.writeReleaseProvenance(releasePath, artifacts, buildTags, targets, o.reproducible); err != nil {
+		return "", err
 	}
 
 	checksumPath := filepath.Join(releasePath, releaseChecksumKey)
 
 	// create a checksum.txt and return with the path to release dir.
-	return releasePath, checksum.Sum(releasePath, checksumPath)
+	if err := checksum.Sum(releasePath, checksumPath); err != nil {
+		return "", err
+	}
+
+	if o.signKey != "" {
+		if _, err := checksum.Sign(checksumPath, o.signKey); err != nil {
+			return "", err
+		}
+	}
+
+	return releasePath, nil
 }
 
 func (c *Chain) preBuild(
@@ -2,8 +2,10 @@ package chain
 
 import (
 	"context"
+	"fmt"
 	"os"
 	"path/filepath"
+	"sync"
 
 	"github.com/go-git/go-git/v5"
 	"github.com/spf13/cobra"
@@ -39,10 +41,18 @@ type (
 
 		Version cosmosver.Version
 
-		sourceVersion  version
-		serveCancel    context.CancelFunc
-		serveRefresher chan struct{}
-		served         bool
+		sourceVersion   version
+		serveCancel     context.CancelFunc
+		serveRefresher  chan struct{}
+		faucetRefresher chan struct{}
+		served          bool
+
+		// mu protects the served config and the options changed while serving,
+		// which are also read by the config watcher and the faucet.
+		mu sync.RWMutex
+
+		// servedConfig is the config used by the chain being served.
+		servedConfig *chainconfig.Config
 
 		ev          events.Bus
 		logOutputer uilog.Outputer
@@ -72,6 +82,17 @@ type (
 
 		// path of a custom config file
 		ConfigFile string
+
+		// configProfile is the name of the config profile to use
+		configProfile string
+
+		// nodeAddress is the RPC address of a running node used by the commands
+		// instead of the address of the first validator.
+		nodeAddress string
+
+		// binaryPath is the path of the binary used by the commands instead
+		// of the app binary installed in the system.
+		binaryPath string
 	}
 
 	version struct {
@@ -111,6 +132,13 @@ func ConfigFile(configFile string) Option {
 	}
 }
 
+// ConfigProfile specifies the config profile to use.
+func ConfigProfile(profile string) Option {
+	return func(c *Chain) {
+		c.options.configProfile = profile
+	}
+}
+
 // WithOutputer sets the CLI outputer for the chain.
 func WithOutputer(s uilog.Outputer) Option {
 	return func(c *Chain) {
@@ -149,6 +177,14 @@ func PrintGeneratedPaths() Option {
 	}
 }
 
+// BinaryPath specifies the path of the binary used to run the chain commands
+// instead of the app binary installed in the system.
+func BinaryPath(path string) Option {
+	return func(c *Chain) {
+		c.options.binaryPath = path
+	}
+}
+
 // New initializes a new Chain with options that its source lives at path.
 func New(path string, options ...Option) (*Chain, error) {
 	app, err := NewAppAt(path)
@@ -157,8 +193,9 @@ func New(path string, options ...Option) (*Chain, error) {
 	}
 
 	c := &Chain{
-		app:            app,
-		serveRefresher: make(chan struct{}, 1),
+		app:             app,
+		serveRefresher:  make(chan struct{}, 1),
+		faucetRefresher: make(chan struct{}, 1),
 	}
 
 	// Apply the options
@@ -258,14 +295,67 @@ func (c *Chain) Config() (*chainconfig.Config, error) {
 	if configPath == "" {
 		return chainconfig.DefaultChainConfig(), nil
 	}
-	return chainconfig.ParseFile(configPath)
+	return chainconfig.ParseFile(configPath, chainconfig.WithProfile(c.options.configProfile))
+}
+
+// ConfigProfilePath returns the path of the profile file of the chain config.
+// Empty string means that the chain doesn't use a profile file.
+func (c *Chain) ConfigProfilePath() string {
+	configPath := c.ConfigPath()
+	if configPath == "" || c.options.configProfile == "" {
+		return ""
+	}
+
+	path := chainconfig.ProfilePath(configPath, c.options.configProfile)
+	if _, err := os.Stat(path); err != nil {
+		return ""
+	}
+	return path
+}
+
+// nodeAddress returns the RPC address of the running node used by the commands.
+func (c *Chain) nodeAddress() string {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.options.nodeAddress
+}
+
+// setNodeAddress sets the RPC address of the running node used by the commands.
+func (c *Chain) setNodeAddress(address string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.options.nodeAddress = address
+}
+
+// setChainID replaces the chain's id while the chain is served.
+func (c *Chain) setChainID(id string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.options.chainID = id
+}
+
+// getServedConfig returns the config used by the chain being served.
+func (c *Chain) getServedConfig() *chainconfig.Config {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.servedConfig
+}
+
+// setServedConfig keeps the config used by the chain being served.
+func (c *Chain) setServedConfig(conf *chainconfig.Config) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.servedConfig = conf
 }
 
 // ID returns the chain's id.
 func (c *Chain) ID() (string, error) {
 	// chainID in App has the most priority.
-	if c.options.chainID != "" {
-		return c.options.chainID, nil
+	c.mu.RLock()
+	chainID := c.options.chainID
+	c.mu.RUnlock()
+	if chainID != "" {
+		return chainID, nil
 	}
 
 	// otherwise uses defined in config.yml
@@ -337,6 +427,46 @@ func (c *Chain) Home() (string, error) {
 	return home, nil
 }
 
+// ValidatorHome returns the home directory of the validator at the given index.
+// The first validator always uses the chain's home directory. The other validators
+// use the home defined in their config or, when it's not defined, a directory next
+// to the chain's home named after the validator.
+func (c *Chain) ValidatorHome(index int) (string, error) {
+	if index == 0 {
+		return c.Home()
+	}
+
+	validator, err := c.validator(index)
+	if err != nil {
+		return "", err
+	}
+
+	if validator.Home != "" {
+		return os.ExpandEnv(validator.Home), nil
+	}
+
+	home, err := c.Home()
+	if err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf("%s-%s", home, validator.Name), nil
+}
+
+// validator returns the config of the validator at the given index.
+func (c *Chain) validator(index int) (chainconfig.Validator, error) {
+	cfg, err := c.Config()
+	if err != nil {
+		return chainconfig.Validator{}, err
+	}
+
+	if index < 0 || index >= len(cfg.Validators) {
+		return chainconfig.Validator{}, errors.Errorf("validator with index %d is not defined in the config", index)
+	}
+
+	return cfg.Validators[index], nil
+}
+
 // AppPath returns the configured App's path.
 func (c *Chain) AppPath() string {
 	return c.app.Path
@@ -456,12 +586,60 @@ func (c *Chain) KeyringBackend() (chaincmd.KeyringBackend, error) {
 
 // Commands returns the runner execute commands on the chain's binary.
 func (c *Chain) Commands(ctx context.Context) (chaincmdrunner.Runner, error) {
-	id, err := c.ID()
+	home, err := c.Home()
 	if err != nil {
 		return chaincmdrunner.Runner{}, err
 	}
 
-	home, err := c.Home()
+	cfg, err := c.Config()
+	if err != nil {
+		return chaincmdrunner.Runner{}, err
+	}
+
+	servers := chainconfigv1.DefaultServers()
+	if len(cfg.Validators) > 0 {
+		validator, _ := chainconfig.FirstValidator(cfg)
+		servers, err = validator.GetServers()
+		if err != nil {
+			return chaincmdrunner.Runner{}, err
+		}
+	}
+
+	return c.commands(ctx, home, servers, c.app.D())
+}
+
+// ValidatorCommands returns the runner to execute commands on the chain's binary
+// using the home directory and the servers of the validator at the given index.
+func (c *Chain) ValidatorCommands(ctx context.Context, index int) (chaincmdrunner.Runner, error) {
+	if index == 0 {
+		return c.Commands(ctx)
+	}
+
+	validator, err := c.validator(index)
+	if err != nil {
+		return chaincmdrunner.Runner{}, err
+	}
+
+	home, err := c.ValidatorHome(index)
+	if err != nil {
+		return chaincmdrunner.Runner{}, err
+	}
+
+	servers, err := validator.GetServers()
+	if err != nil {
+		return chaincmdrunner.Runner{}, err
+	}
+
+	return c.commands(ctx, home, servers, fmt.Sprintf("%s-%s", c.app.D(), validator.Name))
+}
+
+func (c *Chain) commands(
+	ctx context.Context,
+	home string,
+	servers chainconfigv1.Servers,
+	outputPrefix string,
+) (chaincmdrunner.Runner, error) {
+	id, err := c.ID()
 	if err != nil {
 		return chaincmdrunner.Runner{}, err
 	}
@@ -475,27 +653,21 @@ func (c *Chain) Commands(ctx context.Context) (chaincmdrunner.Runner, error) {
 	// find the binary path when the Go bin path is not part
 	// of the PATH environment variable.
 	binary = xexec.TryResolveAbsPath(binary)
-
-	backend, err := c.KeyringBackend()
-	if err != nil {
-		return chaincmdrunner.Runner{}, err
+	if c.options.binaryPath != "" {
+		binary = c.options.binaryPath
 	}
 
-	cfg, err := c.Config()
+	backend, err := c.KeyringBackend()
 	if err != nil {
 		return chaincmdrunner.Runner{}, err
 	}
 
-	servers := chainconfigv1.DefaultServers()
-	if len(cfg.Validators) > 0 {
-		validator, _ := chainconfig.FirstValidator(cfg)
-		servers, err = validator.GetServers()
-		if err != nil {
-			return chaincmdrunner.Runner{}, err
-		}
+	rpcAddress := servers.RPC.Address
+	if nodeAddress := c.nodeAddress(); nodeAddress != "" {
+		rpcAddress = nodeAddress
 	}
 
-	nodeAddr, err := xurl.TCP(servers.RPC.Address)
+	nodeAddr, err := xurl.TCP(rpcAddress)
 	if err != nil {
 		return chaincmdrunner.Runner{}, err
 	}
@@ -514,7 +686,7 @@ func (c *Chain) Commands(ctx context.Context) (chaincmdrunner.Runner, error) {
 
 	// Enable command output only when CLI verbosity is enabled
 	if c.logOutputer != nil && c.logOutputer.Verbosity() == uilog.VerbosityVerbose {
-		out := c.logOutputer.NewOutput(c.app.D(), colors.Cyan)
+		out := c.logOutputer.NewOutput(outputPrefix, colors.Cyan)
 		ccrOptions = append(
 			ccrOptions,
 			chaincmdrunner.Stdout(out.Stdout()),
@@ -0,0 +1,34 @@
+package chain
+
+import (
+	"os"
+	"path/filepath"
+
+	"github.com/ignite/cli/v29/ignite/pkg/cosmoslint"
+	"github.com/ignite/cli/v29/ignite/pkg/errors"
+	"github.com/ignite/cli/v29/ignite/pkg/events"
+)
+
+// CheckDeterminism checks the state machine code of the chain modules for non-deterministic
+// code. Issues are filtered using the allowlist file, or the default allowlist file of the
+// app directory when the path is empty and the file exists.
+func (c *Chain) CheckDeterminism(allowlistPath string) ([]cosmoslint.Issue, error) {
+	if allowlistPath == "" {
+		defaultPath := filepath.Join(c.app.Path, cosmoslint.DefaultAllowlistFile)
+		if _, err := os.Stat(defaultPath); err == nil {
+			allowlistPath = defaultPath
+		}
+	}
+
+	var allowlist cosmoslint.Allowlist
+	if allowlistPath != "" {
+		var err error
+		if allowlist, err = cosmoslint.LoadAllowlist(allowlistPath); err != nil {
+			return nil, errors.Errorf("failed to load allowlist: %w", err)
+		}
+	}
+
+	c.ev.Send("Checking the state machine code for non-determinism...", events.ProgressUpdate())
+
+	return cosmoslint.CheckDeterminism(c.app.Path, allowlist)
+}
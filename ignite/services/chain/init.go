@@ -1,19 +1,27 @@
 package chain
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
+	"fmt"
 	"os"
 	"path/filepath"
 	"strings"
 
 	"github.com/imdario/mergo"
+	"github.com/otiai10/copy"
 
 	chainconfig "github.com/ignite/cli/v29/ignite/config/chain"
+	"github.com/ignite/cli/v29/ignite/config/chain/base"
 	plugininternal "github.com/ignite/cli/v29/ignite/internal/plugin"
 	chaincmdrunner "github.com/ignite/cli/v29/ignite/pkg/chaincmd/runner"
 	"github.com/ignite/cli/v29/ignite/pkg/cliui/view/accountview"
 	"github.com/ignite/cli/v29/ignite/pkg/confile"
+	"github.com/ignite/cli/v29/ignite/pkg/cosmosutil"
+	"github.com/ignite/cli/v29/ignite/pkg/errors"
 	"github.com/ignite/cli/v29/ignite/pkg/events"
+	"github.com/ignite/cli/v29/ignite/pkg/jsonpatch"
 )
 
 type (
@@ -30,6 +38,13 @@ type (
 
 const (
 	moniker = "mynode"
+
+	// genesisFile is the path of the genesis file relative to the node's home.
+	genesisFile = "config/genesis.json"
+
+	// genesisValidatorsDir is the directory inside the node's home where the
+	// homes of the validators that are only bonded at genesis are initialized.
+	genesisValidatorsDir = "genesis-validators"
 )
 
 var (
@@ -66,44 +81,54 @@ func (c *Chain) Init(ctx context.Context, args InitArgs) error {
 }
 
 // InitChain initializes the chain.
+// When more than one validator is defined in the config a home directory
+// is initialized for each one of them.
 func (c *Chain) InitChain(ctx context.Context, initConfiguration, initGenesis bool) error {
 	chainID, err := c.ID()
 	if err != nil {
 		return err
 	}
 
-	// cleanup persistent data from previous `serve`.
-	home, err := c.Home()
+	conf, err := c.Config()
 	if err != nil {
 		return err
 	}
-	if err := os.RemoveAll(home); err != nil {
-		return err
-	}
 
-	commands, err := c.Commands(ctx)
-	if err != nil {
-		return err
-	}
+	// the first node is always initialized even when there are no validators
+	// defined in the config, for example when the config is used for network genesis.
+	nodeCount := max(len(conf.Validators), 1)
 
-	// init node.
-	if err := commands.Init(ctx, moniker); err != nil {
-		return err
-	}
+	for i := 0; i < nodeCount; i++ {
+		// cleanup persistent data from previous `serve`.
+		home, err := c.ValidatorHome(i)
+		if err != nil {
+			return err
+		}
+		if err := os.RemoveAll(home); err != nil {
+			return err
+		}
 
-	var conf *chainconfig.Config
-	if initConfiguration || initGenesis {
-		conf, err = c.Config()
+		commands, err := c.ValidatorCommands(ctx, i)
 		if err != nil {
 			return err
 		}
-	}
 
-	// ovewrite app config files with the values defined in Ignite's config file
-	if initConfiguration {
-		if err := c.Configure(home, conf); err != nil {
+		// init node.
+		if err := commands.Init(ctx, validatorMoniker(conf, i)); err != nil {
 			return err
 		}
+
+		// ovewrite app config files with the values defined in Ignite's config file
+		if initConfiguration {
+			if i == 0 {
+				err = c.Configure(home, conf)
+			} else {
+				err = c.ConfigureValidator(home, conf.Validators[i])
+			}
+			if err != nil {
+				return err
+			}
+		}
 	}
 
 	if initGenesis {
@@ -130,10 +155,20 @@ func (c *Chain) InitAccounts(ctx context.Context, cfg *chainconfig.Config) error
 
 	c.ev.Send("Initializing accounts...", events.ProgressUpdate())
 
-	var accounts accountview.Accounts
+	var (
+		accounts       accountview.Accounts
+		moduleAccounts []base.Account
+		addressPrefix  string
+	)
 
 	// add accounts from config into genesis
 	for _, account := range cfg.Accounts {
+		// Module account addresses are derived once the address prefix is known
+		if account.Module != "" {
+			moduleAccounts = append(moduleAccounts, account)
+			continue
+		}
+
 		var generatedAccount chaincmdrunner.Account
 		accountAddress := account.Address
 
@@ -153,11 +188,16 @@ func (c *Chain) InitAccounts(ctx context.Context, cfg *chainconfig.Config) error
 			accountAddress = generatedAccount.Address
 		}
 
-		coins := strings.Join(account.Coins, ",")
-		if err := commands.AddGenesisAccount(ctx, accountAddress, coins); err != nil {
+		if err := c.addGenesisAccount(ctx, commands, account, accountAddress); err != nil {
 			return err
 		}
 
+		if addressPrefix == "" {
+			if addressPrefix, err = cosmosutil.GetAddressPrefix(accountAddress); err != nil {
+				return err
+			}
+		}
+
 		if account.Address == "" {
 			accounts = accounts.Append(accountview.NewAccount(
 				generatedAccount.Name,
@@ -169,6 +209,46 @@ func (c *Chain) InitAccounts(ctx context.Context, cfg *chainconfig.Config) error
 		}
 	}
 
+	for _, account := range moduleAccounts {
+		if addressPrefix == "" {
+			return errors.New("module accounts require at least one other account to know the address prefix")
+		}
+
+		accountAddress, err := cosmosutil.ModuleAddress(account.Module, addressPrefix)
+		if err != nil {
+			return err
+		}
+
+		if err := c.addGenesisAccount(ctx, commands, account, accountAddress); err != nil {
+			return err
+		}
+
+		accounts = accounts.Append(accountview.NewAccount(account.Name, accountAddress))
+	}
+
+	// add the accounts of the validators bonded at genesis
+	for _, validator := range cfg.GenesisValidators {
+		account, err := commands.AddAccount(ctx, validator.Name, "", "", "", "")
+		if err != nil {
+			return err
+		}
+
+		coins := validator.Coins
+		if len(coins) == 0 {
+			coins = []string{validator.Bonded}
+		}
+
+		if err := commands.AddGenesisAccount(ctx, account.Address, strings.Join(coins, ",")); err != nil {
+			return err
+		}
+
+		accounts = accounts.Append(accountview.NewAccount(
+			account.Name,
+			account.Address,
+			accountview.WithMnemonic(account.Mnemonic),
+		))
+	}
+
 	c.ev.SendView(accounts, events.ProgressFinish())
 
 	// 0 length validator set when using network config
@@ -180,14 +260,19 @@ func (c *Chain) InitAccounts(ctx context.Context, cfg *chainconfig.Config) error
 		if err != nil {
 			return err
 		}
-	} else {
+	} else if len(cfg.Validators) == 1 && len(cfg.GenesisValidators) == 0 {
 		// Sovereign chain writes validators in gentxs.
-		_, err := c.IssueGentx(ctx, createValidatorFromConfig(cfg))
+		_, err := c.IssueGentx(ctx, createValidatorFromConfig(cfg.Validators[0]))
 		if err != nil {
 			return err
 		}
+	} else {
+		// Sovereign chain with multiple validators writes a gentx for each one of them.
+		if err := c.IssueValidatorGentxs(ctx, cfg); err != nil {
+			return err
+		}
 	}
-	return nil
+	return c.ApplyGenesisPatches(ctx, cfg)
 }
 
 // IssueGentx generates a gentx from the validator information in chain config and imports it in the chain genesis.
@@ -207,6 +292,184 @@ func (c Chain) IssueGentx(ctx context.Context, v Validator) (string, error) {
 	return gentxPath, commands.CollectGentxs(ctx)
 }
 
+// IssueValidatorGentxs generates a gentx for each validator and genesis validator defined
+// in the chain config and imports all of them in the genesis of the first validator.
+// The resulting genesis is then copied to the home of every other validator and the
+// nodes are configured to use each other as persistent peers.
+func (c Chain) IssueValidatorGentxs(ctx context.Context, cfg *chainconfig.Config) error {
+	commands, err := c.Commands(ctx)
+	if err != nil {
+		return err
+	}
+
+	home, err := c.Home()
+	if err != nil {
+		return err
+	}
+
+	genesisPath, err := c.GenesisPath()
+	if err != nil {
+		return err
+	}
+
+	gentxsPath, err := c.GentxsPath()
+	if err != nil {
+		return err
+	}
+
+	c.ev.Send("Issuing validator gentxs...", events.ProgressUpdate())
+
+	for i, validator := range cfg.Validators {
+		if i == 0 {
+			if _, err := c.Gentx(ctx, commands, createValidatorFromConfig(validator)); err != nil {
+				return err
+			}
+
+			continue
+		}
+
+		validatorHome, err := c.ValidatorHome(i)
+		if err != nil {
+			return err
+		}
+
+		// the validator keys were created in the keyring of the first validator,
+		// the keyring is shared to be able to sign the gentx from the validator's home.
+		if err := copyKeyring(home, validatorHome); err != nil {
+			return err
+		}
+
+		if err := copy.Copy(genesisPath, filepath.Join(validatorHome, genesisFile)); err != nil {
+			return err
+		}
+
+		validatorCommands, err := c.ValidatorCommands(ctx, i)
+		if err != nil {
+			return err
+		}
+
+		gentxPath, err := c.Gentx(ctx, validatorCommands, createValidatorFromConfig(validator))
+		if err != nil {
+			return err
+		}
+
+		if err := copy.Copy(gentxPath, filepath.Join(gentxsPath, filepath.Base(gentxPath))); err != nil {
+			return err
+		}
+	}
+
+	for _, validator := range cfg.GenesisValidators {
+		gentxPath, err := c.genesisValidatorGentx(ctx, validator)
+		if err != nil {
+			return err
+		}
+
+		if err := copy.Copy(gentxPath, filepath.Join(gentxsPath, filepath.Base(gentxPath))); err != nil {
+			return err
+		}
+	}
+
+	// import the gentxs into the genesis
+	if err := commands.CollectGentxs(ctx); err != nil {
+		return err
+	}
+
+	if len(cfg.Validators) == 1 {
+		return nil
+	}
+
+	// share the final genesis with all the validators
+	if err := c.shareGenesis(cfg); err != nil {
+		return err
+	}
+
+	return c.configurePeers(ctx, cfg)
+}
+
+// shareGenesis copies the genesis of the first validator to the home of the other validators.
+func (c Chain) shareGenesis(cfg *chainconfig.Config) error {
+	genesisPath, err := c.GenesisPath()
+	if err != nil {
+		return err
+	}
+
+	for i := 1; i < len(cfg.Validators); i++ {
+		validatorHome, err := c.ValidatorHome(i)
+		if err != nil {
+			return err
+		}
+
+		if err := copy.Copy(genesisPath, filepath.Join(validatorHome, genesisFile)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// genesisValidatorGentx generates the gentx of a validator that is bonded at genesis
+// but that is not started as a node. A home directory is initialized for the validator
+// inside the home of the first validator to keep the validator consensus key.
+func (c Chain) genesisValidatorGentx(ctx context.Context, validator chainconfig.GenesisValidator) (string, error) {
+	home, err := c.Home()
+	if err != nil {
+		return "", err
+	}
+
+	genesisPath, err := c.GenesisPath()
+	if err != nil {
+		return "", err
+	}
+
+	cfg, err := c.Config()
+	if err != nil {
+		return "", err
+	}
+
+	firstValidator, err := chainconfig.FirstValidator(cfg)
+	if err != nil {
+		return "", err
+	}
+
+	servers, err := firstValidator.GetServers()
+	if err != nil {
+		return "", err
+	}
+
+	validatorHome := filepath.Join(home, genesisValidatorsDir, validator.Name)
+	commands, err := c.commands(ctx, validatorHome, servers, fmt.Sprintf("%s-%s", c.app.D(), validator.Name))
+	if err != nil {
+		return "", err
+	}
+
+	nodeMoniker := validator.Moniker
+	if nodeMoniker == "" {
+		nodeMoniker = validator.Name
+	}
+
+	// init the node to create the validator consensus key
+	if err := commands.Init(ctx, nodeMoniker); err != nil {
+		return "", err
+	}
+
+	// the validator key was created in the keyring of the first validator,
+	// the keyring is shared to be able to sign the gentx from the validator's home.
+	if err := copyKeyring(home, validatorHome); err != nil {
+		return "", err
+	}
+
+	if err := copy.Copy(genesisPath, filepath.Join(validatorHome, genesisFile)); err != nil {
+		return "", err
+	}
+
+	return c.Gentx(ctx, commands, Validator{
+		Name:           validator.Name,
+		Moniker:        nodeMoniker,
+		StakingAmount:  validator.Bonded,
+		CommissionRate: validator.CommissionRate,
+	})
+}
+
 // IsInitialized checks if the chain is initialized.
 // The check is performed by checking if the gentx dir exists in the config,
 // unless c is a consumer chain, in which case the check relies on checking if
@@ -224,9 +487,26 @@ func (c *Chain) IsInitialized() (bool, error) {
 		return plugininternal.ConsumerIsInitialized(context.Background(), c)
 	}
 
+	// every validator home must contain a gentx dir
+	for i := 1; i < len(cfg.Validators); i++ {
+		validatorHome, err := c.ValidatorHome(i)
+		if err != nil {
+			return false, err
+		}
+
+		if ok, err := hasGentxDir(validatorHome); !ok || err != nil {
+			return false, err
+		}
+	}
+
+	return hasGentxDir(home)
+}
+
+func hasGentxDir(home string) (bool, error) {
 	gentxDir := filepath.Join(home, "config", "gentx")
 
-	if _, err := os.Stat(gentxDir); os.IsNotExist(err) {
+	_, err := os.Stat(gentxDir)
+	if os.IsNotExist(err) {
 		return false, nil
 	}
 	if err != nil {
@@ -258,6 +538,56 @@ func (c Chain) UpdateGenesisFile(data map[string]interface{}) error {
 	return cf.Save(genesis)
 }
 
+// ApplyGenesisPatches applies the genesis patches defined in the chain config to the
+// genesis and validates the result. The patched genesis is shared with all the validators.
+func (c Chain) ApplyGenesisPatches(ctx context.Context, cfg *chainconfig.Config) error {
+	if len(cfg.GenesisPatches) == 0 {
+		return nil
+	}
+
+	c.ev.Send("Applying genesis patches...", events.ProgressUpdate())
+
+	path, err := c.GenesisPath()
+	if err != nil {
+		return err
+	}
+
+	genesis, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+
+	patches := make([]jsonpatch.Patch, len(cfg.GenesisPatches))
+	for i, p := range cfg.GenesisPatches {
+		patches[i] = p.JSONPatch()
+	}
+
+	genesis, err = jsonpatch.Apply(genesis, patches...)
+	if err != nil {
+		return errors.Errorf("failed to apply genesis patches: %w", err)
+	}
+
+	var buf bytes.Buffer
+	if err := json.Indent(&buf, genesis, "", "  "); err != nil {
+		return err
+	}
+
+	if err := os.WriteFile(path, buf.Bytes(), 0o644); err != nil {
+		return err
+	}
+
+	commands, err := c.Commands(ctx)
+	if err != nil {
+		return err
+	}
+
+	if err := commands.ValidateGenesis(ctx); err != nil {
+		return errors.Errorf("genesis is invalid after applying the genesis patches: %w", err)
+	}
+
+	return c.shareGenesis(cfg)
+}
+
 type Validator struct {
 	Name                    string
 	Moniker                 string
@@ -282,9 +612,7 @@ type Account struct {
 	Coins    string
 }
 
-func createValidatorFromConfig(conf *chainconfig.Config) (validator Validator) {
-	// Currently, we support the config file with one valid validator.
-	validatorFromConfig := conf.Validators[0]
+func createValidatorFromConfig(validatorFromConfig chainconfig.Validator) (validator Validator) {
 	validator.Name = validatorFromConfig.Name
 	validator.StakingAmount = validatorFromConfig.Bonded
 
@@ -325,3 +653,34 @@ func createValidatorFromConfig(conf *chainconfig.Config) (validator Validator) {
 	}
 	return validator
 }
+
+// validatorMoniker returns the moniker to use when initializing the node of the validator at the given index.
+func validatorMoniker(conf *chainconfig.Config, index int) string {
+	if index == 0 || index >= len(conf.Validators) {
+		return moniker
+	}
+
+	validator := conf.Validators[index]
+	if validator.Gentx != nil && validator.Gentx.Moniker != "" {
+		return validator.Gentx.Moniker
+	}
+
+	return validator.Name
+}
+
+// copyKeyring copies the keyring directories from one home to another.
+// Keyrings that use the OS backend don't have a directory and are not copied.
+func copyKeyring(fromHome, toHome string) error {
+	dirs, err := filepath.Glob(filepath.Join(fromHome, "keyring-*"))
+	if err != nil {
+		return err
+	}
+
+	for _, dir := range dirs {
+		if err := copy.Copy(dir, filepath.Join(toHome, filepath.Base(dir))); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -1,20 +1,241 @@
 package chain
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
 	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
 
 	"github.com/ignite/cli/v29/ignite/pkg/cmdrunner/exec"
+	"github.com/ignite/cli/v29/ignite/pkg/cmdrunner/step"
+	"github.com/ignite/cli/v29/ignite/pkg/cosmosbuf"
+	"github.com/ignite/cli/v29/ignite/pkg/cosmoslint"
 	"github.com/ignite/cli/v29/ignite/pkg/errors"
+	"github.com/ignite/cli/v29/ignite/pkg/events"
+	"github.com/ignite/cli/v29/ignite/pkg/xgit"
 )
 
-var golangCiLintVersion = "latest"
+const (
+	// DefaultGolangCILintVersion is the golangci-lint version used when the config doesn't define one.
+	DefaultGolangCILintVersion = "v1.57.2"
 
-// Lint runs the linting process for the chain.
-// It uses golangci-lint to lint the chain's codebase.
-func (c *Chain) Lint(ctx context.Context) error {
-	if err := exec.Exec(ctx, []string{"go", "install", fmt.Sprintf("github.com/golangci/golangci-lint/cmd/golangci-lint@%s", golangCiLintVersion)}); err != nil {
-		return errors.Errorf("failed to install golangci-lint: %w", err)
+	golangCILintPkg  = "github.com/golangci/golangci-lint/cmd/golangci-lint"
+	golangCILintTool = "golangci-lint"
+	bufTool          = "buf"
+)
+
+type (
+	// LintOption configures the linting of the chain.
+	LintOption func(*lintOptions)
+
+	lintOptions struct {
+		skipProto       bool
+		breakingAgainst string
+	}
+
+	// golangCILintReport is the JSON report of golangci-lint.
+	golangCILintReport struct {
+		Issues []struct {
+			FromLinter string
+			Text       string
+			Pos        struct {
+				Filename string
+				Line     int
+				Column   int
+			}
+		}
+	}
+)
+
+// LintSkipProto disables the linting of the proto files.
+func LintSkipProto() LintOption {
+	return func(o *lintOptions) {
+		o.skipProto = true
+	}
+}
+
+// LintBreakingAgainst checks the proto files for breaking changes against a git ref
+// instead of the ref defined in the config.
+func LintBreakingAgainst(ref string) LintOption {
+	return func(o *lintOptions) {
+		o.breakingAgainst = ref
+	}
+}
+
+// Lint runs the linting process for the chain and returns the issues found.
+// The Go code is linted with the golangci-lint version and config file defined
+// in the config. The proto files are linted with buf, which also checks them for
+// breaking changes when a git ref is defined to compare against. Finally, the
+// Cosmos analyzers check the modules for code that is unsafe in the state machine.
+func (c *Chain) Lint(ctx context.Context, options ...LintOption) ([]cosmoslint.Issue, error) {
+	var o lintOptions
+	for _, apply := range options {
+		apply(&o)
+	}
+
+	cfg, err := c.Config()
+	if err != nil {
+		return nil, err
+	}
+
+	if o.breakingAgainst == "" {
+		o.breakingAgainst = cfg.Lint.BreakingAgainst
+	}
+
+	var analyzers []cosmoslint.Analyzer
+	for _, a := range cosmoslint.Analyzers() {
+		if !slices.Contains(cfg.Lint.Disable, a.Name) {
+			analyzers = append(analyzers, a)
+		}
+	}
+
+	c.ev.Send("Linting Go code with golangci-lint...", events.ProgressUpdate())
+
+	issues, err := c.lintGo(ctx, cfg.Lint.Version, cfg.Lint.Config)
+	if err != nil {
+		return nil, err
+	}
+
+	protoPath := filepath.Join(c.app.Path, cfg.Build.Proto.Path)
+	if _, err := os.Stat(protoPath); err == nil && !o.skipProto {
+		c.ev.Send("Linting proto files with buf...", events.ProgressUpdate())
+
+		protoIssues, err := c.lintProto(ctx, cfg.Build.Proto.Path, o.breakingAgainst)
+		if err != nil {
+			return nil, err
+		}
+
+		issues = append(issues, protoIssues...)
+	}
+
+	c.ev.Send("Checking the modules with the Cosmos analyzers...", events.ProgressUpdate())
+
+	cosmosIssues, err := cosmoslint.Run(c.app.Path, analyzers...)
+	if err != nil {
+		return nil, err
+	}
+
+	issues = append(issues, cosmosIssues...)
+	cosmoslint.SortIssues(issues)
+
+	return issues, nil
+}
+
+// lintGo lints the Go code with a golangci-lint version, which is run using "go run"
+// to be able to use the version pinned in the config without installing it.
+func (c *Chain) lintGo(ctx context.Context, version, configPath string) ([]cosmoslint.Issue, error) {
+	if version == "" {
+		version = DefaultGolangCILintVersion
+	}
+
+	command := []string{
+		"go", "run", fmt.Sprintf("%s@%s", golangCILintPkg, version),
+		"run", "./...", "--out-format=json", "--issues-exit-code=0",
+	}
+	if configPath != "" {
+		command = append(command, "--config", filepath.Join(c.app.Path, configPath))
+	}
+
+	var stdout bytes.Buffer
+	err := exec.Exec(
+		ctx,
+		command,
+		exec.StepOption(step.Workdir(c.app.Path)),
+		exec.StepOption(step.Stdout(&stdout)),
+		exec.IncludeStdLogsToError(),
+	)
+	if err != nil {
+		return nil, errors.Errorf("failed to run golangci-lint %s: %w", version, err)
+	}
+
+	var report golangCILintReport
+	if err := json.Unmarshal(stdout.Bytes(), &report); err != nil {
+		return nil, errors.Errorf("invalid golangci-lint report: %w", err)
+	}
+
+	issues := make([]cosmoslint.Issue, len(report.Issues))
+	for i, issue := range report.Issues {
+		issues[i] = cosmoslint.Issue{
+			Tool:    golangCILintTool,
+			Rule:    issue.FromLinter,
+			Path:    filepath.ToSlash(issue.Pos.Filename),
+			Line:    issue.Pos.Line,
+			Column:  issue.Pos.Column,
+			Message: issue.Text,
+		}
+	}
+
+	return issues, nil
+}
+
+// lintProto lints the proto files with buf, and checks them for breaking
+// changes against a git ref of the app repository when it's not empty.
+func (c *Chain) lintProto(ctx context.Context, protoDir, breakingAgainst string) ([]cosmoslint.Issue, error) {
+	b, err := cosmosbuf.New()
+	if err != nil {
+		return nil, err
+	}
+	defer b.Cleanup()
+
+	protoPath := filepath.Join(c.app.Path, protoDir)
+
+	annotations, err := b.Lint(ctx, protoPath)
+	if err != nil {
+		return nil, err
+	}
+
+	issues := c.bufIssues(protoDir, "", annotations)
+
+	if breakingAgainst == "" {
+		return issues, nil
+	}
+
+	c.ev.Send(
+		fmt.Sprintf("Checking proto files for breaking changes against %s...", breakingAgainst),
+		events.ProgressUpdate(),
+	)
+
+	root, err := xgit.RepositoryRoot(c.app.Path)
+	if err != nil {
+		return nil, err
+	}
+
+	dir, err := filepath.Rel(root, protoPath)
+	if err != nil {
+		return nil, err
+	}
+
+	annotations, err = b.Breaking(ctx, protoPath, cosmosbuf.GitInput(root, breakingAgainst, dir))
+	if err != nil {
+		return nil, err
+	}
+
+	return append(issues, c.bufIssues(protoDir, "breaking/", annotations)...), nil
+}
+
+// bufIssues converts the buf annotations to issues with paths relative to the app directory.
+// Buf reports the paths relative to the proto directory, or as absolute paths for some inputs.
+func (c *Chain) bufIssues(protoDir, rulePrefix string, annotations []cosmosbuf.Annotation) []cosmoslint.Issue {
+	issues := make([]cosmoslint.Issue, len(annotations))
+	for i, a := range annotations {
+		path := filepath.Join(protoDir, a.Path)
+		if filepath.IsAbs(a.Path) {
+			if rel, err := filepath.Rel(c.app.Path, a.Path); err == nil {
+				path = rel
+			}
+		}
+
+		issues[i] = cosmoslint.Issue{
+			Tool:    bufTool,
+			Rule:    rulePrefix + a.Type,
+			Path:    filepath.ToSlash(path),
+			Line:    a.StartLine,
+			Column:  a.StartColumn,
+			Message: a.Message,
+		}
 	}
-	return exec.Exec(ctx, []string{"golangci-lint", "run", "./...", "--out-format=tab"}, exec.IncludeStdLogsToError())
+	return issues
 }
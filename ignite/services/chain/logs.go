@@ -0,0 +1,195 @@
+package chain
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"strings"
+	"time"
+
+	chainconfig "github.com/ignite/cli/v29/ignite/config/chain"
+	"github.com/ignite/cli/v29/ignite/config/chain/base"
+	chaincmdrunner "github.com/ignite/cli/v29/ignite/pkg/chaincmd/runner"
+	"github.com/ignite/cli/v29/ignite/pkg/cliui/icons"
+	"github.com/ignite/cli/v29/ignite/pkg/errors"
+	"github.com/ignite/cli/v29/ignite/pkg/events"
+	"github.com/ignite/cli/v29/ignite/pkg/nodelog"
+	"github.com/ignite/cli/v29/ignite/pkg/supervisor"
+)
+
+const (
+	// logsDir is the name of the directory where the node logs are saved.
+	logsDir = "logs"
+
+	// crashLogLines is the number of log lines read to find the panic trace of a crashed node.
+	crashLogLines = 500
+
+	// crashOutputLines is the number of log lines displayed when a crashed node didn't panic.
+	crashOutputLines = 20
+)
+
+// LogsPath returns the path of the directory where the logs of the chain nodes are saved.
+func (c *Chain) LogsPath() (string, error) {
+	savePath, err := c.chainSavePath()
+	if err != nil {
+		return "", err
+	}
+
+	return filepath.Join(savePath, logsDir), nil
+}
+
+// NodeLogPath returns the path of the log file of a validator node.
+func (c *Chain) NodeLogPath(validator string) (string, error) {
+	logsPath, err := c.LogsPath()
+	if err != nil {
+		return "", err
+	}
+
+	return filepath.Join(logsPath, validator+".log"), nil
+}
+
+// runValidator starts the node of a validator and restarts it when it crashes.
+// The node output is saved to a log file to be able to display the panic trace
+// of the node when it keeps crashing.
+func (c *Chain) runValidator(
+	ctx context.Context,
+	runner chaincmdrunner.Runner,
+	validator chainconfig.Validator,
+	serveConf base.Serve,
+) error {
+	logPath, err := c.NodeLogPath(validator.Name)
+	if err != nil {
+		return err
+	}
+
+	maxRestarts, window, err := restartPolicy(serveConf)
+	if err != nil {
+		return err
+	}
+
+	logFile, err := nodelog.Open(logPath)
+	if err != nil {
+		return err
+	}
+	defer logFile.Close()
+
+	runner = runner.Copy(chaincmdrunner.Tee(logFile))
+
+	s := supervisor.New(
+		supervisor.MaxRestarts(maxRestarts),
+		supervisor.Window(window),
+		supervisor.OnRestart(func(r supervisor.Restart) {
+			c.ev.Send(
+				fmt.Sprintf(
+					"Node %s crashed, restarting in %s (%d/%d)",
+					validator.Name,
+					r.Delay,
+					r.Count,
+					maxRestarts,
+				),
+				events.Icon(icons.NotOK),
+			)
+		}),
+	)
+
+	err = s.Run(ctx, func(ctx context.Context) error {
+		err := c.StartValidator(ctx, runner, validator)
+
+		// Recognized start errors are caused by the node setup so restarting doesn't fix them
+		var startErr *CannotStartAppError
+		if errors.As(err, &startErr) && startErr.Err != nil && startErr.ParseStartError() != "" {
+			return supervisor.Permanent(err)
+		}
+
+		return err
+	})
+
+	var loopErr *supervisor.CrashLoopError
+	if !errors.As(err, &loopErr) {
+		return err
+	}
+
+	lines, err := nodelog.Tail(logPath, crashLogLines)
+	if err != nil {
+		return err
+	}
+
+	// Display the last logs when the node crashed without a panic
+	trace := nodelog.LastPanic(lines)
+	if trace == "" {
+		trace = strings.Join(lines[max(len(lines)-crashOutputLines, 0):], "\n")
+	}
+
+	return &NodeCrashLoopError{
+		Node:    validator.Name,
+		Crashes: loopErr.Restarts + 1,
+		Window:  loopErr.Window,
+		Trace:   trace,
+		LogPath: logPath,
+		Err:     loopErr.Err,
+	}
+}
+
+// NodeCrashLoopError is returned when a validator node keeps crashing after being restarted.
+type NodeCrashLoopError struct {
+	// Node is the name of the validator.
+	Node string
+
+	// Crashes is the number of times the node crashed within the crash loop window.
+	Crashes int
+
+	// Window is the time window used to detect the crash loop.
+	Window time.Duration
+
+	// Trace is the last panic trace found in the node logs,
+	// or the last lines of the logs when the node didn't panic.
+	Trace string
+
+	// LogPath is the path of the node log file.
+	LogPath string
+
+	// Err is the last error returned by the node.
+	Err error
+}
+
+// Title returns a short description of the crash loop.
+func (e *NodeCrashLoopError) Title() string {
+	return fmt.Sprintf("Node %s crashed %d times within %s", e.Node, e.Crashes, e.Window)
+}
+
+func (e *NodeCrashLoopError) Error() string {
+	var b strings.Builder
+
+	b.WriteString(e.Title())
+
+	if e.Trace != "" {
+		fmt.Fprintf(&b, ":\n\n%s", e.Trace)
+	}
+
+	fmt.Fprintf(&b, "\n\nlogs: %s", e.LogPath)
+
+	return b.String()
+}
+
+func (e *NodeCrashLoopError) Unwrap() error {
+	return e.Err
+}
+
+// restartPolicy returns the number of restarts allowed within the restart window
+// before a crashed node is considered to be crash looping, and the restart window.
+// The supervisor defaults are used for the values not defined in the serve config.
+func restartPolicy(serveConf base.Serve) (maxRestarts int, window time.Duration, err error) {
+	maxRestarts, window = supervisor.DefaultMaxRestarts, supervisor.DefaultWindow
+
+	if serveConf.MaxRestarts > 0 {
+		maxRestarts = serveConf.MaxRestarts
+	}
+
+	if serveConf.RestartWindow != "" {
+		if window, err = time.ParseDuration(serveConf.RestartWindow); err != nil {
+			return 0, 0, errors.Errorf("invalid serve restart window: %w", err)
+		}
+	}
+
+	return maxRestarts, window, nil
+}
@@ -0,0 +1,26 @@
+package chain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/cli/v29/ignite/config/chain/base"
+	"github.com/ignite/cli/v29/ignite/pkg/supervisor"
+)
+
+func TestRestartPolicy(t *testing.T) {
+	maxRestarts, window, err := restartPolicy(base.Serve{})
+	require.NoError(t, err)
+	require.Equal(t, supervisor.DefaultMaxRestarts, maxRestarts)
+	require.Equal(t, supervisor.DefaultWindow, window)
+
+	maxRestarts, window, err = restartPolicy(base.Serve{MaxRestarts: 5, RestartWindow: "2m"})
+	require.NoError(t, err)
+	require.Equal(t, 5, maxRestarts)
+	require.Equal(t, 2*time.Minute, window)
+
+	_, _, err = restartPolicy(base.Serve{RestartWindow: "2"})
+	require.Error(t, err)
+}
@@ -0,0 +1,331 @@
+package chain
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	govv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+
+	chainconfig "github.com/ignite/cli/v29/ignite/config/chain"
+	"github.com/ignite/cli/v29/ignite/config/chain/base"
+	"github.com/ignite/cli/v29/ignite/pkg/cliui/icons"
+	"github.com/ignite/cli/v29/ignite/pkg/cosmosaccount"
+	"github.com/ignite/cli/v29/ignite/pkg/cosmosclient"
+	"github.com/ignite/cli/v29/ignite/pkg/cosmosmsg"
+	"github.com/ignite/cli/v29/ignite/pkg/cosmosutil"
+	"github.com/ignite/cli/v29/ignite/pkg/errors"
+	"github.com/ignite/cli/v29/ignite/pkg/events"
+	"github.com/ignite/cli/v29/ignite/pkg/xstrings"
+	"github.com/ignite/cli/v29/ignite/pkg/xurl"
+	"github.com/ignite/cli/v29/ignite/pkg/xyaml"
+)
+
+// provisionRetryInterval is the time to wait between checks of the chain
+// status while waiting for the first block to be committed.
+const provisionRetryInterval = time.Second
+
+// provisioner broadcasts the provision transactions of a chain.
+type provisioner struct {
+	client     cosmosclient.Client
+	decoder    *cosmosmsg.Decoder
+	prefix     string
+	validators map[string]struct{}
+}
+
+// Provision broadcasts the provision transactions defined in the config in order,
+// once the first block of the chain is committed. Each transaction is signed by
+// a config account and waits for its inclusion in a block before the next one is
+// broadcasted. Provisioning stops at the first transaction that fails.
+func (c *Chain) Provision(ctx context.Context, cfg *chainconfig.Config) error {
+	if len(cfg.Provision) == 0 {
+		return nil
+	}
+
+	p, closeConn, err := c.newProvisioner(ctx, cfg)
+	if err != nil {
+		return err
+	}
+	defer closeConn()
+
+	for i, tx := range cfg.Provision {
+		name := provisionTxName(i, tx)
+
+		c.ev.Send(fmt.Sprintf("Provisioning %s...", name), events.ProgressStart())
+
+		res, err := p.broadcast(ctx, tx)
+		if err != nil {
+			return errors.Errorf("provisioning %s failed: %w", name, err)
+		}
+
+		c.ev.Send(
+			fmt.Sprintf("Provisioned %s at height %d (tx %s)", name, res.Height, res.TxHash),
+			events.Icon(icons.OK),
+			events.ProgressFinish(),
+		)
+	}
+
+	return nil
+}
+
+// provision runs the chain provisioning and reports failures as serve events
+// to keep the chain running when a provision transaction fails.
+func (c *Chain) provision(ctx context.Context, cfg *chainconfig.Config) error {
+	err := c.Provision(ctx, cfg)
+	if err != nil && !errors.Is(err, context.Canceled) {
+		c.ev.Send(err.Error(), events.Icon(icons.NotOK), events.ProgressFinish())
+	}
+
+	// Provisioning errors must not stop the chain
+	return nil
+}
+
+func (c *Chain) newProvisioner(ctx context.Context, cfg *chainconfig.Config) (provisioner, func(), error) {
+	validator, err := chainconfig.FirstValidator(cfg)
+	if err != nil {
+		return provisioner{}, nil, err
+	}
+
+	servers, err := validator.GetServers()
+	if err != nil {
+		return provisioner{}, nil, err
+	}
+
+	rpcAddr, err := xurl.HTTP(servers.RPC.Address)
+	if err != nil {
+		return provisioner{}, nil, errors.Errorf("invalid rpc address format: %w", err)
+	}
+
+	commands, err := c.Commands(ctx)
+	if err != nil {
+		return provisioner{}, nil, err
+	}
+
+	// The address prefix of the chain is read from the address of the first signer
+	account, err := commands.ShowAccount(ctx, cfg.Provision[0].From)
+	if err != nil {
+		return provisioner{}, nil, err
+	}
+
+	prefix, err := cosmosutil.GetAddressPrefix(account.Address)
+	if err != nil {
+		return provisioner{}, nil, err
+	}
+
+	home, err := c.Home()
+	if err != nil {
+		return provisioner{}, nil, err
+	}
+
+	backend, err := c.KeyringBackend()
+	if err != nil {
+		return provisioner{}, nil, err
+	}
+
+	c.ev.Send("Waiting for the first block to provision the chain...", events.ProgressStart())
+
+	client, err := waitForFirstBlock(
+		ctx,
+		cosmosclient.WithNodeAddress(rpcAddr),
+		cosmosclient.WithAddressPrefix(prefix),
+		cosmosclient.WithHome(home),
+		cosmosclient.WithKeyringDir(home),
+		cosmosclient.WithKeyringBackend(cosmosaccount.KeyringBackend(backend)),
+		cosmosclient.WithKeyringServiceName(xstrings.Title(c.app.Name)),
+		cosmosclient.WithGas(cosmosclient.GasAuto),
+	)
+	if err != nil {
+		return provisioner{}, nil, err
+	}
+
+	// Messages given as JSON are decoded using the gRPC reflection service of the node
+	conn, err := grpc.NewClient(xurl.Address(servers.GRPC.Address), grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		return provisioner{}, nil, err
+	}
+
+	p := provisioner{
+		client:     client,
+		decoder:    cosmosmsg.NewDecoder(conn),
+		prefix:     prefix,
+		validators: make(map[string]struct{}),
+	}
+
+	for _, v := range cfg.Validators {
+		p.validators[v.Name] = struct{}{}
+	}
+
+	for _, v := range cfg.GenesisValidators {
+		p.validators[v.Name] = struct{}{}
+	}
+
+	return p, func() { conn.Close() }, nil
+}
+
+// waitForFirstBlock creates a client for the chain once its first block is committed.
+func waitForFirstBlock(ctx context.Context, options ...cosmosclient.Option) (cosmosclient.Client, error) {
+	ticker := time.NewTicker(provisionRetryInterval)
+	defer ticker.Stop()
+
+	for {
+		// The client can't be created until the node RPC server is started
+		client, err := cosmosclient.New(ctx, options...)
+		if err == nil {
+			if height, err := client.LatestBlockHeight(ctx); err == nil && height > 0 {
+				return client, nil
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return cosmosclient.Client{}, ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// broadcast broadcasts a provision transaction and waits for its inclusion in a block.
+func (p provisioner) broadcast(ctx context.Context, tx base.ProvisionTx) (*sdk.TxResponse, error) {
+	account, err := p.client.AccountRegistry.GetByName(tx.From)
+	if err != nil {
+		return nil, err
+	}
+
+	from, err := account.Address(p.prefix)
+	if err != nil {
+		return nil, err
+	}
+
+	msgs, err := p.messages(ctx, tx, from)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := p.client.BroadcastTx(ctx, account, msgs...)
+	if err != nil {
+		return nil, err
+	}
+
+	return res.TxResponse, nil
+}
+
+// messages returns the messages of a provision transaction.
+func (p provisioner) messages(ctx context.Context, tx base.ProvisionTx, from string) ([]sdk.Msg, error) {
+	switch {
+	case tx.BankSend != nil:
+		to, err := p.address(tx.BankSend.To)
+		if err != nil {
+			return nil, err
+		}
+
+		amount, err := sdk.ParseCoinsNormalized(strings.Join(tx.BankSend.Amount, ","))
+		if err != nil {
+			return nil, err
+		}
+
+		return []sdk.Msg{&banktypes.MsgSend{FromAddress: from, ToAddress: to, Amount: amount}}, nil
+	case tx.Delegate != nil:
+		validator, err := p.validatorAddress(tx.Delegate.Validator)
+		if err != nil {
+			return nil, err
+		}
+
+		amount, err := sdk.ParseCoinNormalized(tx.Delegate.Amount)
+		if err != nil {
+			return nil, err
+		}
+
+		return []sdk.Msg{stakingtypes.NewMsgDelegate(from, validator, amount)}, nil
+	case tx.GovProposal != nil:
+		proposal := tx.GovProposal
+
+		deposit, err := sdk.ParseCoinsNormalized(strings.Join(proposal.Deposit, ","))
+		if err != nil {
+			return nil, err
+		}
+
+		msgs, err := p.decodeMessages(ctx, proposal.Messages)
+		if err != nil {
+			return nil, err
+		}
+
+		msg, err := govv1.NewMsgSubmitProposal(
+			msgs,
+			deposit,
+			from,
+			proposal.Metadata,
+			proposal.Title,
+			proposal.Summary,
+			proposal.Expedited,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		return []sdk.Msg{msg}, nil
+	default:
+		return p.decodeMessages(ctx, tx.Messages)
+	}
+}
+
+// decodeMessages decodes messages defined using their proto JSON representation.
+func (p provisioner) decodeMessages(ctx context.Context, messages []xyaml.Map) ([]sdk.Msg, error) {
+	msgs := make([]sdk.Msg, len(messages))
+	for i, m := range messages {
+		bz, err := json.Marshal(m)
+		if err != nil {
+			return nil, err
+		}
+
+		if msgs[i], err = p.decoder.DecodeJSON(ctx, bz); err != nil {
+			return nil, errors.Errorf("message #%d: %w", i+1, err)
+		}
+	}
+
+	return msgs, nil
+}
+
+// address returns the address of an account name or the value itself when it's not an account name.
+func (p provisioner) address(nameOrAddress string) (string, error) {
+	var accountErr *cosmosaccount.AccountDoesNotExistError
+
+	account, err := p.client.AccountRegistry.GetByName(nameOrAddress)
+	if errors.As(err, &accountErr) {
+		return nameOrAddress, nil
+	}
+	if err != nil {
+		return "", err
+	}
+
+	return account.Address(p.prefix)
+}
+
+// validatorAddress returns the operator address of a validator name or the value itself when it's not a validator name.
+func (p provisioner) validatorAddress(nameOrAddress string) (string, error) {
+	if _, ok := p.validators[nameOrAddress]; !ok {
+		return nameOrAddress, nil
+	}
+
+	address, err := p.address(nameOrAddress)
+	if err != nil {
+		return "", err
+	}
+
+	return cosmosutil.ChangeAddressPrefix(address, p.prefix+"valoper")
+}
+
+// provisionTxName returns the name of a provision transaction to display in the serve status.
+func provisionTxName(index int, tx base.ProvisionTx) string {
+	if tx.Name != "" {
+		return fmt.Sprintf("%q", tx.Name)
+	}
+
+	return fmt.Sprintf("tx #%d", index+1)
+}
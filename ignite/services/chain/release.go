@@ -0,0 +1,421 @@
+package chain
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+	"text/template"
+	"time"
+
+	"github.com/ignite/cli/v29/ignite/pkg/checksum"
+	"github.com/ignite/cli/v29/ignite/pkg/cliui/icons"
+	"github.com/ignite/cli/v29/ignite/pkg/cmdrunner"
+	"github.com/ignite/cli/v29/ignite/pkg/cmdrunner/exec"
+	"github.com/ignite/cli/v29/ignite/pkg/cmdrunner/step"
+	"github.com/ignite/cli/v29/ignite/pkg/cosmovisor"
+	"github.com/ignite/cli/v29/ignite/pkg/errors"
+	"github.com/ignite/cli/v29/ignite/pkg/events"
+	"github.com/ignite/cli/v29/ignite/pkg/gocmd"
+	"github.com/ignite/cli/v29/ignite/pkg/gomodule"
+	"github.com/ignite/cli/v29/ignite/pkg/provenance"
+	"github.com/ignite/cli/v29/ignite/pkg/sbom"
+	"github.com/ignite/cli/v29/ignite/pkg/xarchive"
+	"github.com/ignite/cli/v29/ignite/pkg/xgit"
+	"github.com/ignite/cli/v29/ignite/pkg/xos"
+	igniteversion "github.com/ignite/cli/v29/ignite/version"
+)
+
+const (
+	// EnvSourceDateEpoch is the name of the env var used to set the source date of reproducible releases.
+	EnvSourceDateEpoch = "SOURCE_DATE_EPOCH"
+
+	releaseSBOMFile       = "sbom.cdx.json"
+	releaseProvenanceFile = "provenance.json"
+	releaseBuildType      = "https://github.com/ignite/cli/chain-build-release@v1"
+	releaseBuilderID      = "https://github.com/ignite/cli"
+
+	// releaseFormatRaw is the release format used to release the binaries without archiving them.
+	releaseFormatRaw = "raw"
+
+	// defaultReleaseName is the default template used to name the release files.
+	defaultReleaseName = "{{.Prefix}}_{{.OS}}_{{.Arch}}"
+)
+
+type releaseOptions struct {
+	reproducible bool
+	signKey      string
+	format       string
+	parallel     int
+	upgrade      string
+	upgradeURL   string
+}
+
+// ReleaseOption configures release builds.
+type ReleaseOption func(*releaseOptions)
+
+// ReleaseReproducible builds a release that always produces the same files for the same source code.
+// Binaries are built without local file system paths and stamped with version control information,
+// and the files of the release archives use the source date as modification time.
+// The source date is read from the SOURCE_DATE_EPOCH env var and defaults to the
+// time of the last commit of the source code.
+func ReleaseReproducible() ReleaseOption {
+	return func(o *releaseOptions) {
+		o.reproducible = true
+	}
+}
+
+// ReleaseSignKey signs the release checksum file with a PEM encoded PKCS #8 private key.
+func ReleaseSignKey(path string) ReleaseOption {
+	return func(o *releaseOptions) {
+		o.signKey = path
+	}
+}
+
+// ReleaseFormat sets the archive format of the release binaries: "tar.gz", "tar.zst", "zip" or "raw".
+// Raw releases contain the binaries without archiving them.
+func ReleaseFormat(format string) ReleaseOption {
+	return func(o *releaseOptions) {
+		o.format = format
+	}
+}
+
+// ReleaseParallel sets the maximum number of targets that are built in parallel.
+// By default the number of targets built in parallel is the number of CPUs.
+func ReleaseParallel(n int) ReleaseOption {
+	return func(o *releaseOptions) {
+		o.parallel = n
+	}
+}
+
+// ReleaseUpgrade builds the release of a chain upgrade for Cosmovisor.
+// The binaries are saved inside a "bin" directory, which is the layout expected by
+// Cosmovisor to download upgrade binaries, and the upgrade plan info with the binary
+// URLs for each target is saved to an "upgrade-plan-info.json" file. The release also
+// contains a "cosmovisor/upgrades/<name>/bin" directory tree with the binary for
+// the current platform, or for the first target when it's not a release target.
+// baseURL is the URL where the release files are published, local file URLs are
+// used when it's empty.
+func ReleaseUpgrade(name, baseURL string) ReleaseOption {
+	return func(o *releaseOptions) {
+		o.upgrade = name
+		o.upgradeURL = baseURL
+	}
+}
+
+// releaseBuild contains the values shared by the builds of the release targets.
+type releaseBuild struct {
+	binary, mainPath string
+	buildFlags       []string
+	buildEnv         []string
+	ldFlags          map[string][]string
+	releasePath      string
+	names            map[string]string
+	format           string
+	files            []string
+	sourceDate       time.Time
+	upgrade          string
+	upgradeTarget    string
+}
+
+// releaseName contains the values available to the release name template.
+type releaseName struct {
+	Prefix  string
+	Version string
+	OS      string
+	Arch    string
+}
+
+// buildReleaseTarget builds the binary of a release target and saves it to the release
+// directory, archived with the extra release files unless the release format is raw.
+func (c *Chain) buildReleaseTarget(ctx context.Context, rb releaseBuild, target string) (path string, err error) {
+	goos, goarch, err := gocmd.ParseTarget(target)
+	if err != nil {
+		return "", err
+	}
+
+	c.ev.Send(fmt.Sprintf("Building %s...", target), events.ProgressUpdate())
+
+	out, err := os.MkdirTemp("", "")
+	if err != nil {
+		return "", err
+	}
+	defer os.RemoveAll(out)
+
+	// Upgrade binaries are archived inside the directory expected by Cosmovisor
+	binDir := out
+	if rb.upgrade != "" {
+		binDir = filepath.Join(out, cosmovisor.BinDir)
+		if err := os.MkdirAll(binDir, 0o755); err != nil {
+			return "", err
+		}
+	}
+
+	buildFlags := rb.buildFlags
+	if ldFlags := rb.ldFlags[target]; len(ldFlags) > 0 {
+		buildFlags = appendLDFlags(buildFlags, ldFlags...)
+	}
+
+	buildOptions := []exec.Option{
+		exec.StepOption(step.Env(append([]string{
+			cmdrunner.Env(gocmd.EnvGOOS, goos),
+			cmdrunner.Env(gocmd.EnvGOARCH, goarch),
+		}, rb.buildEnv...)...)),
+	}
+
+	if err := gocmd.BuildPath(ctx, binDir, rb.binary, rb.mainPath, buildFlags, buildOptions...); err != nil {
+		return "", err
+	}
+
+	binaryPath := filepath.Join(binDir, rb.binary)
+
+	if rb.upgrade != "" && target == rb.upgradeTarget {
+		if err := copyBinary(binaryPath, cosmovisor.UpgradeBinPath(rb.releasePath, rb.upgrade, rb.binary, goos)); err != nil {
+			return "", err
+		}
+	}
+
+	name := rb.names[target]
+
+	if rb.format == releaseFormatRaw {
+		path = filepath.Join(rb.releasePath, name)
+		if goos == "windows" {
+			path += ".exe"
+		}
+
+		if err := copyBinary(binaryPath, path); err != nil {
+			return "", err
+		}
+	} else {
+		for _, f := range rb.files {
+			if err := xos.CopyFile(f, filepath.Join(out, filepath.Base(f))); err != nil {
+				return "", err
+			}
+		}
+
+		format := xarchive.Format(rb.format)
+		path = filepath.Join(rb.releasePath, name+format.Ext())
+
+		if err := createReleaseArchive(path, out, format, rb.sourceDate); err != nil {
+			return "", err
+		}
+	}
+
+	c.ev.Send(fmt.Sprintf("Built %s", filepath.Base(path)), events.Icon(icons.OK))
+
+	return path, nil
+}
+
+// releaseNames renders the name of the release files of each target using the release name template.
+// An error is returned when targets have the same name because their release files would be overwritten.
+func releaseNames(nameTemplate, prefix, version string, targets []string) (map[string]string, error) {
+	tmpl, err := template.New("release").Parse(nameTemplate)
+	if err != nil {
+		return nil, errors.Errorf("invalid release name template: %w", err)
+	}
+
+	var (
+		names    = make(map[string]string, len(targets))
+		targetOf = make(map[string]string, len(targets))
+	)
+	for _, t := range targets {
+		goos, goarch, err := gocmd.ParseTarget(t)
+		if err != nil {
+			return nil, err
+		}
+
+		var name strings.Builder
+		err = tmpl.Execute(&name, releaseName{
+			Prefix:  prefix,
+			Version: version,
+			OS:      goos,
+			Arch:    goarch,
+		})
+		if err != nil {
+			return nil, errors.Errorf("invalid release name template: %w", err)
+		}
+
+		if other, ok := targetOf[name.String()]; ok {
+			return nil, errors.Errorf("release targets %s and %s have the same release name %q", other, t, name.String())
+		}
+
+		targetOf[name.String()] = t
+		names[t] = name.String()
+	}
+
+	return names, nil
+}
+
+// copyBinary copies a binary file, creating the destination directory when it doesn't exist.
+func copyBinary(src, dst string) error {
+	if err := os.MkdirAll(filepath.Dir(dst), 0o755); err != nil {
+		return err
+	}
+
+	if err := xos.CopyFile(src, dst); err != nil {
+		return err
+	}
+
+	return os.Chmod(dst, 0o755)
+}
+
+// writeUpgradeInfo writes the upgrade plan info with the URLs and checksums of the release
+// files of each target, which can be used as the info of a governance upgrade proposal.
+func writeUpgradeInfo(releasePath, baseURL string, targets, artifacts []string) error {
+	info := cosmovisor.NewUpgradeInfo()
+
+	for i, t := range targets {
+		goos, goarch, err := gocmd.ParseTarget(t)
+		if err != nil {
+			return err
+		}
+
+		sum, err := checksum.File(artifacts[i])
+		if err != nil {
+			return err
+		}
+
+		binaryURL := cosmovisor.JoinURL(baseURL, filepath.Base(artifacts[i]))
+		if baseURL == "" {
+			if binaryURL, err = cosmovisor.FileURL(artifacts[i]); err != nil {
+				return err
+			}
+		}
+
+		if err := info.AddBinary(goos, goarch, binaryURL, sum); err != nil {
+			return err
+		}
+	}
+
+	return info.Save(filepath.Join(releasePath, cosmovisor.PlanInfoFile))
+}
+
+// createReleaseArchive creates an archive with the files of a directory.
+func createReleaseArchive(path, dir string, format xarchive.Format, modTime time.Time) error {
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	if err := xarchive.Create(f, dir, format, modTime); err != nil {
+		return err
+	}
+
+	return f.Close()
+}
+
+// appendLDFlags returns a copy of the build flags with extra linker flags added to the ldflags value.
+func appendLDFlags(buildFlags []string, ldFlags ...string) []string {
+	flags := slices.Clone(buildFlags)
+	for i := 0; i < len(flags)-1; i++ {
+		if flags[i] == gocmd.FlagLdflags {
+			flags[i+1] = gocmd.Ldflags(append([]string{flags[i+1]}, ldFlags...)...)
+			return flags
+		}
+	}
+
+	return append(flags, gocmd.FlagLdflags, gocmd.Ldflags(ldFlags...))
+}
+
+// sourceDateEpoch returns the source date used to build reproducible releases.
+func (c *Chain) sourceDateEpoch() (time.Time, error) {
+	if v := os.Getenv(EnvSourceDateEpoch); v != "" {
+		sec, err := strconv.ParseInt(v, 10, 64)
+		if err != nil {
+			return time.Time{}, errors.Errorf("invalid %s value %q: %w", EnvSourceDateEpoch, v, err)
+		}
+
+		return time.Unix(sec, 0).UTC(), nil
+	}
+
+	t, err := xgit.HeadCommitTime(c.app.Path)
+	if err != nil {
+		return time.Time{}, errors.Errorf(
+			"cannot determine the source date, commit the source code or set %s: %w",
+			EnvSourceDateEpoch,
+			err,
+		)
+	}
+
+	return t.UTC(), nil
+}
+
+// writeReleaseSBOM writes a CycloneDX SBOM with the Go modules of the chain to the release directory.
+func (c *Chain) writeReleaseSBOM(releasePath string, createdAt time.Time) error {
+	modFile, err := gomodule.ParseAt(c.app.Path)
+	if err != nil {
+		return err
+	}
+
+	bom := sbom.FromModFile(
+		modFile,
+		c.sourceVersion.tag,
+		sbom.WithTimestamp(createdAt),
+		sbom.WithTool("ignite", igniteversion.Version),
+	)
+
+	return writeReleaseJSON(filepath.Join(releasePath, releaseSBOMFile), bom)
+}
+
+// writeReleaseProvenance writes an in-toto statement with the build provenance of the release files.
+func (c *Chain) writeReleaseProvenance(
+	releasePath string,
+	artifacts, buildTags, targets []string,
+	reproducible bool,
+) error {
+	subjects := make([]provenance.Subject, len(artifacts))
+	for i, path := range artifacts {
+		s, err := provenance.FileSubject(path)
+		if err != nil {
+			return err
+		}
+
+		subjects[i] = s
+	}
+
+	source := provenance.ResourceDescriptor{Name: c.app.ImportPath}
+	if c.sourceVersion.hash != "" {
+		source.Digest = map[string]string{provenance.DigestGitCommit: c.sourceVersion.hash}
+	}
+
+	definition := provenance.BuildDefinition{
+		BuildType: releaseBuildType,
+		ExternalParameters: map[string]interface{}{
+			"targets":      targets,
+			"buildTags":    buildTags,
+			"reproducible": reproducible,
+		},
+		ResolvedDependencies: []provenance.ResourceDescriptor{source},
+	}
+
+	if goVersion, err := gocmd.Env(gocmd.EnvGOVERSION); err == nil {
+		definition.InternalParameters = map[string]interface{}{
+			"goVersion": strings.TrimSpace(goVersion),
+		}
+	}
+
+	statement := provenance.NewStatement(
+		subjects,
+		definition,
+		provenance.Builder{
+			ID:      releaseBuilderID,
+			Version: map[string]string{"ignite": igniteversion.Version},
+		},
+	)
+
+	return writeReleaseJSON(filepath.Join(releasePath, releaseProvenanceFile), statement)
+}
+
+func writeReleaseJSON(path string, v interface{}) error {
+	data, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(path, append(data, '\n'), 0o644)
+}
@@ -0,0 +1,60 @@
+package chain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestAppendLDFlags(t *testing.T) {
+	tests := []struct {
+		name       string
+		buildFlags []string
+		ldFlags    []string
+		want       []string
+	}{
+		{
+			name:       "existing ldflags",
+			buildFlags: []string{"-mod", "readonly", "-ldflags", "-X main.Version=v1.0.0", "-trimpath"},
+			ldFlags:    []string{"-s", "-w"},
+			want:       []string{"-mod", "readonly", "-ldflags", "-X main.Version=v1.0.0 -s -w", "-trimpath"},
+		},
+		{
+			name:       "no ldflags",
+			buildFlags: []string{"-mod", "readonly"},
+			ldFlags:    []string{"-s", "-w"},
+			want:       []string{"-mod", "readonly", "-ldflags", "-s -w"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			buildFlags := append([]string{}, tt.buildFlags...)
+
+			got := appendLDFlags(buildFlags, tt.ldFlags...)
+
+			require.Equal(t, tt.want, got)
+			require.Equal(t, tt.buildFlags, buildFlags)
+		})
+	}
+}
+
+func TestReleaseNames(t *testing.T) {
+	targets := []string{"linux:amd64", "darwin:arm64"}
+
+	names, err := releaseNames(defaultReleaseName, "mars", "v1.0.0", targets)
+	require.NoError(t, err)
+	require.Equal(t, map[string]string{
+		"linux:amd64":  "mars_linux_amd64",
+		"darwin:arm64": "mars_darwin_arm64",
+	}, names)
+
+	names, err = releaseNames("{{.Prefix}}-{{.Version}}-{{.OS}}-{{.Arch}}", "mars", "v1.0.0", targets)
+	require.NoError(t, err)
+	require.Equal(t, "mars-v1.0.0-linux-amd64", names["linux:amd64"])
+
+	_, err = releaseNames("{{.Prefix}}_{{.Version}}", "mars", "v1.0.0", targets)
+	require.ErrorContains(t, err, `release targets linux:amd64 and darwin:arm64 have the same release name "mars_v1.0.0"`)
+
+	_, err = releaseNames("{{.Prefix", "mars", "v1.0.0", targets)
+	require.ErrorContains(t, err, "invalid release name template")
+}
@@ -2,8 +2,11 @@ package chain
 
 import (
 	"context"
+	"fmt"
+	"net/url"
 	"os"
 	"path/filepath"
+	"strings"
 
 	sdktypes "github.com/cosmos/cosmos-sdk/types"
 	"github.com/nqd/flat"
@@ -43,6 +46,12 @@ func (c Chain) Start(ctx context.Context, runner chaincmdrunner.Runner, cfg *cha
 		return err
 	}
 
+	return c.StartValidator(ctx, runner, validator)
+}
+
+// StartValidator wraps the "appd start" command to begin running a validator node from the daemon.
+// The runner must be configured with the home directory of the validator.
+func (c Chain) StartValidator(ctx context.Context, runner chaincmdrunner.Runner, validator chainconfig.Validator) error {
 	servers, err := validator.GetServers()
 	if err != nil {
 		return err
@@ -55,21 +64,26 @@ func (c Chain) Start(ctx context.Context, runner chaincmdrunner.Runner, cfg *cha
 
 // Configure sets the runtime configurations files for a chain (app.toml, client.toml, config.toml).
 func (c Chain) Configure(homePath string, cfg *chainconfig.Config) error {
-	if err := c.appTOML(homePath, cfg); err != nil {
-		return err
-	}
-	if err := c.clientTOML(homePath, cfg); err != nil {
+	validator, err := chainconfig.FirstValidator(cfg)
+	if err != nil {
 		return err
 	}
-	return c.configTOML(homePath, cfg)
+
+	return c.ConfigureValidator(homePath, validator)
 }
 
-func (c Chain) appTOML(homePath string, cfg *chainconfig.Config) error {
-	validator, err := chainconfig.FirstValidator(cfg)
-	if err != nil {
+// ConfigureValidator sets the runtime configurations files for a validator node (app.toml, client.toml, config.toml).
+func (c Chain) ConfigureValidator(homePath string, validator chainconfig.Validator) error {
+	if err := c.appTOML(homePath, validator); err != nil {
+		return err
+	}
+	if err := c.clientTOML(homePath, validator); err != nil {
 		return err
 	}
+	return c.configTOML(homePath, validator)
+}
 
+func (c Chain) appTOML(homePath string, validator chainconfig.Validator) error {
 	// TODO find a better way in order to not delete comments in the toml.yml
 	path := filepath.Join(homePath, "config/app.toml")
 	appConfig, err := toml.LoadFile(path)
@@ -117,12 +131,7 @@ func (c Chain) appTOML(homePath string, cfg *chainconfig.Config) error {
 	return err
 }
 
-func (c Chain) configTOML(homePath string, cfg *chainconfig.Config) error {
-	validator, err := chainconfig.FirstValidator(cfg)
-	if err != nil {
-		return err
-	}
-
+func (c Chain) configTOML(homePath string, validator chainconfig.Validator) error {
 	// TODO find a better way in order to not delete comments in the toml.yml
 	path := filepath.Join(homePath, "config/config.toml")
 	tmConfig, err := toml.LoadFile(path)
@@ -170,12 +179,7 @@ func (c Chain) configTOML(homePath string, cfg *chainconfig.Config) error {
 	return err
 }
 
-func (c Chain) clientTOML(homePath string, cfg *chainconfig.Config) error {
-	validator, err := chainconfig.FirstValidator(cfg)
-	if err != nil {
-		return err
-	}
-
+func (c Chain) clientTOML(homePath string, validator chainconfig.Validator) error {
 	path := filepath.Join(homePath, "config/client.toml")
 	tmConfig, err := toml.LoadFile(path)
 	if os.IsNotExist(err) {
@@ -205,6 +209,79 @@ func (c Chain) clientTOML(homePath string, cfg *chainconfig.Config) error {
 	return err
 }
 
+// configurePeers connects the validator nodes between each other by writing
+// the addresses of the other nodes as persistent peers in their config.toml.
+func (c Chain) configurePeers(ctx context.Context, cfg *chainconfig.Config) error {
+	peers := make([]string, len(cfg.Validators))
+	homes := make([]string, len(cfg.Validators))
+
+	for i, validator := range cfg.Validators {
+		commands, err := c.ValidatorCommands(ctx, i)
+		if err != nil {
+			return err
+		}
+
+		nodeID, err := commands.ShowNodeID(ctx)
+		if err != nil {
+			return err
+		}
+
+		servers, err := validator.GetServers()
+		if err != nil {
+			return err
+		}
+
+		p2pAddr, err := xurl.TCP(servers.P2P.Address)
+		if err != nil {
+			return errors.Errorf("invalid p2p address format %s: %w", servers.P2P.Address, err)
+		}
+
+		u, err := url.Parse(p2pAddr)
+		if err != nil {
+			return err
+		}
+
+		peers[i] = fmt.Sprintf("%s@127.0.0.1:%s", nodeID, u.Port())
+
+		if homes[i], err = c.ValidatorHome(i); err != nil {
+			return err
+		}
+	}
+
+	for i, home := range homes {
+		path := filepath.Join(home, "config/config.toml")
+		tmConfig, err := toml.LoadFile(path)
+		if err != nil {
+			return err
+		}
+
+		var persistentPeers []string
+		for j, peer := range peers {
+			if i != j {
+				persistentPeers = append(persistentPeers, peer)
+			}
+		}
+
+		// All the nodes run in the same host so duplicated IPs must be allowed
+		tmConfig.Set("p2p.persistent_peers", strings.Join(persistentPeers, ","))
+		tmConfig.Set("p2p.allow_duplicate_ip", true)
+		tmConfig.Set("p2p.addr_book_strict", false)
+
+		file, err := os.OpenFile(path, os.O_RDWR|os.O_TRUNC, 0o644)
+		if err != nil {
+			return err
+		}
+
+		_, err = tmConfig.WriteTo(file)
+		file.Close()
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (c Chain) appHome() string {
 	home, _ := os.UserHomeDir()
 	return filepath.Join(home, "."+c.app.Name)
@@ -21,6 +21,7 @@ import (
 	"github.com/ignite/cli/v29/ignite/pkg/cliui/colors"
 	"github.com/ignite/cli/v29/ignite/pkg/cliui/icons"
 	"github.com/ignite/cli/v29/ignite/pkg/cliui/view/accountview"
+	"github.com/ignite/cli/v29/ignite/pkg/cliui/view/crashview"
 	"github.com/ignite/cli/v29/ignite/pkg/cliui/view/errorview"
 	"github.com/ignite/cli/v29/ignite/pkg/cosmosfaucet"
 	"github.com/ignite/cli/v29/ignite/pkg/dirchange"
@@ -46,11 +47,14 @@ const (
 	// binaryChecksumKey is the cache key for the checksum to detect binary modification.
 	binaryChecksumKey = "binary_checksum"
 
-	// configChecksumKey is the cache key for containing the checksum to detect config modification.
-	configChecksumKey = "config_checksum"
+	// configChecksumsKey is the cache key for the checksums of the last served config used to detect config changes.
+	configChecksumsKey = "config_checksums"
 
 	// serveDirchangeCacheNamespace is the name of the cache namespace for detecting changes in directories.
 	serveDirchangeCacheNamespace = "serve.dirchange"
+
+	// serveConfigCacheNamespace is the name of the cache namespace for detecting changes in the config.
+	serveConfigCacheNamespace = "serve.config"
 )
 
 var (
@@ -71,6 +75,8 @@ type serveOptions struct {
 	quitOnFail      bool
 	generateClients bool
 	buildTags       []string
+	fromState       string
+	attach          string
 }
 
 func newServeOption() serveOptions {
@@ -118,6 +124,21 @@ func ServeSkipProto() ServeOption {
 	}
 }
 
+// ServeFromState restores a named chain state when the chain is served for the first time.
+func ServeFromState(name string) ServeOption {
+	return func(c *serveOptions) {
+		c.fromState = name
+	}
+}
+
+// ServeAttach serves the chain using a node that is already running at the RPC address
+// instead of building and starting the chain. The chain binary must be installed.
+func ServeAttach(rpcAddress string) ServeOption {
+	return func(c *serveOptions) {
+		c.attach = rpcAddress
+	}
+}
+
 // BuildTags set the build tags for the go build.
 func BuildTags(buildTags ...string) ServeOption {
 	return func(c *serveOptions) {
@@ -148,6 +169,11 @@ func (c *Chain) Serve(ctx context.Context, cacheStorage cache.Storage, options .
 		return err
 	}
 
+	// the chain commands are executed using the attached node
+	if serveOptions.attach != "" {
+		c.setNodeAddress(serveOptions.attach)
+	}
+
 	// start serving components.
 	g, ctx := errgroup.WithContext(ctx)
 
@@ -174,6 +200,7 @@ func (c *Chain) Serve(ctx context.Context, cacheStorage cache.Storage, options .
 					serveCtx      context.Context
 					buildErr      *CannotBuildAppError
 					startErr      *CannotStartAppError
+					crashErr      *NodeCrashLoopError
 					validationErr *chainconfig.ValidationError
 				)
 
@@ -183,15 +210,21 @@ func (c *Chain) Serve(ctx context.Context, cacheStorage cache.Storage, options .
 				shouldReset := serveOptions.forceReset || serveOptions.resetOnce
 
 				// serve the app.
-				err = c.serve(
-					serveCtx,
-					cacheStorage,
-					serveOptions.buildTags,
-					shouldReset,
-					serveOptions.skipProto,
-					serveOptions.generateClients,
-				)
+				if serveOptions.attach != "" {
+					err = c.attach(serveCtx, cacheStorage, serveOptions.skipProto, serveOptions.generateClients)
+				} else {
+					err = c.serve(
+						serveCtx,
+						cacheStorage,
+						serveOptions.buildTags,
+						shouldReset,
+						serveOptions.skipProto,
+						serveOptions.generateClients,
+						serveOptions.fromState,
+					)
+				}
 				serveOptions.resetOnce = false
+				serveOptions.fromState = ""
 
 				switch {
 				case err == nil:
@@ -235,6 +268,16 @@ func (c *Chain) Serve(ctx context.Context, cacheStorage cache.Storage, options .
 					}
 
 					c.ev.SendView(errorview.NewError(err), events.ProgressFinish(), events.Group(events.GroupError))
+				case errors.As(err, &crashErr):
+					if serveOptions.quitOnFail {
+						return err
+					}
+
+					c.ev.SendView(
+						crashview.NewCrash(crashErr.Title(), crashErr.Trace, crashview.WithLogPath(crashErr.LogPath)),
+						events.ProgressFinish(),
+						events.Group(events.GroupCrash),
+					)
 				case errors.As(err, &startErr):
 					// Parse returned error logs
 					parsedErr := startErr.ParseStartError()
@@ -264,7 +307,7 @@ func (c *Chain) Serve(ctx context.Context, cacheStorage cache.Storage, options .
 
 	// routine to watch back-end
 	g.Go(func() error {
-		return c.watchAppBackend(ctx)
+		return c.watchAppBackend(ctx, serveOptions.attach != "")
 	})
 
 	return g.Wait()
@@ -294,26 +337,100 @@ func (c *Chain) refreshServe() {
 	c.serveRefresher <- struct{}{}
 }
 
-func (c *Chain) watchAppBackend(ctx context.Context) error {
-	watchPaths := appBackendSourceWatchPaths(defaults.ProtoDir)
+// refreshFaucet restarts the faucet server without restarting the chain.
+func (c *Chain) refreshFaucet() {
+	select {
+	case c.faucetRefresher <- struct{}{}:
+	default:
+		// a faucet restart is already pending
+	}
+}
+
+// watchAppBackend watches the source code and the config of the app to refresh the serve.
+// Only the proto files are watched when the chain is attached to a running node,
+// because the source code of the app is not built.
+func (c *Chain) watchAppBackend(ctx context.Context, protoOnly bool) error {
+	protoDir := defaults.ProtoDir
 
 	if c.ConfigPath() != "" {
 		conf, err := c.Config()
 		if err != nil {
 			return err
 		}
-		watchPaths = append(appBackendSourceWatchPaths(conf.Build.Proto.Path), c.ConfigPath())
+		protoDir = conf.Build.Proto.Path
 	}
 
-	return localfs.Watch(
-		ctx,
-		watchPaths,
-		localfs.WatcherWorkdir(c.app.Path),
-		localfs.WatcherOnChange(c.refreshServe),
-		localfs.WatcherIgnoreHidden(),
-		localfs.WatcherIgnoreFolders(),
-		localfs.WatcherIgnoreExt(ignoredExts...),
-	)
+	watchPaths := appBackendSourceWatchPaths(protoDir)
+	if protoOnly {
+		watchPaths = []string{protoDir}
+	}
+
+	g, ctx := errgroup.WithContext(ctx)
+
+	g.Go(func() error {
+		return localfs.Watch(
+			ctx,
+			watchPaths,
+			localfs.WatcherWorkdir(c.app.Path),
+			localfs.WatcherOnChange(c.refreshServe),
+			localfs.WatcherIgnoreHidden(),
+			localfs.WatcherIgnoreFolders(),
+			localfs.WatcherIgnoreExt(ignoredExts...),
+		)
+	})
+
+	if c.ConfigPath() != "" {
+		configPaths := []string{c.ConfigPath()}
+		if path := c.ConfigProfilePath(); path != "" {
+			configPaths = append(configPaths, path)
+		}
+
+		g.Go(func() error {
+			return localfs.Watch(
+				ctx,
+				configPaths,
+				localfs.WatcherWorkdir(c.app.Path),
+				localfs.WatcherOnChange(c.onConfigChange),
+			)
+		})
+	}
+
+	return g.Wait()
+}
+
+// onConfigChange applies the config changes to the served chain using the smallest
+// possible action. Faucet changes only restart the faucet server while any other
+// change refreshes the serve, which then decides if the state must be reset.
+func (c *Chain) onConfigChange() {
+	served := c.getServedConfig()
+	if served == nil {
+		c.refreshServe()
+		return
+	}
+
+	// Let the serve report the error when the config is not valid
+	conf, err := c.Config()
+	if err != nil {
+		c.refreshServe()
+		return
+	}
+
+	changes, err := chainconfig.Diff(served, conf)
+	if err != nil {
+		c.refreshServe()
+		return
+	}
+
+	switch {
+	case changes.IsEmpty():
+		return
+	case changes.IsFaucetOnly() && served.Faucet.Name != nil && conf.Faucet.Name != nil:
+		c.setServedConfig(conf)
+		c.ev.Send("Faucet config changed, restarting the faucet...", events.ProgressUpdate())
+		c.refreshFaucet()
+	default:
+		c.refreshServe()
+	}
 }
 
 // serve performs the operations to serve the blockchain: build, init and start.
@@ -324,6 +441,7 @@ func (c *Chain) serve(
 	cacheStorage cache.Storage,
 	buildTags []string,
 	forceReset, skipProto, generateClients bool,
+	fromState string,
 ) error {
 	conf, err := c.Config()
 	if err != nil {
@@ -348,20 +466,18 @@ func (c *Chain) serve(
 	if err != nil {
 		return err
 	}
-	if isInit {
-		configModified := false
-		if c.ConfigPath() != "" {
-			configModified, err = dirchange.HasDirChecksumChanged(dirCache, configChecksumKey, c.app.Path, c.ConfigPath())
-			if err != nil {
-				return err
-			}
-		}
 
-		if forceReset || configModified {
-			// if forceReset is set, we consider the app as being not initialized
-			c.ev.Send("Resetting the app state...", events.ProgressUpdate())
-			isInit = false
-		}
+	// determine which config values changed since the last serve
+	configCache := cache.New[chainconfig.Checksums](cacheStorage, serveConfigCacheNamespace)
+	configChanges, err := c.configChanges(configCache, conf)
+	if err != nil {
+		return err
+	}
+
+	if isInit && (forceReset || configChanges.State) {
+		// if forceReset is set, we consider the app as being not initialized
+		c.ev.Send("Resetting the app state...", events.ProgressUpdate())
+		isInit = false
 	}
 
 	// check if source has been modified since last serve
@@ -390,7 +506,8 @@ func (c *Chain) serve(
 		}
 	}
 
-	appModified := sourceModified || binaryModified
+	// other config changes like the build config require the app to be rebuilt
+	appModified := sourceModified || binaryModified || configChanges.Other
 
 	// check if exported genesis exists
 	exportGenesisExists := true
@@ -427,24 +544,41 @@ func (c *Chain) serve(
 		// we reset the chain database and import the genesis state
 		c.ev.Send("Existent genesis detected, restoring the database...", events.ProgressUpdate())
 
-		if err := commands.UnsafeReset(ctx); err != nil {
+		if err := c.resetValidators(ctx, conf); err != nil {
 			return err
 		}
 
-		if err := c.importChainState(); err != nil {
+		if err := c.importChainState(conf); err != nil {
 			return err
 		}
-	} else {
+	} else if fromState == "" {
 		c.ev.Send("Restarting existing app...", events.ProgressUpdate())
 	}
 
-	// save checksums
-	if c.ConfigPath() != "" {
-		if err := dirchange.SaveDirChecksum(dirCache, configChecksumKey, c.app.Path, c.ConfigPath()); err != nil {
+	// when only the node config changed the config files are
+	// updated without resetting the state of the chain.
+	if !initApp && configChanges.Node {
+		c.ev.Send("Updating the node configuration...", events.ProgressUpdate())
+
+		if err := c.configureValidators(conf); err != nil {
 			return err
 		}
 	}
 
+	// restore the named chain state when requested
+	if fromState != "" {
+		c.ev.Send(fmt.Sprintf("Restoring chain state %q...", fromState), events.ProgressUpdate())
+
+		if _, err := c.RestoreState(ctx, fromState); err != nil {
+			return err
+		}
+	}
+
+	// save checksums
+	if err := saveConfigChecksums(configCache, conf); err != nil {
+		return err
+	}
+
 	if err := dirchange.SaveDirChecksum(dirCache, sourceChecksumKey, c.app.Path, sourceWatchPaths...); err != nil {
 		return err
 	}
@@ -469,20 +603,85 @@ func (c *Chain) serve(
 		c.ev.SendView(view, events.ProgressFinish())
 	}
 
-	// start the blockchain
-	return c.start(ctx, conf)
+	// keep the served config to be able to detect which values change
+	c.setServedConfig(conf)
+
+	// start the blockchain and provision it when its state is new
+	return c.start(ctx, conf, initApp && fromState == "")
 }
 
-func (c *Chain) start(ctx context.Context, cfg *chainconfig.Config) error {
+// configChanges returns the changes between the config used during the
+// last serve and the current one. All the config values are considered
+// as changed when there are no config checksums from a previous serve.
+func (c *Chain) configChanges(configCache cache.Cache[chainconfig.Checksums], conf *chainconfig.Config) (chainconfig.Changes, error) {
+	checksums, err := configCache.Get(configChecksumsKey)
+	if errors.Is(err, cache.ErrorNotFound) {
+		return chainconfig.Changes{State: true, Node: true, Faucet: true, Other: true}, nil
+	} else if err != nil {
+		return chainconfig.Changes{}, err
+	}
+
+	current, err := chainconfig.ConfigChecksums(conf)
+	if err != nil {
+		return chainconfig.Changes{}, err
+	}
+
+	return checksums.Changes(current), nil
+}
+
+// saveConfigChecksums saves the checksums of the served config to be able to detect changes on the next serve.
+// Only checksums are saved to avoid storing secrets like the account mnemonics in the cache.
+func saveConfigChecksums(configCache cache.Cache[chainconfig.Checksums], conf *chainconfig.Config) error {
+	checksums, err := chainconfig.ConfigChecksums(conf)
+	if err != nil {
+		return err
+	}
+
+	return configCache.Put(configChecksumsKey, checksums)
+}
+
+// configureValidators updates the node config files of every validator.
+func (c *Chain) configureValidators(cfg *chainconfig.Config) error {
+	for i, validator := range cfg.Validators {
+		home, err := c.ValidatorHome(i)
+		if err != nil {
+			return err
+		}
+
+		if err := c.ConfigureValidator(home, validator); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (c *Chain) start(ctx context.Context, cfg *chainconfig.Config, provision bool) error {
 	commands, err := c.Commands(ctx)
 	if err != nil {
 		return err
 	}
 
+	validator, err := chainconfig.FirstValidator(cfg)
+	if err != nil {
+		return err
+	}
+
 	g, ctx := errgroup.WithContext(ctx)
 
 	// start the blockchain.
-	g.Go(func() error { return c.Start(ctx, commands, cfg) })
+	g.Go(func() error { return c.runValidator(ctx, commands, validator, cfg.Serve) })
+
+	// start the nodes of the other validators
+	for i := 1; i < len(cfg.Validators); i++ {
+		validator := cfg.Validators[i]
+		validatorCommands, err := c.ValidatorCommands(ctx, i)
+		if err != nil {
+			return err
+		}
+
+		g.Go(func() error { return c.runValidator(ctx, validatorCommands, validator, cfg.Serve) })
+	}
 
 	// start the faucet if enabled.
 	faucet, err := c.Faucet(ctx)
@@ -496,22 +695,19 @@ func (c *Chain) start(ctx context.Context, cfg *chainconfig.Config) error {
 			return err
 		}
 
-		g.Go(func() (err error) {
-			if err := c.runFaucetServer(ctx, faucet); err != nil {
-				return &CannotBuildAppError{err}
-			}
-			return nil
+		g.Go(func() error {
+			return c.serveFaucet(ctx, faucet)
 		})
 	}
 
+	// broadcast the provision transactions once the chain is started.
+	if provision {
+		g.Go(func() error { return c.provision(ctx, cfg) })
+	}
+
 	// set the app as being served
 	c.served = true
 
-	validator, err := chainconfig.FirstValidator(cfg)
-	if err != nil {
-		return err
-	}
-
 	servers, err := validator.GetServers()
 	if err != nil {
 		return err
@@ -533,6 +729,20 @@ func (c *Chain) start(ctx context.Context, cfg *chainconfig.Config) error {
 		events.Icon(icons.Earth),
 	)
 
+	for i := 1; i < len(cfg.Validators); i++ {
+		servers, err := cfg.Validators[i].GetServers()
+		if err != nil {
+			return err
+		}
+
+		rpcAddr, _ := xurl.HTTP(servers.RPC.Address)
+
+		c.ev.Send(
+			fmt.Sprintf("Tendermint node (%s): %s", cfg.Validators[i].Name, rpcAddr),
+			events.Icon(icons.Earth),
+		)
+	}
+
 	if isFaucetEnabled {
 		faucetAddr, _ := xurl.HTTP(chainconfig.FaucetHost(cfg))
 
@@ -550,6 +760,15 @@ func (c *Chain) start(ctx context.Context, cfg *chainconfig.Config) error {
 		events.Icon(icons.Bullet),
 		events.Group(EvtGroupPath),
 	)
+	for i := 1; i < len(cfg.Validators); i++ {
+		validatorHome, _ := c.ValidatorHome(i)
+
+		c.ev.Send(
+			fmt.Sprintf("Data directory (%s): %s", cfg.Validators[i].Name, colors.Faint(validatorHome)),
+			events.Icon(icons.Bullet),
+			events.Group(EvtGroupPath),
+		)
+	}
 	c.ev.Send(
 		fmt.Sprintf("App binary: %s", colors.Faint(appBin)),
 		events.Icon(icons.Bullet),
@@ -559,6 +778,59 @@ func (c *Chain) start(ctx context.Context, cfg *chainconfig.Config) error {
 	return g.Wait()
 }
 
+// serveFaucet runs the faucet server and restarts it with
+// the latest faucet config every time the faucet is refreshed.
+func (c *Chain) serveFaucet(ctx context.Context, faucet cosmosfaucet.Faucet) error {
+	// discard pending refreshes from previous serves
+	select {
+	case <-c.faucetRefresher:
+	default:
+	}
+
+	for {
+		faucetCtx, cancel := context.WithCancel(ctx)
+		errc := make(chan error, 1)
+
+		go func() {
+			errc <- c.runFaucetServer(faucetCtx, faucet)
+		}()
+
+		select {
+		case <-ctx.Done():
+			cancel()
+			return <-errc
+		case err := <-errc:
+			cancel()
+			if err != nil {
+				return &CannotBuildAppError{err}
+			}
+			return nil
+		case <-c.faucetRefresher:
+			cancel()
+			if err := <-errc; err != nil {
+				return &CannotBuildAppError{err}
+			}
+		}
+
+		var err error
+		if faucet, err = c.Faucet(ctx); err != nil {
+			return &CannotBuildAppError{err}
+		}
+
+		cfg, err := c.Config()
+		if err != nil {
+			return &CannotBuildAppError{err}
+		}
+
+		faucetAddr, _ := xurl.HTTP(chainconfig.FaucetHost(cfg))
+		c.ev.Send(
+			fmt.Sprintf("Token faucet restarted: %s", faucetAddr),
+			events.Icon(icons.Earth),
+			events.ProgressFinish(),
+		)
+	}
+}
+
 func (c *Chain) runFaucetServer(ctx context.Context, faucet cosmosfaucet.Faucet) error {
 	cfg, err := c.Config()
 	if err != nil {
@@ -581,18 +853,47 @@ func (c *Chain) saveChainState(ctx context.Context, commands chaincmdrunner.Runn
 	return commands.Export(ctx, genesisPath)
 }
 
-// importChainState imports the saved genesis in chain config to use it as the genesis.
-func (c *Chain) importChainState() error {
+// importChainState imports the saved genesis in chain config to use it as the genesis
+// of every validator node.
+func (c *Chain) importChainState(cfg *chainconfig.Config) error {
 	exportGenesisPath, err := c.exportedGenesisPath()
 	if err != nil {
 		return err
 	}
-	genesisPath, err := c.GenesisPath()
-	if err != nil {
-		return err
+
+	return c.importGenesis(cfg, exportGenesisPath)
+}
+
+// importGenesis copies a genesis file to the home of every validator node.
+func (c *Chain) importGenesis(cfg *chainconfig.Config, genesisPath string) error {
+	for i := 0; i < max(len(cfg.Validators), 1); i++ {
+		home, err := c.ValidatorHome(i)
+		if err != nil {
+			return err
+		}
+
+		if err := copy.Copy(genesisPath, filepath.Join(home, genesisFile)); err != nil {
+			return err
+		}
 	}
 
-	return copy.Copy(exportGenesisPath, genesisPath)
+	return nil
+}
+
+// resetValidators resets the database of every validator node.
+func (c *Chain) resetValidators(ctx context.Context, cfg *chainconfig.Config) error {
+	for i := 0; i < max(len(cfg.Validators), 1); i++ {
+		commands, err := c.ValidatorCommands(ctx, i)
+		if err != nil {
+			return err
+		}
+
+		if err := commands.UnsafeReset(ctx); err != nil {
+			return err
+		}
+	}
+
+	return nil
 }
 
 // chainSavePath returns the path where the chain state is saved.
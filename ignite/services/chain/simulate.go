@@ -2,8 +2,32 @@ package chain
 
 import (
 	"context"
+	"fmt"
+	"go/parser"
+	"go/token"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync/atomic"
+	"time"
 
 	"github.com/cosmos/cosmos-sdk/types/simulation"
+	"golang.org/x/sync/errgroup"
+
+	"github.com/ignite/cli/v29/ignite/pkg/chaincmd"
+	chaincmdrunner "github.com/ignite/cli/v29/ignite/pkg/chaincmd/runner"
+	"github.com/ignite/cli/v29/ignite/pkg/errors"
+	"github.com/ignite/cli/v29/ignite/pkg/events"
+	"github.com/ignite/cli/v29/ignite/pkg/simcampaign"
+)
+
+const (
+	simulationTestDir    = "app"
+	simulationLogFile    = "simulation.log"
+	simulationStatsFile  = "stats.json"
+	simulationParamsFile = "params.json"
 )
 
 type simappOptions struct {
@@ -12,6 +36,8 @@ type simappOptions struct {
 	config      simulation.Config
 	period      uint
 	genesisTime int64
+	mode        chaincmd.SimulationMode
+	runs        int
 }
 
 func newSimappOptions() simappOptions {
@@ -23,6 +49,7 @@ func newSimappOptions() simappOptions {
 		verbose:     false,
 		period:      0,
 		genesisTime: 0,
+		mode:        chaincmd.SimulationModeFull,
 	}
 }
 
@@ -57,6 +84,20 @@ func SimappWithConfig(config simulation.Config) SimappOption {
 	}
 }
 
+// SimappWithMode allows to run a different simulation mode instead of the full app simulation.
+func SimappWithMode(mode chaincmd.SimulationMode) SimappOption {
+	return func(c *simappOptions) {
+		c.mode = mode
+	}
+}
+
+// SimappWithRuns sets the number of times that the determinism simulation runs with the same seed.
+func SimappWithRuns(runs int) SimappOption {
+	return func(c *simappOptions) {
+		c.runs = runs
+	}
+}
+
 func (c *Chain) Simulate(ctx context.Context, options ...SimappOption) error {
 	simappOptions := newSimappOptions()
 
@@ -65,6 +106,10 @@ func (c *Chain) Simulate(ctx context.Context, options ...SimappOption) error {
 		apply(&simappOptions)
 	}
 
+	if err := c.checkSimulationMode(simappOptions.mode); err != nil {
+		return err
+	}
+
 	commands, err := c.Commands(ctx)
 	if err != nil {
 		return err
@@ -76,5 +121,180 @@ func (c *Chain) Simulate(ctx context.Context, options ...SimappOption) error {
 		simappOptions.config,
 		simappOptions.period,
 		simappOptions.genesisTime,
+		simappOptions.mode,
+		simappOptions.modeOptions()...,
+	)
+}
+
+// modeOptions returns the options of the simulation command that are specific to the simulation mode.
+func (o simappOptions) modeOptions() []chaincmd.SimappOption {
+	if o.mode == chaincmd.SimulationModeDeterminism {
+		return []chaincmd.SimappOption{chaincmd.SimappWithNumTimesToRunPerSeed(o.runs)}
+	}
+	return nil
+}
+
+// checkSimulationMode checks that the app has the simulation test of the mode. Without
+// the check the simulation would pass without running any test.
+func (c *Chain) checkSimulationMode(mode chaincmd.SimulationMode) error {
+	test := mode.Test()
+	if test == "" {
+		return nil
+	}
+
+	dir := filepath.Join(c.app.Path, simulationTestDir)
+	pkgs, err := parser.ParseDir(token.NewFileSet(), dir, func(fi fs.FileInfo) bool {
+		return strings.HasSuffix(fi.Name(), "_test.go")
+	}, 0)
+	if err != nil {
+		return err
+	}
+
+	for _, pkg := range pkgs {
+		for _, f := range pkg.Files {
+			if f.Scope.Lookup(test) != nil {
+				return nil
+			}
+		}
+	}
+
+	return errors.Errorf(
+		"the %s simulation mode runs the %s test, which is missing in the %s directory of the app",
+		mode,
+		test,
+		simulationTestDir,
+	)
+}
+
+// SimulateCampaign runs the simulation of the chain with each seed, running up to parallel
+// simulations at the same time. The output, the operation stats and the randomly generated
+// params of each simulation are saved in a subdirectory of dir named after the seed.
+// Simulations that fail don't stop the campaign, their failures are reported in the results.
+func (c *Chain) SimulateCampaign(
+	ctx context.Context,
+	seeds []int64,
+	parallel int,
+	dir string,
+	options ...SimappOption,
+) (simcampaign.Campaign, error) {
+	simappOptions := newSimappOptions()
+
+	// apply the options
+	for _, apply := range options {
+		apply(&simappOptions)
+	}
+
+	commands, err := c.Commands(ctx)
+	if err != nil {
+		return simcampaign.Campaign{}, err
+	}
+
+	if err := c.checkSimulationMode(simappOptions.mode); err != nil {
+		return simcampaign.Campaign{}, err
+	}
+
+	var (
+		campaign = simcampaign.Campaign{Runs: make([]simcampaign.Run, len(seeds))}
+		done     atomic.Int32
+	)
+
+	g, gCtx := errgroup.WithContext(ctx)
+	g.SetLimit(max(parallel, 1))
+
+	for i, seed := range seeds {
+		i, seed := i, seed
+
+		g.Go(func() error {
+			run, err := c.simulateSeed(gCtx, commands, simappOptions, seed, filepath.Join(dir, fmt.Sprintf("seed-%d", seed)))
+			if err != nil {
+				return errors.Errorf("cannot run the simulation with seed %d: %w", seed, err)
+			}
+
+			campaign.Runs[i] = run
+
+			c.ev.Send(
+				fmt.Sprintf("Simulated %d of %d seeds", done.Add(1), len(seeds)),
+				events.ProgressUpdate(),
+			)
+
+			return nil
+		})
+	}
+
+	if err := g.Wait(); err != nil {
+		return simcampaign.Campaign{}, err
+	}
+
+	return campaign, nil
+}
+
+// simulateSeed runs the simulation with a seed and saves its output and exported files in dir.
+func (c *Chain) simulateSeed(
+	ctx context.Context,
+	commands chaincmdrunner.Runner,
+	o simappOptions,
+	seed int64,
+	dir string,
+) (simcampaign.Run, error) {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return simcampaign.Run{}, err
+	}
+
+	logPath := filepath.Join(dir, simulationLogFile)
+	log, err := os.Create(logPath)
+	if err != nil {
+		return simcampaign.Run{}, err
+	}
+	defer log.Close()
+
+	config := o.config
+	config.Seed = seed
+	config.ExportStatsPath = filepath.Join(dir, simulationStatsFile)
+	config.ExportParamsPath = filepath.Join(dir, simulationParamsFile)
+
+	start := time.Now()
+	simErr := commands.SimulationWithOutput(
+		ctx,
+		log,
+		c.app.Path,
+		o.enabled,
+		o.verbose,
+		config,
+		o.period,
+		o.genesisTime,
+		o.mode,
+		o.modeOptions()...,
 	)
+	run := simcampaign.Run{
+		Seed:     seed,
+		Passed:   simErr == nil,
+		Duration: time.Since(start),
+		Dir:      dir,
+	}
+
+	// A canceled context is not a failure of the simulation
+	if ctx.Err() != nil {
+		return simcampaign.Run{}, ctx.Err()
+	}
+
+	// Stats that can't be read fail the seed instead of the whole campaign
+	// so the other seeds are simulated and the summary is still written
+	if run.Stats, err = simcampaign.LoadStats(config.ExportStatsPath); err != nil && !errors.Is(err, os.ErrNotExist) {
+		run.Passed = false
+		run.Failure = fmt.Sprintf("cannot read the simulation stats: %s", err)
+	}
+
+	if simErr == nil {
+		return run, nil
+	}
+
+	if _, err := log.Seek(0, io.SeekStart); err != nil {
+		return simcampaign.Run{}, err
+	}
+
+	if run.BrokenInvariants, run.Failure, err = simcampaign.ParseLog(log); err != nil {
+		return simcampaign.Run{}, err
+	}
+
+	return run, nil
 }
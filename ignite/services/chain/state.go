@@ -0,0 +1,308 @@
+package chain
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/ignite/cli/v29/ignite/pkg/errors"
+)
+
+const (
+	// statesDir is the name of the directory where the named chain states are saved.
+	statesDir = "states"
+
+	// stateGenesisFile is the name of the exported genesis file of a named chain state.
+	stateGenesisFile = "genesis.json"
+
+	// stateMetadataFile is the name of the metadata file of a named chain state.
+	stateMetadataFile = "state.json"
+)
+
+var (
+	// ErrStateNotFound is returned when a named chain state doesn't exist.
+	ErrStateNotFound = errors.New("chain state not found")
+
+	stateNameRe = regexp.MustCompile(`^[a-zA-Z0-9][a-zA-Z0-9_.-]*$`)
+)
+
+// State contains the metadata of a named chain state.
+type State struct {
+	// Name is the name of the state.
+	Name string `json:"name"`
+
+	// Height is the block height at which the state was exported.
+	Height int64 `json:"height"`
+
+	// AppVersion is the version of the app that exported the state.
+	AppVersion string `json:"app_version,omitempty"`
+
+	// SourceHash is the hash of the source commit of the app that exported the state.
+	SourceHash string `json:"source_hash,omitempty"`
+
+	// CreatedAt is the time when the state was saved.
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// SaveState exports the current state of the chain and saves it using a name.
+// An existing state with the same name is overwritten.
+// The chain must not be running while the state is exported.
+func (c *Chain) SaveState(ctx context.Context, name string) (State, error) {
+	if err := validateStateName(name); err != nil {
+		return State{}, err
+	}
+
+	statePath, err := c.statePath(name)
+	if err != nil {
+		return State{}, err
+	}
+
+	commands, err := c.Commands(ctx)
+	if err != nil {
+		return State{}, err
+	}
+
+	// Export the state into a temporary directory next to the final one so a
+	// previously saved state with the same name is kept when the export fails.
+	statesPath := filepath.Dir(statePath)
+	if err := os.MkdirAll(statesPath, 0o755); err != nil {
+		return State{}, err
+	}
+
+	tmpPath, err := os.MkdirTemp(statesPath, "."+name+"-*")
+	if err != nil {
+		return State{}, err
+	}
+	defer os.RemoveAll(tmpPath)
+
+	genesisPath := filepath.Join(tmpPath, stateGenesisFile)
+	if err := commands.Export(ctx, genesisPath); err != nil {
+		return State{}, err
+	}
+
+	height, appVersion, err := readExportedGenesisInfo(genesisPath)
+	if err != nil {
+		return State{}, err
+	}
+
+	if appVersion == "" {
+		appVersion = c.sourceVersion.tag
+	}
+
+	state := State{
+		Name:       name,
+		Height:     height,
+		AppVersion: appVersion,
+		SourceHash: c.sourceVersion.hash,
+		CreatedAt:  time.Now().UTC(),
+	}
+
+	bz, err := json.MarshalIndent(state, "", "  ")
+	if err != nil {
+		return State{}, err
+	}
+
+	if err := os.WriteFile(filepath.Join(tmpPath, stateMetadataFile), bz, 0o644); err != nil {
+		return State{}, err
+	}
+
+	if err := replaceDir(tmpPath, statePath); err != nil {
+		return State{}, err
+	}
+
+	return state, nil
+}
+
+// States returns the named chain states sorted by creation time.
+func (c *Chain) States() ([]State, error) {
+	savePath, err := c.chainSavePath()
+	if err != nil {
+		return nil, err
+	}
+
+	entries, err := os.ReadDir(filepath.Join(savePath, statesDir))
+	if os.IsNotExist(err) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	var states []State
+	for _, entry := range entries {
+		// Skip the temporary directories of the states being saved
+		if !entry.IsDir() || strings.HasPrefix(entry.Name(), ".") {
+			continue
+		}
+
+		state, err := readState(filepath.Join(savePath, statesDir, entry.Name()))
+		if errors.Is(err, ErrStateNotFound) {
+			continue
+		} else if err != nil {
+			return nil, err
+		}
+
+		states = append(states, state)
+	}
+
+	sort.SliceStable(states, func(i, j int) bool {
+		return states[i].CreatedAt.Before(states[j].CreatedAt)
+	})
+
+	return states, nil
+}
+
+// RestoreState resets the database of the chain validators and uses
+// the genesis of a named chain state as their genesis.
+// The chain must be initialized before restoring a state.
+func (c *Chain) RestoreState(ctx context.Context, name string) (State, error) {
+	if err := validateStateName(name); err != nil {
+		return State{}, err
+	}
+
+	statePath, err := c.statePath(name)
+	if err != nil {
+		return State{}, err
+	}
+
+	state, err := readState(statePath)
+	if err != nil {
+		return State{}, err
+	}
+
+	isInit, err := c.IsInitialized()
+	if err != nil {
+		return State{}, err
+	}
+	if !isInit {
+		return State{}, errors.New("the chain must be initialized before restoring a state")
+	}
+
+	cfg, err := c.Config()
+	if err != nil {
+		return State{}, err
+	}
+
+	if err := c.resetValidators(ctx, cfg); err != nil {
+		return State{}, err
+	}
+
+	genesisPath := filepath.Join(statePath, stateGenesisFile)
+	if err := c.importGenesis(cfg, genesisPath); err != nil {
+		return State{}, err
+	}
+
+	// Use the restored state as the exported genesis too to keep
+	// it when the state is imported after a source code change.
+	exportedGenesisPath, err := c.exportedGenesisPath()
+	if err != nil {
+		return State{}, err
+	}
+
+	bz, err := os.ReadFile(genesisPath)
+	if err != nil {
+		return State{}, err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(exportedGenesisPath), 0o755); err != nil {
+		return State{}, err
+	}
+
+	return state, os.WriteFile(exportedGenesisPath, bz, 0o644)
+}
+
+// statePath returns the path of the directory of a named chain state.
+func (c *Chain) statePath(name string) (string, error) {
+	savePath, err := c.chainSavePath()
+	if err != nil {
+		return "", err
+	}
+
+	return filepath.Join(savePath, statesDir, name), nil
+}
+
+// replaceDir moves the src directory to dst replacing the existing dst directory.
+// The existing directory is only removed once src is moved into place.
+func replaceDir(src, dst string) error {
+	backupPath := fmt.Sprintf("%s.old", src)
+	if err := os.Rename(dst, backupPath); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+
+	if err := os.Rename(src, dst); err != nil {
+		// Restore the previous directory when the new one can't be moved
+		_ = os.Rename(backupPath, dst)
+		return err
+	}
+
+	return os.RemoveAll(backupPath)
+}
+
+func readState(statePath string) (State, error) {
+	bz, err := os.ReadFile(filepath.Join(statePath, stateMetadataFile))
+	if os.IsNotExist(err) {
+		return State{}, errors.Wrap(ErrStateNotFound, filepath.Base(statePath))
+	} else if err != nil {
+		return State{}, err
+	}
+
+	if _, err := os.Stat(filepath.Join(statePath, stateGenesisFile)); os.IsNotExist(err) {
+		return State{}, errors.Wrap(ErrStateNotFound, filepath.Base(statePath))
+	} else if err != nil {
+		return State{}, err
+	}
+
+	var state State
+	if err := json.Unmarshal(bz, &state); err != nil {
+		return State{}, errors.Errorf("invalid chain state metadata: %w", err)
+	}
+
+	return state, nil
+}
+
+// readExportedGenesisInfo reads the block height and the app version of an exported genesis.
+func readExportedGenesisInfo(path string) (height int64, appVersion string, err error) {
+	bz, err := os.ReadFile(path)
+	if err != nil {
+		return 0, "", err
+	}
+
+	var genesis struct {
+		AppVersion    string          `json:"app_version"`
+		InitialHeight json.RawMessage `json:"initial_height"`
+	}
+	if err := json.Unmarshal(bz, &genesis); err != nil {
+		return 0, "", errors.Errorf("invalid exported genesis: %w", err)
+	}
+
+	// Initial height can be either a number or a string depending on the genesis format
+	initialHeight := strings.Trim(string(genesis.InitialHeight), `"`)
+	if initialHeight == "" {
+		return 0, genesis.AppVersion, nil
+	}
+
+	v, err := strconv.ParseInt(initialHeight, 10, 64)
+	if err != nil {
+		return 0, "", errors.Errorf("invalid exported genesis initial height: %w", err)
+	}
+
+	// The exported genesis initial height is the next height of the exported state
+	if v > 0 {
+		v--
+	}
+
+	return v, genesis.AppVersion, nil
+}
+
+func validateStateName(name string) error {
+	if !stateNameRe.MatchString(name) {
+		return errors.Errorf("invalid chain state name %q, only letters, numbers, '.', '_' and '-' are allowed", name)
+	}
+	return nil
+}
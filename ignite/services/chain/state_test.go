@@ -0,0 +1,117 @@
+package chain
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestReadExportedGenesisInfo(t *testing.T) {
+	tests := []struct {
+		name           string
+		genesis        string
+		wantHeight     int64
+		wantAppVersion string
+		wantErr        bool
+	}{
+		{
+			name:           "numeric initial height",
+			genesis:        `{"app_version":"v1.0.0","initial_height":43}`,
+			wantHeight:     42,
+			wantAppVersion: "v1.0.0",
+		},
+		{
+			name:       "string initial height",
+			genesis:    `{"initial_height":"11"}`,
+			wantHeight: 10,
+		},
+		{
+			name:       "missing initial height",
+			genesis:    `{"chain_id":"mars"}`,
+			wantHeight: 0,
+		},
+		{
+			name:    "invalid initial height",
+			genesis: `{"initial_height":"foo"}`,
+			wantErr: true,
+		},
+		{
+			name:    "invalid genesis",
+			genesis: `{`,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "genesis.json")
+			require.NoError(t, os.WriteFile(path, []byte(tt.genesis), 0o644))
+
+			height, appVersion, err := readExportedGenesisInfo(path)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tt.wantHeight, height)
+			require.Equal(t, tt.wantAppVersion, appVersion)
+		})
+	}
+}
+
+func TestValidateStateName(t *testing.T) {
+	require.NoError(t, validateStateName("after-gov_proposal.1"))
+	require.Error(t, validateStateName(""))
+	require.Error(t, validateStateName("../foo"))
+	require.Error(t, validateStateName("foo/bar"))
+	require.Error(t, validateStateName(".hidden"))
+}
+
+func TestReadState(t *testing.T) {
+	dir := t.TempDir()
+
+	_, err := readState(dir)
+	require.ErrorIs(t, err, ErrStateNotFound)
+
+	require.NoError(t, os.WriteFile(filepath.Join(dir, stateMetadataFile), []byte(`{"name":"foo","height":5}`), 0o644))
+	_, err = readState(dir)
+	require.ErrorIs(t, err, ErrStateNotFound)
+
+	require.NoError(t, os.WriteFile(filepath.Join(dir, stateGenesisFile), []byte(`{}`), 0o644))
+	state, err := readState(dir)
+	require.NoError(t, err)
+	require.Equal(t, "foo", state.Name)
+	require.EqualValues(t, 5, state.Height)
+}
+
+func TestReplaceDir(t *testing.T) {
+	var (
+		dir = t.TempDir()
+		src = filepath.Join(dir, ".new")
+		dst = filepath.Join(dir, "state")
+	)
+
+	// Replace a directory that doesn't exist yet
+	require.NoError(t, os.Mkdir(src, 0o755))
+	require.NoError(t, os.WriteFile(filepath.Join(src, stateMetadataFile), []byte("1"), 0o644))
+	require.NoError(t, replaceDir(src, dst))
+
+	bz, err := os.ReadFile(filepath.Join(dst, stateMetadataFile))
+	require.NoError(t, err)
+	require.Equal(t, "1", string(bz))
+
+	// Replace an existing directory
+	require.NoError(t, os.Mkdir(src, 0o755))
+	require.NoError(t, os.WriteFile(filepath.Join(src, stateMetadataFile), []byte("2"), 0o644))
+	require.NoError(t, replaceDir(src, dst))
+
+	bz, err = os.ReadFile(filepath.Join(dst, stateMetadataFile))
+	require.NoError(t, err)
+	require.Equal(t, "2", string(bz))
+
+	entries, err := os.ReadDir(dir)
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+}
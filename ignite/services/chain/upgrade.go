@@ -0,0 +1,499 @@
+package chain
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	upgradetypes "cosmossdk.io/x/upgrade/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+	"golang.org/x/sync/errgroup"
+
+	chainconfig "github.com/ignite/cli/v29/ignite/config/chain"
+	"github.com/ignite/cli/v29/ignite/pkg/cache"
+	"github.com/ignite/cli/v29/ignite/pkg/cliui/icons"
+	"github.com/ignite/cli/v29/ignite/pkg/cosmosaccount"
+	"github.com/ignite/cli/v29/ignite/pkg/cosmosclient"
+	"github.com/ignite/cli/v29/ignite/pkg/cosmosutil"
+	"github.com/ignite/cli/v29/ignite/pkg/cosmovisor"
+	"github.com/ignite/cli/v29/ignite/pkg/errors"
+	"github.com/ignite/cli/v29/ignite/pkg/events"
+	"github.com/ignite/cli/v29/ignite/pkg/xstrings"
+	"github.com/ignite/cli/v29/ignite/pkg/xurl"
+)
+
+const (
+	// DefaultUpgradeHeightOffset is the default number of blocks between
+	// the submission of the upgrade proposal and the upgrade height.
+	DefaultUpgradeHeightOffset = 20
+
+	// DefaultUpgradeVotingPeriod is the default voting period of the upgrade proposal.
+	DefaultUpgradeVotingPeriod = 10 * time.Second
+
+	// upgradeVerifyBlocks is the number of blocks that the upgraded chain must
+	// produce after the upgrade height for the upgrade to succeed.
+	upgradeVerifyBlocks = 3
+
+	// upgradeVerifyTimeout is the time to wait for the upgraded chain to produce blocks.
+	upgradeVerifyTimeout = 2 * time.Minute
+
+	// upgradeRetryInterval is the time to wait between checks of the upgrade progress.
+	upgradeRetryInterval = time.Second
+)
+
+type (
+	// UpgradeOption configures the rehearsal of a chain upgrade.
+	UpgradeOption func(*upgradeOptions)
+
+	upgradeOptions struct {
+		heightOffset int64
+		votingPeriod time.Duration
+		buildTags    []string
+	}
+
+	// upgradeHalt is the upgrade info written by the node when it halts at the upgrade height.
+	upgradeHalt struct {
+		Name   string `json:"name"`
+		Height int64  `json:"height"`
+	}
+)
+
+// UpgradeHeightOffset sets the number of blocks between the submission
+// of the upgrade proposal and the upgrade height.
+func UpgradeHeightOffset(offset int64) UpgradeOption {
+	return func(o *upgradeOptions) {
+		o.heightOffset = offset
+	}
+}
+
+// UpgradeVotingPeriod sets the voting period of the upgrade proposal.
+func UpgradeVotingPeriod(period time.Duration) UpgradeOption {
+	return func(o *upgradeOptions) {
+		o.votingPeriod = period
+	}
+}
+
+// UpgradeBuildTags sets the build tags used to build the binaries of both versions of the chain.
+func UpgradeBuildTags(buildTags ...string) UpgradeOption {
+	return func(o *upgradeOptions) {
+		o.buildTags = buildTags
+	}
+}
+
+// RehearseUpgrade rehearses an in-place upgrade from a previous version of the
+// chain to the version of c using dir as workspace.
+// The binaries of both versions are built and the previous version is initialized
+// like when the chain is served. Once it's started, a software upgrade proposal is
+// submitted and voted by the config validators. When the chain halts at the upgrade
+// height the nodes are restarted with the binary of c, and the upgrade succeeds
+// when the upgraded chain keeps producing blocks.
+func (c *Chain) RehearseUpgrade(
+	ctx context.Context,
+	cacheStorage cache.Storage,
+	from *Chain,
+	name, dir string,
+	options ...UpgradeOption,
+) error {
+	o := upgradeOptions{
+		heightOffset: DefaultUpgradeHeightOffset,
+		votingPeriod: DefaultUpgradeVotingPeriod,
+	}
+	for _, apply := range options {
+		apply(&o)
+	}
+
+	if name == "" {
+		return errors.New("upgrade name can't be empty")
+	}
+	if o.heightOffset <= 0 {
+		return errors.New("upgrade height offset must be positive")
+	}
+	if o.votingPeriod <= 0 {
+		return errors.New("upgrade voting period must be positive")
+	}
+
+	// Both versions of the chain run the same nodes
+	home := filepath.Join(dir, "home")
+	from.SetHome(home)
+	c.SetHome(home)
+
+	c.ev.Send("Building the chain version to upgrade from...", events.ProgressStart())
+
+	if err := from.buildBinaryTo(ctx, cacheStorage, o.buildTags, filepath.Join(dir, "from")); err != nil {
+		return err
+	}
+
+	c.ev.Send("Building the chain version to upgrade to...", events.ProgressStart())
+
+	if err := c.buildBinaryTo(ctx, cacheStorage, o.buildTags, filepath.Join(dir, "to")); err != nil {
+		return err
+	}
+
+	fromCfg, err := from.Config()
+	if err != nil {
+		return err
+	}
+
+	c.ev.Send("Initializing the chain...", events.ProgressStart())
+
+	if err := from.initUpgrade(ctx, fromCfg, o.votingPeriod); err != nil {
+		return err
+	}
+
+	var height int64
+	err = from.runNodes(ctx, fromCfg, func(ctx context.Context) error {
+		if height, err = from.proposeUpgrade(ctx, fromCfg, name, o.heightOffset); err != nil {
+			return err
+		}
+
+		c.ev.Send(fmt.Sprintf("Waiting for the chain to halt at height %d...", height), events.ProgressStart())
+
+		return waitForUpgradeHalt(ctx, home, name)
+	})
+
+	// The node can exit when it halts at the upgrade height
+	if err != nil && (height == 0 || !isUpgradeHalted(home, name)) {
+		return err
+	}
+
+	c.ev.Send(
+		fmt.Sprintf("Chain halted at upgrade height %d", height),
+		events.Icon(icons.OK),
+		events.ProgressFinish(),
+	)
+
+	cfg, err := c.Config()
+	if err != nil {
+		return err
+	}
+
+	c.ev.Send("Starting the upgraded chain...", events.ProgressStart())
+
+	err = c.runNodes(ctx, cfg, func(ctx context.Context) error {
+		ctx, cancel := context.WithTimeout(ctx, upgradeVerifyTimeout)
+		defer cancel()
+
+		client, _, err := c.nodeClient(ctx, cfg)
+		if err != nil {
+			return err
+		}
+
+		return client.WaitForBlockHeight(ctx, height+upgradeVerifyBlocks)
+	})
+	if err != nil {
+		return errors.Errorf("upgraded chain doesn't produce blocks: %w", err)
+	}
+
+	c.ev.Send(
+		fmt.Sprintf("Upgrade %s applied, the chain is producing blocks", name),
+		events.Icon(icons.OK),
+		events.ProgressFinish(),
+	)
+
+	return nil
+}
+
+// buildBinaryTo builds the chain binary in the output directory
+// and uses it to run the chain commands.
+func (c *Chain) buildBinaryTo(ctx context.Context, cacheStorage cache.Storage, buildTags []string, output string) error {
+	binary, err := c.Build(ctx, cacheStorage, buildTags, output, false, false)
+	if err != nil {
+		return err
+	}
+
+	c.options.binaryPath = filepath.Join(output, binary)
+
+	return nil
+}
+
+// initUpgrade initializes the chain like when it's served, and shortens the voting
+// period of the governance proposals to be able to vote the upgrade proposal.
+func (c *Chain) initUpgrade(ctx context.Context, cfg *chainconfig.Config, votingPeriod time.Duration) error {
+	if len(cfg.Validators) == 0 {
+		return errors.New("at least one validator is required to rehearse the upgrade")
+	}
+
+	if err := c.Init(ctx, InitArgsAll); err != nil {
+		return err
+	}
+
+	// The voting period of expedited proposals must be shorter than the regular one
+	err := c.UpdateGenesisFile(map[string]interface{}{
+		"app_state": map[string]interface{}{
+			"gov": map[string]interface{}{
+				"params": map[string]interface{}{
+					"voting_period":           votingPeriod.String(),
+					"expedited_voting_period": (votingPeriod / 2).String(),
+				},
+			},
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	return c.shareGenesis(cfg)
+}
+
+// runNodes starts the nodes of the config validators and calls fn while they are running.
+// The nodes are stopped when fn returns. An error is returned when a node stops before.
+func (c *Chain) runNodes(ctx context.Context, cfg *chainconfig.Config, fn func(context.Context) error) error {
+	nodeCtx, stop := context.WithCancel(ctx)
+	defer stop()
+
+	g, gCtx := errgroup.WithContext(nodeCtx)
+
+	for i, validator := range cfg.Validators {
+		commands, err := c.ValidatorCommands(ctx, i)
+		if err != nil {
+			return err
+		}
+
+		validator := validator
+		g.Go(func() error {
+			return c.StartValidator(gCtx, commands, validator)
+		})
+	}
+
+	err := fn(gCtx)
+
+	// The nodes context is only canceled before stopping the nodes when a node exits
+	exited := gCtx.Err() != nil && ctx.Err() == nil
+
+	stop()
+	nodeErr := g.Wait()
+
+	if exited {
+		return errors.Errorf("node stopped unexpectedly: %w", nodeErr)
+	}
+
+	return err
+}
+
+// nodeClient creates a client for the node of the first validator once its first block is committed.
+// The client uses the keyring of the first validator, which contains the keys of all the validators.
+// The address prefix of the chain is returned with the client.
+func (c *Chain) nodeClient(ctx context.Context, cfg *chainconfig.Config) (cosmosclient.Client, string, error) {
+	validator, err := chainconfig.FirstValidator(cfg)
+	if err != nil {
+		return cosmosclient.Client{}, "", err
+	}
+
+	servers, err := validator.GetServers()
+	if err != nil {
+		return cosmosclient.Client{}, "", err
+	}
+
+	rpcAddr, err := xurl.HTTP(servers.RPC.Address)
+	if err != nil {
+		return cosmosclient.Client{}, "", errors.Errorf("invalid rpc address format: %w", err)
+	}
+
+	commands, err := c.Commands(ctx)
+	if err != nil {
+		return cosmosclient.Client{}, "", err
+	}
+
+	// The address prefix of the chain is read from the address of the first validator
+	account, err := commands.ShowAccount(ctx, validator.Name)
+	if err != nil {
+		return cosmosclient.Client{}, "", err
+	}
+
+	prefix, err := cosmosutil.GetAddressPrefix(account.Address)
+	if err != nil {
+		return cosmosclient.Client{}, "", err
+	}
+
+	home, err := c.Home()
+	if err != nil {
+		return cosmosclient.Client{}, "", err
+	}
+
+	backend, err := c.KeyringBackend()
+	if err != nil {
+		return cosmosclient.Client{}, "", err
+	}
+
+	client, err := waitForFirstBlock(
+		ctx,
+		cosmosclient.WithNodeAddress(rpcAddr),
+		cosmosclient.WithAddressPrefix(prefix),
+		cosmosclient.WithHome(home),
+		cosmosclient.WithKeyringDir(home),
+		cosmosclient.WithKeyringBackend(cosmosaccount.KeyringBackend(backend)),
+		cosmosclient.WithKeyringServiceName(xstrings.Title(c.app.Name)),
+		cosmosclient.WithGas(cosmosclient.GasAuto),
+	)
+
+	return client, prefix, err
+}
+
+// proposeUpgrade submits the software upgrade proposal using the account of the
+// first validator, votes yes with the accounts of all the validators and waits
+// for the proposal to pass. The upgrade height of the plan is returned.
+func (c *Chain) proposeUpgrade(ctx context.Context, cfg *chainconfig.Config, name string, heightOffset int64) (int64, error) {
+	c.ev.Send("Waiting for the first block to propose the upgrade...", events.ProgressStart())
+
+	client, prefix, err := c.nodeClient(ctx, cfg)
+	if err != nil {
+		return 0, err
+	}
+
+	authority, err := cosmosutil.ModuleAddress("gov", prefix)
+	if err != nil {
+		return 0, err
+	}
+
+	proposer, err := client.Account(cfg.Validators[0].Name)
+	if err != nil {
+		return 0, err
+	}
+
+	proposerAddr, err := proposer.Address(prefix)
+	if err != nil {
+		return 0, err
+	}
+
+	gov := govv1.NewQueryClient(client.Context())
+
+	params, err := gov.Params(ctx, &govv1.QueryParamsRequest{})
+	if err != nil {
+		return 0, err
+	}
+
+	height, err := client.LatestBlockHeight(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	plan := upgradetypes.Plan{Name: name, Height: height + heightOffset}
+	msg, err := govv1.NewMsgSubmitProposal(
+		[]sdk.Msg{&upgradetypes.MsgSoftwareUpgrade{Authority: authority, Plan: plan}},
+		params.Params.MinDeposit,
+		proposerAddr,
+		"",
+		fmt.Sprintf("Upgrade %s", name),
+		fmt.Sprintf("Software upgrade %s at height %d", name, plan.Height),
+		false,
+	)
+	if err != nil {
+		return 0, err
+	}
+
+	c.ev.Send(fmt.Sprintf("Proposing upgrade %s at height %d...", name, plan.Height), events.ProgressStart())
+
+	res, err := client.BroadcastTx(ctx, proposer, msg)
+	if err != nil {
+		return 0, errors.Errorf("cannot submit the upgrade proposal: %w", err)
+	}
+
+	var proposal govv1.MsgSubmitProposalResponse
+	if err := res.Decode(&proposal); err != nil {
+		return 0, err
+	}
+
+	c.ev.Send(fmt.Sprintf("Voting upgrade proposal %d...", proposal.ProposalId), events.ProgressStart())
+
+	// All the validators bonded at genesis vote to reach the quorum
+	voters := make([]string, 0, len(cfg.Validators)+len(cfg.GenesisValidators))
+	for _, v := range cfg.Validators {
+		voters = append(voters, v.Name)
+	}
+	for _, v := range cfg.GenesisValidators {
+		voters = append(voters, v.Name)
+	}
+
+	for _, voter := range voters {
+		account, err := client.Account(voter)
+		if err != nil {
+			return 0, err
+		}
+
+		addr, err := account.Address(prefix)
+		if err != nil {
+			return 0, err
+		}
+
+		vote := &govv1.MsgVote{ProposalId: proposal.ProposalId, Voter: addr, Option: govv1.OptionYes}
+		if _, err := client.BroadcastTx(ctx, account, vote); err != nil {
+			return 0, errors.Errorf("validator %s cannot vote the upgrade proposal: %w", voter, err)
+		}
+	}
+
+	c.ev.Send(fmt.Sprintf("Waiting for upgrade proposal %d to pass...", proposal.ProposalId), events.ProgressStart())
+
+	if err := waitForProposal(ctx, gov, proposal.ProposalId); err != nil {
+		return 0, err
+	}
+
+	c.ev.Send(
+		fmt.Sprintf("Upgrade proposal %d passed", proposal.ProposalId),
+		events.Icon(icons.OK),
+		events.ProgressFinish(),
+	)
+
+	return plan.Height, nil
+}
+
+// waitForProposal waits until the voting period of a proposal ends and checks that it passed.
+func waitForProposal(ctx context.Context, gov govv1.QueryClient, id uint64) error {
+	ticker := time.NewTicker(upgradeRetryInterval)
+	defer ticker.Stop()
+
+	for {
+		res, err := gov.Proposal(ctx, &govv1.QueryProposalRequest{ProposalId: id})
+		if err != nil {
+			return err
+		}
+
+		switch res.Proposal.Status {
+		case govv1.StatusPassed:
+			return nil
+		case govv1.StatusRejected, govv1.StatusFailed:
+			return errors.Errorf("upgrade proposal %d didn't pass: %s", id, res.Proposal.Status)
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// waitForUpgradeHalt waits until the node halts at the height of an upgrade.
+func waitForUpgradeHalt(ctx context.Context, home, name string) error {
+	ticker := time.NewTicker(upgradeRetryInterval)
+	defer ticker.Stop()
+
+	for !isUpgradeHalted(home, name) {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+
+	return nil
+}
+
+// isUpgradeHalted checks if the node halted at the height of an upgrade.
+// Nodes write the upgrade info file to their data directory when they halt.
+func isUpgradeHalted(home, name string) bool {
+	bz, err := os.ReadFile(filepath.Join(home, "data", cosmovisor.UpgradeInfoFile))
+	if err != nil {
+		return false
+	}
+
+	var halt upgradeHalt
+	if err := json.Unmarshal(bz, &halt); err != nil {
+		return false
+	}
+
+	return halt.Name == name
+}
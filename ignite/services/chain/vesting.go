@@ -0,0 +1,166 @@
+package chain
+
+import (
+	"context"
+	"strconv"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/ignite/cli/v29/ignite/config/chain/base"
+	"github.com/ignite/cli/v29/ignite/pkg/chaincmd"
+	chaincmdrunner "github.com/ignite/cli/v29/ignite/pkg/chaincmd/runner"
+	"github.com/ignite/cli/v29/ignite/pkg/confile"
+	"github.com/ignite/cli/v29/ignite/pkg/errors"
+)
+
+const (
+	baseAccountType            = "/cosmos.auth.v1beta1.BaseAccount"
+	periodicVestingAccountType = "/cosmos.vesting.v1beta1.PeriodicVestingAccount"
+)
+
+// addGenesisAccount adds an account from the config to the genesis using its address.
+// Accounts with a vesting schedule are added as vesting accounts and accounts with
+// a module name are added as module accounts.
+func (c Chain) addGenesisAccount(
+	ctx context.Context,
+	commands chaincmdrunner.Runner,
+	account base.Account,
+	address string,
+) error {
+	coins := strings.Join(account.Coins, ",")
+
+	if account.Module != "" {
+		return commands.AddGenesisAccount(ctx, address, coins, chaincmd.GenesisAccountWithModuleName(account.Module))
+	}
+
+	if account.Vesting == nil {
+		return commands.AddGenesisAccount(ctx, address, coins)
+	}
+
+	vesting := account.Vesting
+	switch vesting.Type {
+	case base.VestingContinuous:
+		return commands.AddVestingAccount(
+			ctx,
+			address,
+			coins,
+			strings.Join(vesting.Coins, ","),
+			vesting.EndTime,
+			chaincmd.GenesisAccountWithVestingStartTime(vesting.StartTime),
+		)
+	case base.VestingDelayed:
+		return commands.AddVestingAccount(ctx, address, coins, strings.Join(vesting.Coins, ","), vesting.EndTime)
+	case base.VestingPeriodic:
+		// The chain binary doesn't support adding periodic vesting accounts,
+		// so the account is added and then converted in the genesis file.
+		if err := commands.AddGenesisAccount(ctx, address, coins); err != nil {
+			return err
+		}
+
+		return c.setPeriodicVestingAccount(address, *vesting)
+	}
+
+	return errors.Errorf("account %s has an invalid vesting type: %s", account.Name, vesting.Type)
+}
+
+// setPeriodicVestingAccount converts a base account of the genesis into a periodic vesting account.
+func (c Chain) setPeriodicVestingAccount(address string, vesting base.Vesting) error {
+	path, err := c.GenesisPath()
+	if err != nil {
+		return err
+	}
+
+	genesis := make(map[string]interface{})
+	cf := confile.New(confile.DefaultJSONEncodingCreator, path)
+	if err := cf.Load(&genesis); err != nil {
+		return err
+	}
+
+	if err := convertToPeriodicVestingAccount(genesis, address, vesting); err != nil {
+		return err
+	}
+
+	return cf.Save(genesis)
+}
+
+// convertToPeriodicVestingAccount replaces a base account of a genesis by a periodic vesting account.
+// The vesting end time and the original vesting coins are computed from the vesting periods,
+// which are validated not to exceed the account coins when the config is parsed.
+func convertToPeriodicVestingAccount(genesis map[string]interface{}, address string, vesting base.Vesting) error {
+	accounts, err := genesisAuthAccounts(genesis)
+	if err != nil {
+		return err
+	}
+
+	var (
+		originalVesting sdk.Coins
+		periods         []interface{}
+		endTime         = vesting.StartTime
+	)
+
+	for _, p := range vesting.Periods {
+		amount, err := sdk.ParseCoinsNormalized(strings.Join(p.Coins, ","))
+		if err != nil {
+			return errors.Errorf("invalid vesting period coins of account %s: %w", address, err)
+		}
+
+		originalVesting = originalVesting.Add(amount...)
+		endTime += p.Length
+		periods = append(periods, map[string]interface{}{
+			"length": strconv.FormatInt(p.Length, 10),
+			"amount": amount,
+		})
+	}
+
+	for i, a := range accounts {
+		account, ok := a.(map[string]interface{})
+		if !ok || account["address"] != address {
+			continue
+		}
+
+		if account["@type"] != baseAccountType {
+			return errors.Errorf("genesis account %s is not a base account", address)
+		}
+
+		// The base account is wrapped by the vesting account so it must not have a type
+		delete(account, "@type")
+
+		accounts[i] = map[string]interface{}{
+			"@type": periodicVestingAccountType,
+			"base_vesting_account": map[string]interface{}{
+				"base_account":      account,
+				"original_vesting":  originalVesting,
+				"delegated_free":    []interface{}{},
+				"delegated_vesting": []interface{}{},
+				"end_time":          strconv.FormatInt(endTime, 10),
+			},
+			"start_time":      strconv.FormatInt(vesting.StartTime, 10),
+			"vesting_periods": periods,
+		}
+
+		return nil
+	}
+
+	return errors.Errorf("genesis account %s not found", address)
+}
+
+// genesisAuthAccounts returns the accounts of the auth module genesis state.
+func genesisAuthAccounts(genesis map[string]interface{}) ([]interface{}, error) {
+	appState, ok := genesis["app_state"].(map[string]interface{})
+	if !ok {
+		return nil, errors.New("genesis app state not found")
+	}
+
+	auth, ok := appState["auth"].(map[string]interface{})
+	if !ok {
+		return nil, errors.New("genesis auth module state not found")
+	}
+
+	accounts, ok := auth["accounts"].([]interface{})
+	if !ok {
+		return nil, errors.New("genesis auth accounts not found")
+	}
+
+	return accounts, nil
+}
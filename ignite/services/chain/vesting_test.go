@@ -0,0 +1,113 @@
+package chain
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/cli/v29/ignite/config/chain/base"
+)
+
+func TestConvertToPeriodicVestingAccount(t *testing.T) {
+	genesisWithAccount := `{
+  "app_state": {
+    "auth": {
+      "accounts": [
+        {
+          "@type": "/cosmos.auth.v1beta1.BaseAccount",
+          "address": "cosmos1adn9gxjmrc3hrsdx5zpc9sj2ra7kgqkmphf8yw",
+          "pub_key": null,
+          "account_number": "0",
+          "sequence": "0"
+        }
+      ]
+    }
+  }
+}`
+
+	vesting := base.Vesting{
+		Type:      base.VestingPeriodic,
+		StartTime: 1700000000,
+		Periods: []base.VestingPeriod{
+			{Length: 3600, Coins: []string{"500token"}},
+			{Length: 7200, Coins: []string{"250token", "10stake"}},
+		},
+	}
+
+	tests := []struct {
+		name    string
+		genesis string
+		address string
+		want    string
+		wantErr string
+	}{
+		{
+			name:    "base account",
+			genesis: genesisWithAccount,
+			address: "cosmos1adn9gxjmrc3hrsdx5zpc9sj2ra7kgqkmphf8yw",
+			want: `{
+  "app_state": {
+    "auth": {
+      "accounts": [
+        {
+          "@type": "/cosmos.vesting.v1beta1.PeriodicVestingAccount",
+          "base_vesting_account": {
+            "base_account": {
+              "address": "cosmos1adn9gxjmrc3hrsdx5zpc9sj2ra7kgqkmphf8yw",
+              "pub_key": null,
+              "account_number": "0",
+              "sequence": "0"
+            },
+            "original_vesting": [
+              {"denom": "stake", "amount": "10"},
+              {"denom": "token", "amount": "750"}
+            ],
+            "delegated_free": [],
+            "delegated_vesting": [],
+            "end_time": "1700010800"
+          },
+          "start_time": "1700000000",
+          "vesting_periods": [
+            {"length": "3600", "amount": [{"denom": "token", "amount": "500"}]},
+            {"length": "7200", "amount": [{"denom": "stake", "amount": "10"}, {"denom": "token", "amount": "250"}]}
+          ]
+        }
+      ]
+    }
+  }
+}`,
+		},
+		{
+			name:    "missing account",
+			genesis: genesisWithAccount,
+			address: "cosmos1vmafl8f3s6uuzwnxkqz0eza47v6ecn0tfqrcl7",
+			wantErr: "genesis account cosmos1vmafl8f3s6uuzwnxkqz0eza47v6ecn0tfqrcl7 not found",
+		},
+		{
+			name:    "missing auth state",
+			genesis: `{"app_state": {}}`,
+			address: "cosmos1adn9gxjmrc3hrsdx5zpc9sj2ra7kgqkmphf8yw",
+			wantErr: "genesis auth module state not found",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			genesis := make(map[string]interface{})
+			require.NoError(t, json.Unmarshal([]byte(tt.genesis), &genesis))
+
+			err := convertToPeriodicVestingAccount(genesis, tt.address, vesting)
+			if tt.wantErr != "" {
+				require.EqualError(t, err, tt.wantErr)
+				return
+			}
+
+			require.NoError(t, err)
+
+			bz, err := json.Marshal(genesis)
+			require.NoError(t, err)
+			require.JSONEq(t, tt.want, string(bz))
+		})
+	}
+}
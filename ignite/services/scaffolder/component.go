@@ -21,6 +21,7 @@ const (
 	componentMessage = "message"
 	componentQuery   = "query"
 	componentPacket  = "packet"
+	componentEvent   = "event"
 )
 
 // checkComponentValidity performs various checks common to all components to verify if it can be scaffolded.
@@ -54,6 +55,7 @@ func checkComponentCreated(appPath, moduleName string, compName multiformatname.
 		fmt.Sprintf("query%srequest", compName.LowerCase):     componentQuery,
 		fmt.Sprintf("query%sresponse", compName.LowerCase):    componentQuery,
 		fmt.Sprintf("%spacketdata", compName.LowerCase):       componentPacket,
+		fmt.Sprintf("event%s", compName.LowerCase):            componentEvent,
 	}
 
 	if !noMessage {
@@ -116,9 +118,19 @@ func checkCustomTypes(ctx context.Context, appPath, appName, protoDir, module st
 			continue
 		}
 
-		if _, ok := datatype.IsSupportedType(datatype.Name(ft)); !ok {
-			customFieldTypes = append(customFieldTypes, ft)
+		if _, ok := datatype.IsSupportedType(datatype.Name(ft)); ok {
+			continue
 		}
+
+		// Fields can use arrays and maps of custom types
+		name, customType, err := datatype.ParseCustom(ft)
+		if err != nil {
+			return err
+		}
+
+		// The proto analysis names nested messages after their parents separated by underscores
+		message := datatype.CustomMessage(name, customType)
+		customFieldTypes = append(customFieldTypes, strings.ReplaceAll(message, ".", "_"))
 	}
 	return protoanalysis.HasMessages(ctx, path, customFieldTypes...)
 }
@@ -97,6 +97,16 @@ func TestContainsCustomTypes(t *testing.T) {
 			fields:   []string{"foo", "bar:CustomType"},
 			contains: true,
 		},
+		{
+			name:     "contains an array of custom types",
+			fields:   []string{"foo", "bar:[]CustomType"},
+			contains: true,
+		},
+		{
+			name:     "contains a map of custom types",
+			fields:   []string{"foo", "bar:map<string,CustomType>"},
+			contains: true,
+		},
 	}
 
 	for _, tc := range tests {
@@ -0,0 +1,62 @@
+package scaffolder
+
+import (
+	"context"
+
+	"github.com/ignite/cli/v29/ignite/pkg/multiformatname"
+	"github.com/ignite/cli/v29/ignite/templates/event"
+	"github.com/ignite/cli/v29/ignite/templates/field"
+)
+
+// AddEvent adds a new typed event to a scaffolded module with a keeper helper to emit it.
+func (s Scaffolder) AddEvent(
+	ctx context.Context,
+	moduleName,
+	eventName string,
+	fields ...string,
+) error {
+	// If no module is provided, we add the event to the app's module
+	if moduleName == "" {
+		moduleName = s.modpath.Package
+	}
+	mfName, err := multiformatname.NewName(moduleName, multiformatname.NoNumber)
+	if err != nil {
+		return err
+	}
+	moduleName = mfName.LowerCase
+
+	name, err := multiformatname.NewName(eventName)
+	if err != nil {
+		return err
+	}
+
+	if err := checkComponentValidity(s.appPath, moduleName, name, true); err != nil {
+		return err
+	}
+
+	// Check and parse provided fields
+	if err := checkCustomTypes(ctx, s.appPath, s.modpath.Package, s.protoDir, moduleName, fields); err != nil {
+		return err
+	}
+	parsedFields, err := field.ParseFields(fields, checkForbiddenMessageField)
+	if err != nil {
+		return err
+	}
+
+	opts := &event.Options{
+		AppName:    s.modpath.Package,
+		AppPath:    s.appPath,
+		ProtoDir:   s.protoDir,
+		ModulePath: s.modpath.RawPath,
+		ModuleName: moduleName,
+		EventName:  name,
+		Fields:     parsedFields.WithScope(name.UpperCamel),
+	}
+
+	g, err := event.NewGenerator(opts)
+	if err != nil {
+		return err
+	}
+
+	return s.Run(g)
+}
@@ -19,6 +19,7 @@ type messageOptions struct {
 	description       string
 	signer            string
 	withoutSimulation bool
+	withEvents        bool
 }
 
 // newMessageOptions returns a messageOptions with default options.
@@ -53,6 +54,13 @@ func WithoutSimulation() MessageOption {
 	}
 }
 
+// WithEvents emits a typed event with the fields of the message when it's handled.
+func WithEvents() MessageOption {
+	return func(m *messageOptions) {
+		m.withEvents = true
+	}
+}
+
 // AddMessage adds a new message to scaffolded app.
 func (s Scaffolder) AddMessage(
 	ctx context.Context,
@@ -133,11 +141,12 @@ func (s Scaffolder) AddMessage(
 			ModulePath:   s.modpath.RawPath,
 			ModuleName:   moduleName,
 			MsgName:      name,
-			Fields:       parsedMsgFields,
-			ResFields:    parsedResFields,
+			Fields:       parsedMsgFields.WithScope(name.UpperCamel),
+			ResFields:    parsedResFields.WithScope(name.UpperCamel + "Response"),
 			MsgDesc:      scaffoldingOpts.description,
 			MsgSigner:    mfSigner,
 			NoSimulation: scaffoldingOpts.withoutSimulation,
+			Events:       scaffoldingOpts.withEvents,
 		}
 	)
 
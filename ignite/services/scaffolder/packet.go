@@ -125,8 +125,8 @@ func (s Scaffolder) AddPacket(
 			ModulePath: s.modpath.RawPath,
 			ModuleName: moduleName,
 			PacketName: name,
-			Fields:     parsedPacketFields,
-			AckFields:  parsedAcksFields,
+			Fields:     parsedPacketFields.WithScope(name.UpperCamel),
+			AckFields:  parsedAcksFields.WithScope(name.UpperCamel + "Ack"),
 			NoMessage:  o.withoutMessage,
 			MsgSigner:  mfSigner,
 		}
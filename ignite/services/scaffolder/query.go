@@ -67,8 +67,8 @@ func (s Scaffolder) AddQuery(
 			ModulePath:  s.modpath.RawPath,
 			ModuleName:  moduleName,
 			QueryName:   name,
-			ReqFields:   parsedReqFields,
-			ResFields:   parsedResFields,
+			ReqFields:   parsedReqFields.WithScope(name.UpperCamel),
+			ResFields:   parsedResFields.WithScope(name.UpperCamel + "Response"),
 			Description: description,
 			Paginated:   paginated,
 		}
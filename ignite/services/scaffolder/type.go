@@ -2,11 +2,14 @@ package scaffolder
 
 import (
 	"context"
+	"path/filepath"
+	"slices"
 	"strings"
 
 	"github.com/gobuffalo/genny/v2"
 
 	"github.com/ignite/cli/v29/ignite/pkg/errors"
+	"github.com/ignite/cli/v29/ignite/pkg/goanalysis"
 	"github.com/ignite/cli/v29/ignite/pkg/multiformatname"
 	"github.com/ignite/cli/v29/ignite/pkg/placeholder"
 	"github.com/ignite/cli/v29/ignite/templates/field"
@@ -33,10 +36,16 @@ type addTypeOptions struct {
 	isMap       bool
 	isSingleton bool
 
-	indexes []string
+	indexes          []string
+	secondaryIndexes []string
+
+	authority       string
+	immutableFields []string
+	statusField     string
 
 	withoutMessage    bool
 	withoutSimulation bool
+	withEvents        bool
 	signer            string
 }
 
@@ -45,6 +54,7 @@ func newAddTypeOptions(moduleName string) addTypeOptions {
 	return addTypeOptions{
 		moduleName: moduleName,
 		signer:     "creator",
+		authority:  typed.AuthorityCreator,
 	}
 }
 
@@ -104,6 +114,14 @@ func TypeWithoutSimulation() AddTypeOption {
 	}
 }
 
+// TypeWithEvents emits typed events when the values of a list or map type are created,
+// updated or deleted by the messages.
+func TypeWithEvents() AddTypeOption {
+	return func(o *addTypeOptions) {
+		o.withEvents = true
+	}
+}
+
 // TypeWithSigner provides a custom signer name for the message.
 func TypeWithSigner(signer string) AddTypeOption {
 	return func(o *addTypeOptions) {
@@ -111,6 +129,37 @@ func TypeWithSigner(signer string) AddTypeOption {
 	}
 }
 
+// TypeWithSecondaryIndexes adds secondary indexes to a map type for the given fields,
+// which allows to look up the values by these fields.
+func TypeWithSecondaryIndexes(fields ...string) AddTypeOption {
+	return func(o *addTypeOptions) {
+		o.secondaryIndexes = fields
+	}
+}
+
+// TypeWithAuthority defines who can create, update and delete the values of a list or map type.
+// The supported authorities are "creator", "anyone", "gov" and "module-admin".
+func TypeWithAuthority(authority string) AddTypeOption {
+	return func(o *addTypeOptions) {
+		o.authority = authority
+	}
+}
+
+// TypeWithImmutableFields prevents the given fields of a list or map type to be updated.
+func TypeWithImmutableFields(fields ...string) AddTypeOption {
+	return func(o *addTypeOptions) {
+		o.immutableFields = fields
+	}
+}
+
+// TypeWithStatusField adds to a list or map type a status field that can only change
+// from a state to the next one, e.g. "status:draft->active->closed".
+func TypeWithStatusField(status string) AddTypeOption {
+	return func(o *addTypeOptions) {
+		o.statusField = status
+	}
+}
+
 // AddType adds a new type to a scaffolded app.
 // if none of the list, map or singleton given, a dry type without anything extra (like a storage layer, models, CLI etc.)
 // will be scaffolded.
@@ -127,6 +176,16 @@ func (s Scaffolder) AddType(
 		apply(&o)
 	}
 
+	if len(o.secondaryIndexes) > 0 && !o.isMap {
+		return errors.New("secondary indexes can only be added to map types")
+	}
+	if o.withEvents && ((!o.isList && !o.isMap) || o.withoutMessage) {
+		return errors.New("events can only be emitted by the messages of list and map types")
+	}
+	if err := checkTypeRules(o); err != nil {
+		return err
+	}
+
 	mfName, err := multiformatname.NewName(o.moduleName, multiformatname.NoNumber)
 	if err != nil {
 		return err
@@ -170,14 +229,37 @@ func (s Scaffolder) AddType(
 			ModulePath:   s.modpath.RawPath,
 			ModuleName:   moduleName,
 			TypeName:     name,
-			Fields:       tFields,
+			Fields:       tFields.WithScope(name.UpperCamel),
 			NoMessage:    o.withoutMessage,
 			NoSimulation: o.withoutSimulation,
 			MsgSigner:    mfSigner,
 			IsIBC:        isIBC,
+			Authority:    o.authority,
+			Events:       o.withEvents,
 		}
 		gens []*genny.Generator
 	)
+
+	if err := parseTypeRules(opts, o.immutableFields, o.statusField); err != nil {
+		return err
+	}
+
+	// Only the authority can send the messages, so they can't be simulated
+	if o.authority == typed.AuthorityGov || o.authority == typed.AuthorityModuleAdmin {
+		opts.NoSimulation = true
+	}
+
+	// The module admin is defined in the module params
+	if o.authority == typed.AuthorityModuleAdmin {
+		paramsGen, err := moduleAdminParamGenerator(s.appPath, s.protoDir, s.modpath.Package, moduleName)
+		if err != nil {
+			return err
+		}
+		if paramsGen != nil {
+			gens = append(gens, paramsGen)
+		}
+	}
+
 	// Check and support MsgServer convention
 	gens, err = supportMsgServer(
 		gens,
@@ -199,7 +281,7 @@ func (s Scaffolder) AddType(
 	case o.isList:
 		g, err = list.NewGenerator(s.Tracer(), opts)
 	case o.isMap:
-		g, err = mapGenerator(s.Tracer(), opts, o.indexes)
+		g, err = mapGenerator(s.Tracer(), opts, o.indexes, o.secondaryIndexes)
 	case o.isSingleton:
 		g, err = singleton.NewGenerator(s.Tracer(), opts)
 	default:
@@ -259,7 +341,12 @@ func parseTypeFields(opts addTypeOptions) (field.Fields, error) {
 }
 
 // mapGenerator returns the template generator for a map.
-func mapGenerator(replacer placeholder.Replacer, opts *typed.Options, indexes []string) (*genny.Generator, error) {
+func mapGenerator(
+	replacer placeholder.Replacer,
+	opts *typed.Options,
+	indexes,
+	secondaryIndexes []string,
+) (*genny.Generator, error) {
 	// Parse indexes with the associated type
 	parsedIndexes, err := field.ParseFields(indexes, checkForbiddenTypeIndex)
 	if err != nil {
@@ -278,5 +365,150 @@ func mapGenerator(replacer placeholder.Replacer, opts *typed.Options, indexes []
 	}
 
 	opts.Indexes = parsedIndexes
+
+	opts.SecondaryIndexes, err = parseSecondaryIndexes(opts, secondaryIndexes)
+	if err != nil {
+		return nil, err
+	}
+
 	return maptype.NewGenerator(replacer, opts)
 }
+
+// parseSecondaryIndexes returns the fields of the type used as secondary indexes.
+// The message signer can be used as a secondary index when messages are scaffolded.
+func parseSecondaryIndexes(opts *typed.Options, names []string) (field.Fields, error) {
+	fields := opts.Fields
+	if !opts.NoMessage {
+		fields = append(fields, field.Field{Name: opts.MsgSigner, DatatypeName: datatype.String})
+	}
+
+	var (
+		secondaryIndexes field.Fields
+		exists           = make(map[string]struct{})
+	)
+	for _, name := range names {
+		mfName, err := multiformatname.NewName(name)
+		if err != nil {
+			return nil, err
+		}
+		if _, ok := exists[mfName.LowerCamel]; ok {
+			return nil, errors.Errorf("duplicated secondary index %s", name)
+		}
+		exists[mfName.LowerCamel] = struct{}{}
+
+		i := slices.IndexFunc(fields, func(f field.Field) bool {
+			return f.Name.LowerCamel == mfName.LowerCamel
+		})
+		if i == -1 {
+			return nil, errors.Errorf("secondary index %s is not a field of the type", name)
+		}
+		if fields[i].CollectionsKey() == "" {
+			return nil, errors.Errorf("secondary index %s of type %s is not supported", name, fields[i].DatatypeName)
+		}
+		secondaryIndexes = append(secondaryIndexes, fields[i])
+	}
+
+	return secondaryIndexes, nil
+}
+
+// checkTypeRules checks that the authority, immutable fields and status field
+// options are only used by the list and map types with messages.
+func checkTypeRules(o addTypeOptions) error {
+	if !slices.Contains(typed.Authorities, o.authority) {
+		return errors.Errorf(
+			"invalid authority %s, should be one of: %s",
+			o.authority,
+			strings.Join(typed.Authorities, ", "),
+		)
+	}
+
+	hasRules := o.authority != typed.AuthorityCreator || len(o.immutableFields) > 0 || o.statusField != ""
+	if !hasRules {
+		return nil
+	}
+	if !o.isList && !o.isMap {
+		return errors.New("authority, immutable fields and status field can only be used by list and map types")
+	}
+	if o.withoutMessage {
+		return errors.New("authority, immutable fields and status field require the messages of the type")
+	}
+	return nil
+}
+
+// parseTypeRules parses the immutable fields and the status field of a type.
+// The status field is added to the fields of the type when it's not one of them.
+func parseTypeRules(opts *typed.Options, immutableFields []string, statusField string) error {
+	if statusField != "" {
+		status, err := typed.ParseStatusField(statusField)
+		if err != nil {
+			return err
+		}
+		if err := checkForbiddenTypeField(status.Field.Name.Original); err != nil {
+			return err
+		}
+		if status.Field.Name.LowerCamel == opts.MsgSigner.LowerCamel {
+			return errors.Errorf("status field %s cannot be the message signer", status.Field.Name.Original)
+		}
+
+		i := slices.IndexFunc(opts.Fields, func(f field.Field) bool {
+			return f.Name.LowerCamel == status.Field.Name.LowerCamel
+		})
+		switch {
+		case i == -1:
+			opts.Fields = append(opts.Fields, status.Field)
+		case opts.Fields[i].DatatypeName != datatype.String:
+			return errors.Errorf("status field %s must be a string", status.Field.Name.Original)
+		default:
+			status.Field = opts.Fields[i]
+		}
+		opts.Status = status
+	}
+
+	exists := make(map[string]struct{})
+	for _, name := range immutableFields {
+		mfName, err := multiformatname.NewName(name)
+		if err != nil {
+			return err
+		}
+		if _, ok := exists[mfName.LowerCamel]; ok {
+			return errors.Errorf("duplicated immutable field %s", name)
+		}
+		exists[mfName.LowerCamel] = struct{}{}
+
+		if opts.Status.Defined() && opts.Status.Field.Name.LowerCamel == mfName.LowerCamel {
+			return errors.Errorf("status field %s cannot be immutable", name)
+		}
+		i := slices.IndexFunc(opts.Fields, func(f field.Field) bool {
+			return f.Name.LowerCamel == mfName.LowerCamel
+		})
+		if i == -1 {
+			return errors.Errorf("immutable field %s is not a field of the type", name)
+		}
+		opts.ImmutableFields = append(opts.ImmutableFields, opts.Fields[i])
+	}
+
+	return nil
+}
+
+// moduleAdminParamGenerator returns the generator that adds the module admin param
+// to the module params. No generator is returned when the param already exists.
+func moduleAdminParamGenerator(appPath, protoDir, appName, moduleName string) (*genny.Generator, error) {
+	path := filepath.Join(appPath, "x", moduleName, "types")
+	ok, err := goanalysis.HasAnyStructFieldsInPkg(path, "Params", []string{typed.ModuleAdminParam})
+	if err != nil || ok {
+		return nil, err
+	}
+
+	params, err := field.ParseFields([]string{typed.ModuleAdminParam}, checkForbiddenTypeIndex)
+	if err != nil {
+		return nil, err
+	}
+
+	return modulecreate.NewModuleParam(modulecreate.ParamsOptions{
+		ModuleName: moduleName,
+		Params:     params,
+		AppName:    appName,
+		AppPath:    appPath,
+		ProtoDir:   protoDir,
+	})
+}
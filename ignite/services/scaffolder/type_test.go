@@ -8,6 +8,7 @@ import (
 	"github.com/ignite/cli/v29/ignite/pkg/multiformatname"
 	"github.com/ignite/cli/v29/ignite/templates/field"
 	"github.com/ignite/cli/v29/ignite/templates/field/datatype"
+	"github.com/ignite/cli/v29/ignite/templates/typed"
 )
 
 func TestParseTypeFields(t *testing.T) {
@@ -216,5 +217,223 @@ func TestCheckForbiddenTypeIndexField(t *testing.T) {
 	}
 }
 
+func TestParseSecondaryIndexes(t *testing.T) {
+	fields, err := field.ParseFields([]string{"owner:address", "status", "amount:uint", "price:coin"}, checkForbiddenTypeField)
+	require.NoError(t, err)
+
+	signer, err := multiformatname.NewName("creator")
+	require.NoError(t, err)
+
+	tests := []struct {
+		name        string
+		names       []string
+		noMessage   bool
+		expected    []string
+		shouldError bool
+	}{
+		{
+			name:     "should pass - fields",
+			names:    []string{"owner", "amount"},
+			expected: []string{"owner", "amount"},
+		},
+		{
+			name:     "should pass - signer",
+			names:    []string{"status", "creator"},
+			expected: []string{"status", "creator"},
+		},
+		{
+			name:        "should fail - signer without message",
+			names:       []string{"creator"},
+			noMessage:   true,
+			shouldError: true,
+		},
+		{
+			name:        "should fail - unknown field",
+			names:       []string{"foo"},
+			shouldError: true,
+		},
+		{
+			name:        "should fail - unsupported type",
+			names:       []string{"price"},
+			shouldError: true,
+		},
+		{
+			name:        "should fail - duplicated index",
+			names:       []string{"owner", "owner"},
+			shouldError: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			opts := &typed.Options{
+				Fields:    fields,
+				MsgSigner: signer,
+				NoMessage: tc.noMessage,
+			}
+			secondaryIndexes, err := parseSecondaryIndexes(opts, tc.names)
+			if tc.shouldError {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			names := make([]string, len(secondaryIndexes))
+			for i, index := range secondaryIndexes {
+				names[i] = index.Name.LowerCamel
+			}
+			require.Equal(t, tc.expected, names)
+		})
+	}
+}
+
+func TestCheckTypeRules(t *testing.T) {
+	tests := []struct {
+		name        string
+		options     addTypeOptions
+		shouldError bool
+	}{
+		{
+			name:    "should pass - default authority",
+			options: addTypeOptions{isSingleton: true, authority: typed.AuthorityCreator},
+		},
+		{
+			name:    "should pass - list rules",
+			options: addTypeOptions{isList: true, authority: typed.AuthorityGov, immutableFields: []string{"title"}},
+		},
+		{
+			name:    "should pass - map status",
+			options: addTypeOptions{isMap: true, authority: typed.AuthorityCreator, statusField: "status:draft->active"},
+		},
+		{
+			name:        "should fail - unknown authority",
+			options:     addTypeOptions{isList: true, authority: "foo"},
+			shouldError: true,
+		},
+		{
+			name:        "should fail - singleton rules",
+			options:     addTypeOptions{isSingleton: true, authority: typed.AuthorityAnyone},
+			shouldError: true,
+		},
+		{
+			name:        "should fail - rules without message",
+			options:     addTypeOptions{isList: true, authority: typed.AuthorityCreator, immutableFields: []string{"title"}, withoutMessage: true},
+			shouldError: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := checkTypeRules(tc.options)
+			if tc.shouldError {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestParseTypeRules(t *testing.T) {
+	fields, err := field.ParseFields([]string{"title", "price:coin", "state:int"}, checkForbiddenTypeField)
+	require.NoError(t, err)
+
+	signer, err := multiformatname.NewName("creator")
+	require.NoError(t, err)
+
+	tests := []struct {
+		name              string
+		immutableFields   []string
+		statusField       string
+		expectedFields    []string
+		expectedImmutable []string
+		expectedStates    []string
+		shouldError       bool
+	}{
+		{
+			name:              "should pass - immutable fields",
+			immutableFields:   []string{"title", "price"},
+			expectedFields:    []string{"title", "price", "state"},
+			expectedImmutable: []string{"title", "price"},
+		},
+		{
+			name:              "should pass - new status field",
+			immutableFields:   []string{"title"},
+			statusField:       "status:draft->active->closed",
+			expectedFields:    []string{"title", "price", "state", "status"},
+			expectedImmutable: []string{"title"},
+			expectedStates:    []string{"draft", "active", "closed"},
+		},
+		{
+			name:           "should pass - existing status field",
+			statusField:    "title:draft->published",
+			expectedFields: []string{"title", "price", "state"},
+			expectedStates: []string{"draft", "published"},
+		},
+		{
+			name:        "should fail - status field not a string",
+			statusField: "state:draft->active",
+			shouldError: true,
+		},
+		{
+			name:        "should fail - status field is the signer",
+			statusField: "creator:draft->active",
+			shouldError: true,
+		},
+		{
+			name:        "should fail - single state",
+			statusField: "status:draft",
+			shouldError: true,
+		},
+		{
+			name:            "should fail - immutable status field",
+			immutableFields: []string{"status"},
+			statusField:     "status:draft->active",
+			shouldError:     true,
+		},
+		{
+			name:            "should fail - unknown immutable field",
+			immutableFields: []string{"foo"},
+			shouldError:     true,
+		},
+		{
+			name:            "should fail - duplicated immutable field",
+			immutableFields: []string{"title", "title"},
+			shouldError:     true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			opts := &typed.Options{
+				Fields:    append(field.Fields(nil), fields...),
+				MsgSigner: signer,
+			}
+			err := parseTypeRules(opts, tc.immutableFields, tc.statusField)
+			if tc.shouldError {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			names := func(fields field.Fields) []string {
+				var names []string
+				for _, f := range fields {
+					names = append(names, f.Name.LowerCamel)
+				}
+				return names
+			}
+			require.Equal(t, tc.expectedFields, names(opts.Fields))
+			require.Equal(t, tc.expectedImmutable, names(opts.ImmutableFields))
+
+			var states []string
+			for _, state := range opts.Status.States {
+				states = append(states, state.Snake)
+			}
+			require.Equal(t, tc.expectedStates, states)
+		})
+	}
+}
+
 func TestAddType(t *testing.T) {
 }
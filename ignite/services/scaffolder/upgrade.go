@@ -0,0 +1,103 @@
+package scaffolder
+
+import (
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/ignite/cli/v29/ignite/pkg/errors"
+	"github.com/ignite/cli/v29/ignite/pkg/goanalysis"
+	"github.com/ignite/cli/v29/ignite/pkg/xos"
+	"github.com/ignite/cli/v29/ignite/templates/upgrade"
+)
+
+// AddUpgrade adds a new chain upgrade to the scaffolded app.
+// The upgrade adds and deletes the stores of modules, and migrates the stores of
+// the modules defined as module:fromVersion to their next consensus version.
+func (s Scaffolder) AddUpgrade(name string, addStores, deleteStores, migrations []string) error {
+	if strings.TrimSpace(name) == "" {
+		return errors.New("upgrade name can't be empty")
+	}
+
+	// The upgrade handlers are registered using the upgrade keeper of the app
+	ok, err := goanalysis.HasAnyStructFieldsInPkg(filepath.Join(s.appPath, "app"), "App", []string{"UpgradeKeeper"})
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return errors.New("the app doesn't include the upgrade module")
+	}
+
+	opts := &upgrade.Options{
+		AppPath:      s.appPath,
+		ModulePath:   s.modpath.RawPath,
+		UpgradeName:  name,
+		AddStores:    addStores,
+		DeleteStores: deleteStores,
+	}
+
+	if _, err := os.Stat(filepath.Join(s.appPath, upgrade.PathUpgradesDir, opts.PackageName())); err == nil {
+		return errors.Errorf("the upgrade %s already exists", name)
+	} else if !os.IsNotExist(err) {
+		return err
+	}
+
+	opts.HasUpgrades = xos.FileExists(filepath.Join(s.appPath, upgrade.PathAppUpgradesGo))
+
+	for _, store := range addStores {
+		for _, deleted := range deleteStores {
+			if store == deleted {
+				return errors.Errorf("the store %s can't be both added and deleted", store)
+			}
+		}
+	}
+
+	migrated := make(map[string]struct{})
+	for _, m := range migrations {
+		migration, err := parseMigration(m)
+		if err != nil {
+			return err
+		}
+
+		if _, ok := migrated[migration.ModuleName]; ok {
+			return errors.Errorf("the %s module can only be migrated once per upgrade", migration.ModuleName)
+		}
+		migrated[migration.ModuleName] = struct{}{}
+
+		ok, err := moduleExists(s.appPath, migration.ModuleName)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			return errors.Errorf("the module %s doesn't exist", migration.ModuleName)
+		}
+
+		migratorPath := filepath.Join(s.appPath, moduleDir, migration.ModuleName, "keeper/migrations.go")
+		migration.HasMigrator = xos.FileExists(migratorPath)
+
+		opts.Migrations = append(opts.Migrations, migration)
+	}
+
+	g, err := upgrade.NewGenerator(opts)
+	if err != nil {
+		return err
+	}
+
+	return s.Run(g, upgrade.NewAppModify(s.Tracer(), opts))
+}
+
+// parseMigration parses a module store migration defined as module:fromVersion.
+func parseMigration(m string) (upgrade.Migration, error) {
+	moduleName, version, ok := strings.Cut(m, ":")
+	if !ok || moduleName == "" {
+		return upgrade.Migration{}, errors.Errorf("invalid migration %q, expected module:fromVersion", m)
+	}
+
+	from, err := strconv.ParseUint(version, 10, 64)
+	if err != nil || from == 0 {
+		return upgrade.Migration{}, errors.Errorf("invalid migration %q, the version must be a positive number", m)
+	}
+
+	return upgrade.Migration{ModuleName: moduleName, FromVersion: from}, nil
+}
@@ -0,0 +1,130 @@
+package event
+
+import (
+	"embed"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/emicklei/proto"
+	"github.com/gobuffalo/genny/v2"
+	"github.com/gobuffalo/packd"
+	"github.com/gobuffalo/plush/v4"
+
+	"github.com/ignite/cli/v29/ignite/pkg/errors"
+	"github.com/ignite/cli/v29/ignite/pkg/gomodulepath"
+	"github.com/ignite/cli/v29/ignite/pkg/protoanalysis/protoutil"
+	"github.com/ignite/cli/v29/ignite/pkg/xgenny"
+	"github.com/ignite/cli/v29/ignite/templates/field/plushhelpers"
+	"github.com/ignite/cli/v29/ignite/templates/module"
+	"github.com/ignite/cli/v29/ignite/templates/testutil"
+)
+
+// ProtoFile is the name of the proto file that defines the events of a module.
+const ProtoFile = "events.proto"
+
+//go:embed files/event/* files/event/**/*
+var fsEvent embed.FS
+
+func Box(box packd.Walker, opts *Options, g *genny.Generator) error {
+	if err := g.Box(box); err != nil {
+		return err
+	}
+	ctx := plush.NewContext()
+	ctx.Set("ModuleName", opts.ModuleName)
+	ctx.Set("AppName", opts.AppName)
+	ctx.Set("EventName", opts.EventName)
+	ctx.Set("ModulePath", opts.ModulePath)
+	ctx.Set("Fields", opts.Fields)
+
+	plushhelpers.ExtendPlushContext(ctx)
+	g.Transformer(xgenny.Transformer(ctx))
+	g.Transformer(genny.Replace("{{protoDir}}", opts.ProtoDir))
+	g.Transformer(genny.Replace("{{appName}}", opts.AppName))
+	g.Transformer(genny.Replace("{{moduleName}}", opts.ModuleName))
+	g.Transformer(genny.Replace("{{eventName}}", opts.EventName.Snake))
+
+	// Create the 'testutil' package with the test helpers
+	return testutil.Register(g, opts.AppPath)
+}
+
+// NewGenerator returns the generator to scaffold a typed event in a module.
+func NewGenerator(opts *Options) (*genny.Generator, error) {
+	g := genny.New()
+
+	g.RunFn(protoEventModify(opts))
+
+	template := xgenny.NewEmbedWalker(
+		fsEvent,
+		"files/event",
+		opts.AppPath,
+	)
+	return g, Box(template, opts, g)
+}
+
+// protoEventModify adds the event message to the events proto file of the module.
+// The proto file is created when the module doesn't define events yet.
+func protoEventModify(opts *Options) genny.RunFn {
+	return func(r *genny.Runner) error {
+		path := filepath.Join(opts.AppPath, opts.ProtoDir, opts.AppName, opts.ModuleName, ProtoFile)
+
+		var content string
+		f, err := r.Disk.Find(path)
+		switch {
+		case os.IsNotExist(err):
+			appModulePath := gomodulepath.ExtractAppPath(opts.ModulePath)
+			content = fmt.Sprintf(`syntax = "proto3";
+
+package %[1]v;
+
+option go_package = "%[2]v/x/%[3]v/types";
+`,
+				module.ProtoPackageName(appModulePath, opts.ModuleName),
+				opts.ModulePath,
+				opts.ModuleName,
+			)
+		case err != nil:
+			return err
+		default:
+			content = f.String()
+		}
+
+		protoFile, err := protoutil.ParseProtoFile(strings.NewReader(content))
+		if err != nil {
+			return err
+		}
+
+		var fields []*proto.NormalField
+		for i, field := range opts.Fields {
+			fields = append(fields, field.ToProtoField(i+1))
+		}
+		protoutil.Append(protoFile, protoutil.NewMessage(
+			"Event"+opts.EventName.UpperCamel,
+			protoutil.WithFields(fields...),
+		))
+
+		// Declare the enum types that are not declared yet
+		for _, enum := range opts.Fields.ProtoEnums() {
+			if !protoutil.HasEnum(protoFile, enum.Name) {
+				protoutil.Append(protoFile, enum)
+			}
+		}
+
+		// Ensure custom types are imported
+		var protoImports []*proto.Import
+		for _, imp := range opts.Fields.ProtoImports() {
+			protoImports = append(protoImports, protoutil.NewImport(imp))
+		}
+		for _, f := range opts.Fields.Custom() {
+			protoPath := fmt.Sprintf("%[1]v/%[2]v/%[3]v.proto", opts.AppName, opts.ModuleName, f)
+			protoImports = append(protoImports, protoutil.NewImport(protoPath))
+		}
+		if err = protoutil.AddImports(protoFile, true, protoImports...); err != nil {
+			return errors.Errorf("failed to add imports to %s: %w", path, err)
+		}
+
+		newFile := genny.NewFileS(path, protoutil.Print(protoFile))
+		return r.File(newFile)
+	}
+}
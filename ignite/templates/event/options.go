@@ -0,0 +1,17 @@
+package event
+
+import (
+	"github.com/ignite/cli/v29/ignite/pkg/multiformatname"
+	"github.com/ignite/cli/v29/ignite/templates/field"
+)
+
+// Options represents the options to scaffold a typed event.
+type Options struct {
+	AppName    string
+	AppPath    string
+	ProtoDir   string
+	ModuleName string
+	ModulePath string
+	EventName  multiformatname.Name
+	Fields     field.Fields
+}
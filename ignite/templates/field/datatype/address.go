@@ -0,0 +1,40 @@
+package datatype
+
+import (
+	"fmt"
+
+	"github.com/emicklei/proto"
+
+	"github.com/ignite/cli/v29/ignite/pkg/multiformatname"
+	"github.com/ignite/cli/v29/ignite/pkg/protoanalysis/protoutil"
+)
+
+// DataAddress is an account address data type definition.
+var DataAddress = DataType{
+	DataType:         func(string) string { return "string" },
+	Equal:            equalOperator,
+	DefaultTestValue: "cosmos1fl48vsnmsdzcv85q5d2q4z5ajdha8yu34mf0eh",
+	ProtoType: func(_, name string, index int) string {
+		return fmt.Sprintf(`string %s = %d [(cosmos_proto.scalar) = "cosmos.AddressString"]`, name, index)
+	},
+	GenesisArgs: func(multiformatname.Name, int) string { return "" },
+	CLIArgs: func(name multiformatname.Name, _, prefix string, argIndex int) string {
+		return fmt.Sprintf("%s%s := args[%d]", prefix, name.UpperCamel, argIndex)
+	},
+	SimulationValue: func(string) string {
+		return "accs[r.Intn(len(accs))].Address.String()"
+	},
+	ValidateBasic: func(name multiformatname.Name, _ string) string {
+		return fmt.Sprintf(`if _, err := sdk.AccAddressFromBech32(msg.%[1]v); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid %[2]v address (%%s)", err)
+	}`, name.UpperCamel, name.LowerCamel)
+	},
+	GoValidateImports: []GoImport{{Name: "github.com/cosmos/cosmos-sdk/types", Alias: "sdk"}},
+	ProtoImports:      []string{"cosmos_proto/cosmos.proto"},
+	CollectionsKey:    "collections.StringKey",
+	NonIndex:          true,
+	ToProtoField: func(_, name string, index int) *proto.NormalField {
+		option := protoutil.NewOption("cosmos_proto.scalar", "cosmos.AddressString", protoutil.Custom())
+		return protoutil.NewField(name, "string", index, protoutil.WithFieldOptions(option))
+	},
+}
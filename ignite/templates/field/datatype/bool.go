@@ -12,6 +12,7 @@ import (
 // DataBool bool data type definition.
 var DataBool = DataType{
 	DataType:          func(string) string { return "bool" },
+	Equal:             equalOperator,
 	DefaultTestValue:  "false",
 	ValueLoop:         "false",
 	ValueIndex:        "false",
@@ -29,6 +30,9 @@ var DataBool = DataType{
             		}`,
 			prefix, name.UpperCamel, argIndex)
 	},
+	SimulationValue: func(string) string {
+		return "r.Intn(2) == 1"
+	},
 	ToBytes: func(name string) string {
 		return fmt.Sprintf(`%[1]vBytes := []byte{0}
 					if %[1]v {
@@ -41,5 +45,6 @@ var DataBool = DataType{
 	ToProtoField: func(_, name string, index int) *proto.NormalField {
 		return protoutil.NewField(name, "bool", index)
 	},
-	GoCLIImports: []GoImport{{Name: "github.com/spf13/cast"}},
+	GoCLIImports:   []GoImport{{Name: "github.com/spf13/cast"}},
+	CollectionsKey: "collections.BoolKey",
 }
@@ -0,0 +1,38 @@
+package datatype
+
+import (
+	"fmt"
+
+	"github.com/emicklei/proto"
+
+	"github.com/ignite/cli/v29/ignite/pkg/multiformatname"
+	"github.com/ignite/cli/v29/ignite/pkg/protoanalysis/protoutil"
+)
+
+// DataBytes is a bytes data type definition.
+var DataBytes = DataType{
+	DataType:         func(string) string { return "[]byte" },
+	Equal:            equalBytes,
+	DefaultTestValue: "0a0b0c",
+	ProtoType: func(_, name string, index int) string {
+		return fmt.Sprintf("bytes %s = %d", name, index)
+	},
+	GenesisArgs: func(name multiformatname.Name, value int) string {
+		return fmt.Sprintf("%s: []byte(\"%d\"),\n", name.UpperCamel, value)
+	},
+	CLIArgs: func(name multiformatname.Name, _, prefix string, argIndex int) string {
+		return fmt.Sprintf(`%s%s, err := hex.DecodeString(args[%d])
+				if err != nil {
+					return err
+				}`, prefix, name.UpperCamel, argIndex)
+	},
+	SimulationValue: func(string) string {
+		return "[]byte(simtypes.RandStringOfLength(r, 32))"
+	},
+	GoCLIImports:   []GoImport{{Name: "encoding/hex"}},
+	GoEqualImports: []GoImport{{Name: "bytes"}},
+	NonIndex:       true,
+	ToProtoField: func(_, name string, index int) *proto.NormalField {
+		return protoutil.NewField(name, "bytes", index)
+	},
+}
@@ -13,6 +13,7 @@ var (
 	// DataCoin coin data type definition.
 	DataCoin = DataType{
 		DataType:         func(string) string { return "sdk.Coin" },
+		Equal:            equalString,
 		DefaultTestValue: "10token",
 		ProtoType: func(_, name string, index int) string {
 			return fmt.Sprintf("cosmos.base.v1beta1.Coin %s = %d [(gogoproto.nullable) = false]",
@@ -25,9 +26,14 @@ var (
 						return err
 					}`, prefix, name.UpperCamel, argIndex)
 		},
-		GoCLIImports: []GoImport{{Name: "github.com/cosmos/cosmos-sdk/types", Alias: "sdk"}},
-		ProtoImports: []string{"gogoproto/gogo.proto", "cosmos/base/v1beta1/coin.proto"},
-		NonIndex:     true,
+		SimulationValue: func(string) string {
+			return "sdk.NewInt64Coin(sdk.DefaultBondDenom, r.Int63n(1000))"
+		},
+		GoCLIImports:        []GoImport{{Name: "github.com/cosmos/cosmos-sdk/types", Alias: "sdk"}},
+		GoTypeImports:       []GoImport{{Name: "github.com/cosmos/cosmos-sdk/types", Alias: "sdk"}},
+		GoSimulationImports: []GoImport{{Name: "github.com/cosmos/cosmos-sdk/types", Alias: "sdk"}},
+		ProtoImports:        []string{"gogoproto/gogo.proto", "cosmos/base/v1beta1/coin.proto"},
+		NonIndex:            true,
 		ToProtoField: func(_, name string, index int) *proto.NormalField {
 			option := protoutil.NewOption("gogoproto.nullable", "false", protoutil.Custom())
 			return protoutil.NewField(
@@ -39,6 +45,7 @@ var (
 	// DataCoinSlice is a coin array data type definition.
 	DataCoinSlice = DataType{
 		DataType:         func(string) string { return "sdk.Coins" },
+		Equal:            equalCoins,
 		DefaultTestValue: "10token,20stake",
 		ProtoType: func(_, name string, index int) string {
 			return fmt.Sprintf("repeated cosmos.base.v1beta1.Coin %s = %d [(gogoproto.nullable) = false]",
@@ -51,9 +58,15 @@ var (
 						return err
 					}`, prefix, name.UpperCamel, argIndex)
 		},
-		GoCLIImports: []GoImport{{Name: "github.com/cosmos/cosmos-sdk/types", Alias: "sdk"}},
-		ProtoImports: []string{"gogoproto/gogo.proto", "cosmos/base/v1beta1/coin.proto"},
-		NonIndex:     true,
+		SimulationValue: func(string) string {
+			return "sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, r.Int63n(1000)))"
+		},
+		GoCLIImports:        []GoImport{{Name: "github.com/cosmos/cosmos-sdk/types", Alias: "sdk"}},
+		GoTypeImports:       []GoImport{{Name: "github.com/cosmos/cosmos-sdk/types", Alias: "sdk"}},
+		GoEqualImports:      []GoImport{{Name: "github.com/cosmos/cosmos-sdk/types", Alias: "sdk"}},
+		GoSimulationImports: []GoImport{{Name: "github.com/cosmos/cosmos-sdk/types", Alias: "sdk"}},
+		ProtoImports:        []string{"gogoproto/gogo.proto", "cosmos/base/v1beta1/coin.proto"},
+		NonIndex:            true,
 		ToProtoField: func(_, name string, index int) *proto.NormalField {
 			option := protoutil.NewOption("gogoproto.nullable", "false", protoutil.Custom())
 			return protoutil.NewField(
@@ -2,33 +2,218 @@ package datatype
 
 import (
 	"fmt"
+	"regexp"
+	"strings"
 
 	"github.com/emicklei/proto"
 
+	"github.com/ignite/cli/v29/ignite/pkg/errors"
 	"github.com/ignite/cli/v29/ignite/pkg/multiformatname"
 	"github.com/ignite/cli/v29/ignite/pkg/protoanalysis/protoutil"
 )
 
-// DataCustom is a custom data type definition.
-var DataCustom = DataType{
-	DataType:         func(datatype string) string { return fmt.Sprintf("*%s", datatype) },
-	DefaultTestValue: "null",
-	ProtoType: func(datatype, name string, index int) string {
-		return fmt.Sprintf("%s %s = %d", datatype, name, index)
-	},
-	GenesisArgs: func(name multiformatname.Name, _ int) string {
-		return fmt.Sprintf("%s: new(types.%s),\n", name.UpperCamel, name.UpperCamel)
-	},
-	CLIArgs: func(name multiformatname.Name, datatype, prefix string, argIndex int) string {
-		return fmt.Sprintf(`%[1]v%[2]v := new(types.%[3]v)
+const (
+	// customSlicePrefix is the prefix of the custom type arrays, e.g. "[]MyType".
+	customSlicePrefix = "[]"
+
+	// customMapPrefix and customMapSuffix enclose the key and value types
+	// of the custom type maps, e.g. "map<string,MyType>".
+	customMapPrefix = "map<"
+	customMapSuffix = ">"
+
+	// customMapSeparator separates the key and value types of the custom type maps.
+	customMapSeparator = ","
+)
+
+var (
+	// customTypeRe matches the custom type names. Nested types are separated by dots.
+	customTypeRe = regexp.MustCompile(`^[A-Za-z][A-Za-z0-9_]*(\.[A-Za-z][A-Za-z0-9_]*)*$`)
+
+	// customMapKeys are the supported key types of custom type maps with their Go and proto type.
+	customMapKeys = map[Name]string{
+		String: "string",
+		Int:    "int64",
+		Uint:   "uint64",
+	}
+)
+
+var (
+	// DataCustom is a custom data type definition.
+	DataCustom = DataType{
+		DataType:         func(datatype string) string { return fmt.Sprintf("*%s", customGoType(datatype)) },
+		DefaultTestValue: "null",
+		ProtoType: func(datatype, name string, index int) string {
+			return fmt.Sprintf("%s %s = %d", datatype, name, index)
+		},
+		GenesisArgs: func(name multiformatname.Name, _ int) string {
+			return fmt.Sprintf("%s: new(types.%s),\n", name.UpperCamel, name.UpperCamel)
+		},
+		CLIArgs: func(name multiformatname.Name, datatype, prefix string, argIndex int) string {
+			return fmt.Sprintf(`%[1]v%[2]v := new(types.%[3]v)
 					err = json.Unmarshal([]byte(args[%[4]v]), %[1]v%[2]v)
     				if err != nil {
                 		return err
-            		}`, prefix, name.UpperCamel, datatype, argIndex)
-	},
-	ToProtoField: func(datatype, name string, index int) *proto.NormalField {
-		return protoutil.NewField(name, datatype, index)
-	},
-	GoCLIImports: []GoImport{{Name: "encoding/json"}},
-	NonIndex:     true,
+            		}`, prefix, name.UpperCamel, customGoType(datatype), argIndex)
+		},
+		ValidateNested: func(name multiformatname.Name, _ string) string {
+			return fmt.Sprintf(`if v, ok := any(m.%[1]v).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return fmt.Errorf("invalid %[2]v: %%w", err)
+		}
+	}`, name.UpperCamel, name.LowerCamel)
+		},
+		ToProtoField: func(datatype, name string, index int) *proto.NormalField {
+			return protoutil.NewField(name, datatype, index)
+		},
+		GoCLIImports: []GoImport{{Name: "encoding/json"}},
+		NonIndex:     true,
+	}
+
+	// DataCustomSlice is a custom type array data type definition.
+	// The datatype is the name of the custom type.
+	DataCustomSlice = DataType{
+		DataType:         func(datatype string) string { return fmt.Sprintf("[]*%s", customGoType(datatype)) },
+		DefaultTestValue: "[]",
+		ProtoType: func(datatype, name string, index int) string {
+			return fmt.Sprintf("repeated %s %s = %d", datatype, name, index)
+		},
+		GenesisArgs: func(multiformatname.Name, int) string { return "" },
+		CLIArgs: func(name multiformatname.Name, datatype, prefix string, argIndex int) string {
+			return fmt.Sprintf(`var %[1]v%[2]v []*types.%[3]v
+					err = json.Unmarshal([]byte(args[%[4]v]), &%[1]v%[2]v)
+					if err != nil {
+						return err
+					}`, prefix, name.UpperCamel, customGoType(datatype), argIndex)
+		},
+		ValidateNested: func(name multiformatname.Name, _ string) string {
+			return fmt.Sprintf(`for i, elem := range m.%[1]v {
+		if v, ok := any(elem).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return fmt.Errorf("invalid %[2]v %%d: %%w", i, err)
+			}
+		}
+	}`, name.UpperCamel, name.LowerCamel)
+		},
+		ToProtoField: func(datatype, name string, index int) *proto.NormalField {
+			return protoutil.NewField(name, datatype, index, protoutil.Repeated())
+		},
+		GoCLIImports: []GoImport{{Name: "encoding/json"}},
+		NonIndex:     true,
+	}
+
+	// DataCustomMap is a custom type map data type definition.
+	// The datatype is the key type and the name of the custom type separated by a comma.
+	DataCustomMap = DataType{
+		DataType: func(datatype string) string {
+			key, value := splitCustomMap(datatype)
+			return fmt.Sprintf("map[%s]*%s", customMapKeys[key], customGoType(value))
+		},
+		DefaultTestValue: "{}",
+		ProtoType: func(datatype, name string, index int) string {
+			return fmt.Sprintf("%s %s = %d", customMapProtoType(datatype), name, index)
+		},
+		GenesisArgs: func(multiformatname.Name, int) string { return "" },
+		CLIArgs: func(name multiformatname.Name, datatype, prefix string, argIndex int) string {
+			key, value := splitCustomMap(datatype)
+			return fmt.Sprintf(`var %[1]v%[2]v map[%[3]v]*types.%[4]v
+					err = json.Unmarshal([]byte(args[%[5]v]), &%[1]v%[2]v)
+					if err != nil {
+						return err
+					}`, prefix, name.UpperCamel, customMapKeys[key], customGoType(value), argIndex)
+		},
+		ValidateNested: func(name multiformatname.Name, _ string) string {
+			return fmt.Sprintf(`for key, elem := range m.%[1]v {
+		if v, ok := any(elem).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return fmt.Errorf("invalid %[2]v %%v: %%w", key, err)
+			}
+		}
+	}`, name.UpperCamel, name.LowerCamel)
+		},
+		ToProtoField: func(datatype, name string, index int) *proto.NormalField {
+			// The proto formatter prints the field type as it is,
+			// so the map field is defined with the map type
+			return protoutil.NewField(name, customMapProtoType(datatype), index)
+		},
+		GoCLIImports: []GoImport{{Name: "encoding/json"}},
+		NonIndex:     true,
+	}
+)
+
+// ParseCustom parses the type of a field using custom types, which is either a custom type
+// "MyType", a custom type nested in another one "MyType.Nested", an array of custom types
+// "[]MyType" or a map of custom types "map<string,MyType>".
+// The type name and the datatype of the field are returned.
+func ParseCustom(s string) (Name, string, error) {
+	switch {
+	case strings.HasPrefix(s, customSlicePrefix):
+		value := strings.TrimPrefix(s, customSlicePrefix)
+		if err := validateCustomType(value); err != nil {
+			return "", "", err
+		}
+		return CustomSlice, value, nil
+
+	case strings.HasPrefix(s, customMapPrefix):
+		if !strings.HasSuffix(s, customMapSuffix) {
+			return "", "", errors.Errorf("invalid map type %s, should be 'map<key,MyType>'", s)
+		}
+		kv := strings.TrimSuffix(strings.TrimPrefix(s, customMapPrefix), customMapSuffix)
+		key, value, ok := strings.Cut(kv, customMapSeparator)
+		if !ok {
+			return "", "", errors.Errorf("invalid map type %s, should be 'map<key,MyType>'", s)
+		}
+		key, value = strings.TrimSpace(key), strings.TrimSpace(value)
+		if _, ok := customMapKeys[Name(key)]; !ok {
+			return "", "", errors.Errorf("invalid map key type %s, should be string, int or uint", key)
+		}
+		if err := validateCustomType(value); err != nil {
+			return "", "", err
+		}
+		return CustomMap, key + customMapSeparator + value, nil
+	}
+
+	if err := validateCustomType(s); err != nil {
+		return "", "", err
+	}
+	return Custom, s, nil
+}
+
+// CustomMessage returns the name of the proto message used by a custom type field,
+// e.g. "MyType" for "[]MyType" fields. An empty string is returned for the other types.
+func CustomMessage(name Name, datatype string) string {
+	switch name {
+	case Custom, CustomSlice:
+		return datatype
+	case CustomMap:
+		_, value := splitCustomMap(datatype)
+		return value
+	default:
+		return ""
+	}
+}
+
+// validateCustomType checks that the name of a custom type is a valid proto message name.
+func validateCustomType(name string) error {
+	if !customTypeRe.MatchString(name) {
+		return errors.Errorf("invalid custom type name %s", name)
+	}
+	return nil
+}
+
+// customGoType returns the Go type of a custom type. The Go types of the nested
+// proto messages are prefixed with the name of their parent message.
+func customGoType(datatype string) string {
+	return strings.ReplaceAll(datatype, ".", "_")
+}
+
+// customMapProtoType returns the proto map type of a custom type map datatype.
+func customMapProtoType(datatype string) string {
+	key, value := splitCustomMap(datatype)
+	return fmt.Sprintf("map<%s, %s>", customMapKeys[key], value)
+}
+
+// splitCustomMap returns the key type and the custom type of a custom type map datatype.
+func splitCustomMap(datatype string) (Name, string) {
+	key, value, _ := strings.Cut(datatype, customMapSeparator)
+	return Name(key), value
 }
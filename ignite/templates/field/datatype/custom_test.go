@@ -0,0 +1,102 @@
+package datatype_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/cli/v29/ignite/templates/field/datatype"
+)
+
+func TestParseCustom(t *testing.T) {
+	tests := []struct {
+		name     string
+		typename string
+		want     datatype.Name
+		datatype string
+		message  string
+		err      bool
+	}{
+		{
+			name:     "custom type",
+			typename: "Foo",
+			want:     datatype.Custom,
+			datatype: "Foo",
+			message:  "Foo",
+		},
+		{
+			name:     "nested custom type",
+			typename: "Foo.Bar",
+			want:     datatype.Custom,
+			datatype: "Foo.Bar",
+			message:  "Foo.Bar",
+		},
+		{
+			name:     "array of custom types",
+			typename: "[]Foo",
+			want:     datatype.CustomSlice,
+			datatype: "Foo",
+			message:  "Foo",
+		},
+		{
+			name:     "map of custom types",
+			typename: "map<string,Foo>",
+			want:     datatype.CustomMap,
+			datatype: "string,Foo",
+			message:  "Foo",
+		},
+		{
+			name:     "map of nested custom types with spaces",
+			typename: "map<int, Foo.Bar>",
+			want:     datatype.CustomMap,
+			datatype: "int,Foo.Bar",
+			message:  "Foo.Bar",
+		},
+		{
+			name:     "invalid custom type",
+			typename: "Foo-Bar",
+			err:      true,
+		},
+		{
+			name:     "array without type",
+			typename: "[]",
+			err:      true,
+		},
+		{
+			name:     "map without value type",
+			typename: "map<string>",
+			err:      true,
+		},
+		{
+			name:     "map with invalid key type",
+			typename: "map<coin,Foo>",
+			err:      true,
+		},
+		{
+			name:     "unclosed map",
+			typename: "map<string,Foo",
+			err:      true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			name, dt, err := datatype.ParseCustom(tt.typename)
+			if tt.err {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.want, name)
+			require.Equal(t, tt.datatype, dt)
+			require.Equal(t, tt.message, datatype.CustomMessage(name, dt))
+		})
+	}
+}
+
+func TestCustomDataTypes(t *testing.T) {
+	require.Equal(t, "*Foo_Bar", datatype.DataCustom.DataType("Foo.Bar"))
+	require.Equal(t, "[]*Foo", datatype.DataCustomSlice.DataType("Foo"))
+	require.Equal(t, "repeated Foo foos = 1", datatype.DataCustomSlice.ProtoType("Foo", "foos", 1))
+	require.Equal(t, "map[uint64]*Foo_Bar", datatype.DataCustomMap.DataType("uint,Foo.Bar"))
+	require.Equal(t, "map<uint64, Foo.Bar> foos = 2", datatype.DataCustomMap.ProtoType("uint,Foo.Bar", "foos", 2))
+}
@@ -0,0 +1,98 @@
+package datatype
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/emicklei/proto"
+
+	"github.com/ignite/cli/v29/ignite/pkg/errors"
+	"github.com/ignite/cli/v29/ignite/pkg/multiformatname"
+	"github.com/ignite/cli/v29/ignite/pkg/protoanalysis/protoutil"
+)
+
+// enumUnspecified is the name of the zero value of the enum types.
+const enumUnspecified = "unspecified"
+
+// DataEnum is an enum data type definition. The datatype is the name of the
+// enum type, which is declared in the proto file next to the messages using it.
+var DataEnum = DataType{
+	DataType:         func(datatype string) string { return datatype },
+	Equal:            equalOperator,
+	DefaultTestValue: "1",
+	ProtoType: func(datatype, name string, index int) string {
+		return fmt.Sprintf("%s %s = %d", datatype, name, index)
+	},
+	GenesisArgs: func(multiformatname.Name, int) string { return "" },
+	CLIArgs: func(name multiformatname.Name, datatype, prefix string, argIndex int) string {
+		return fmt.Sprintf(`%[1]vValue%[2]v, ok := types.%[3]v_value[args[%[4]v]]
+					if !ok {
+						return fmt.Errorf("invalid %[5]v %%s", args[%[4]v])
+					}
+					%[1]v%[2]v := types.%[3]v(%[1]vValue%[2]v)`,
+			prefix, name.UpperCamel, datatype, argIndex, name.LowerCamel)
+	},
+	SimulationValue: func(datatype string) string {
+		return fmt.Sprintf("types.%[1]v(r.Intn(len(types.%[1]v_name)))", datatype)
+	},
+	ValidateBasic: func(name multiformatname.Name, datatype string) string {
+		return fmt.Sprintf(`if _, ok := %[3]v_name[int32(msg.%[1]v)]; !ok {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "invalid %[2]v %%d", msg.%[1]v)
+	}`, name.UpperCamel, name.LowerCamel, datatype)
+	},
+	GoCLIImports: []GoImport{{Name: "fmt"}},
+	NonIndex:     true,
+	ToProtoField: func(datatype, name string, index int) *proto.NormalField {
+		return protoutil.NewField(name, datatype, index)
+	},
+}
+
+// ParseEnumValues parses the values of an enum type separated by EnumValuesSeparator.
+func ParseEnumValues(s string) ([]multiformatname.Name, error) {
+	if s == "" {
+		return nil, errors.New("enum requires at least one value, e.g. 'enum:Active|Inactive'")
+	}
+
+	var (
+		values []multiformatname.Name
+		exist  = make(map[string]struct{})
+	)
+	for _, v := range strings.Split(s, EnumValuesSeparator) {
+		value, err := multiformatname.NewName(v)
+		if err != nil {
+			return nil, errors.Errorf("invalid enum value '%s': %w", v, err)
+		}
+
+		// The zero value of the enum is always the unspecified value
+		if value.Snake == enumUnspecified {
+			return nil, errors.Errorf("enum value '%s' is reserved", v)
+		}
+
+		if _, ok := exist[value.Snake]; ok {
+			return nil, errors.Errorf("enum value '%s' is duplicated", v)
+		}
+		exist[value.Snake] = struct{}{}
+
+		values = append(values, value)
+	}
+
+	return values, nil
+}
+
+// NewProtoEnum creates the proto declaration of an enum type. The values are prefixed
+// with the enum name to be unique in the proto package, and the zero value is unspecified:
+//
+//	enum Status {
+//	  STATUS_UNSPECIFIED = 0;
+//	  STATUS_ACTIVE = 1;
+//	}
+func NewProtoEnum(name multiformatname.Name, values []multiformatname.Name) *proto.Enum {
+	prefix := strings.ToUpper(name.Snake) + "_"
+
+	fields := []*proto.EnumField{protoutil.NewEnumField(prefix+strings.ToUpper(enumUnspecified), 0)}
+	for i, v := range values {
+		fields = append(fields, protoutil.NewEnumField(prefix+strings.ToUpper(v.Snake), i+1))
+	}
+
+	return protoutil.NewEnum(name.UpperCamel, protoutil.WithEnumFields(fields...))
+}
@@ -0,0 +1,90 @@
+package datatype_test
+
+import (
+	"testing"
+
+	"github.com/emicklei/proto"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/cli/v29/ignite/pkg/multiformatname"
+	"github.com/ignite/cli/v29/ignite/templates/field/datatype"
+)
+
+func TestParseEnumValues(t *testing.T) {
+	tests := []struct {
+		name   string
+		values string
+		want   []string
+		err    bool
+	}{
+		{
+			name:   "single value",
+			values: "Active",
+			want:   []string{"active"},
+		},
+		{
+			name:   "multiple values",
+			values: "Active|Inactive|onHold",
+			want:   []string{"active", "inactive", "on_hold"},
+		},
+		{
+			name:   "no values",
+			values: "",
+			err:    true,
+		},
+		{
+			name:   "empty value",
+			values: "Active||Inactive",
+			err:    true,
+		},
+		{
+			name:   "reserved value",
+			values: "Active|Unspecified",
+			err:    true,
+		},
+		{
+			name:   "duplicated value",
+			values: "Active|active",
+			err:    true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			values, err := datatype.ParseEnumValues(tt.values)
+			if tt.err {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			got := make([]string, len(values))
+			for i, v := range values {
+				got[i] = v.Snake
+			}
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestNewProtoEnum(t *testing.T) {
+	// Arrange
+	name, err := multiformatname.NewName("orderStatus")
+	require.NoError(t, err)
+	values, err := datatype.ParseEnumValues("Open|Closed")
+	require.NoError(t, err)
+
+	// Act
+	enum := datatype.NewProtoEnum(name, values)
+
+	// Assert
+	require.Equal(t, "OrderStatus", enum.Name)
+
+	var fields []string
+	for i, e := range enum.Elements {
+		field, ok := e.(*proto.EnumField)
+		require.True(t, ok)
+		require.Equal(t, i, field.Integer)
+		fields = append(fields, field.Name)
+	}
+	require.Equal(t, []string{"ORDER_STATUS_UNSPECIFIED", "ORDER_STATUS_OPEN", "ORDER_STATUS_CLOSED"}, fields)
+}
@@ -13,6 +13,7 @@ var (
 	// DataInt is an int data type definition.
 	DataInt = DataType{
 		DataType:          func(string) string { return "int32" },
+		Equal:             equalOperator,
 		DefaultTestValue:  "111",
 		ValueLoop:         "int32(i)",
 		ValueIndex:        "0",
@@ -30,6 +31,9 @@ var (
             		}`,
 				prefix, name.UpperCamel, argIndex)
 		},
+		SimulationValue: func(string) string {
+			return "r.Int31()"
+		},
 		ToBytes: func(name string) string {
 			return fmt.Sprintf(`%[1]vBytes := make([]byte, 4)
   					binary.BigEndian.PutUint32(%[1]vBytes, uint32(%[1]v))`, name)
@@ -40,12 +44,14 @@ var (
 		ToProtoField: func(_, name string, index int) *proto.NormalField {
 			return protoutil.NewField(name, "int32", index)
 		},
-		GoCLIImports: []GoImport{{Name: "github.com/spf13/cast"}},
+		GoCLIImports:   []GoImport{{Name: "github.com/spf13/cast"}},
+		CollectionsKey: "collections.Int32Key",
 	}
 
 	// DataIntSlice is an int array data type definition.
 	DataIntSlice = DataType{
 		DataType:         func(string) string { return "[]int32" },
+		Equal:            equalSlices,
 		DefaultTestValue: "1,2,3,4,5",
 		ProtoType: func(_, name string, index int) string {
 			return fmt.Sprintf("repeated int32 %s = %d", name, index)
@@ -64,10 +70,14 @@ var (
 						%[1]v%[2]v[i] = value
 					}`, prefix, name.UpperCamel, argIndex)
 		},
+		SimulationValue: func(string) string {
+			return "[]int32{r.Int31()}"
+		},
 		ToProtoField: func(_, name string, index int) *proto.NormalField {
 			return protoutil.NewField(name, "int32", index, protoutil.Repeated())
 		},
-		GoCLIImports: []GoImport{{Name: "github.com/spf13/cast"}, {Name: "strings"}},
-		NonIndex:     true,
+		GoCLIImports:   []GoImport{{Name: "github.com/spf13/cast"}, {Name: "strings"}},
+		GoEqualImports: []GoImport{{Name: "slices"}},
+		NonIndex:       true,
 	}
 )
@@ -0,0 +1,94 @@
+package datatype
+
+import (
+	"fmt"
+
+	"github.com/emicklei/proto"
+
+	"github.com/ignite/cli/v29/ignite/pkg/multiformatname"
+	"github.com/ignite/cli/v29/ignite/pkg/protoanalysis/protoutil"
+)
+
+var (
+	// DataDec is a decimal data type definition using the Cosmos SDK legacy decimal type.
+	DataDec = DataType{
+		DataType:         func(string) string { return "math.LegacyDec" },
+		Equal:            equalString,
+		DefaultTestValue: "1.5",
+		ProtoType: func(_, name string, index int) string {
+			return fmt.Sprintf(`string %s = %d [(cosmos_proto.scalar) = "cosmos.Dec", `+
+				`(gogoproto.customtype) = "cosmossdk.io/math.LegacyDec", (gogoproto.nullable) = false]`,
+				name, index)
+		},
+		GenesisArgs: func(name multiformatname.Name, value int) string {
+			return fmt.Sprintf("%s: math.LegacyNewDec(%d),\n", name.UpperCamel, value)
+		},
+		CLIArgs: func(name multiformatname.Name, _, prefix string, argIndex int) string {
+			return fmt.Sprintf(`%s%s, err := math.LegacyNewDecFromStr(args[%d])
+					if err != nil {
+						return err
+					}`, prefix, name.UpperCamel, argIndex)
+		},
+		SimulationValue: func(string) string {
+			return "math.LegacyNewDecWithPrec(r.Int63n(1000000), 6)"
+		},
+		ValidateBasic: func(name multiformatname.Name, _ string) string {
+			return fmt.Sprintf(`if msg.%[1]v.IsNil() {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "%[2]v can't be empty")
+	}`, name.UpperCamel, name.LowerCamel)
+		},
+		GoCLIImports:        []GoImport{{Name: "cosmossdk.io/math"}},
+		GoTypeImports:       []GoImport{{Name: "cosmossdk.io/math"}},
+		GoSimulationImports: []GoImport{{Name: "cosmossdk.io/math"}},
+		ProtoImports:        []string{"gogoproto/gogo.proto", "cosmos_proto/cosmos.proto"},
+		NonIndex:            true,
+		ToProtoField: func(_, name string, index int) *proto.NormalField {
+			return protoutil.NewField(name, "string", index, protoutil.WithFieldOptions(
+				protoutil.NewOption("cosmos_proto.scalar", "cosmos.Dec", protoutil.Custom()),
+				protoutil.NewOption("gogoproto.customtype", "cosmossdk.io/math.LegacyDec", protoutil.Custom()),
+				protoutil.NewOption("gogoproto.nullable", "false", protoutil.Custom()),
+			))
+		},
+	}
+
+	// DataMathInt is an arbitrary precision integer data type definition using the Cosmos SDK integer type.
+	DataMathInt = DataType{
+		DataType:         func(string) string { return "math.Int" },
+		Equal:            equalString,
+		DefaultTestValue: "1000",
+		ProtoType: func(_, name string, index int) string {
+			return fmt.Sprintf(`string %s = %d [(cosmos_proto.scalar) = "cosmos.Int", `+
+				`(gogoproto.customtype) = "cosmossdk.io/math.Int", (gogoproto.nullable) = false]`,
+				name, index)
+		},
+		GenesisArgs: func(name multiformatname.Name, value int) string {
+			return fmt.Sprintf("%s: math.NewInt(%d),\n", name.UpperCamel, value)
+		},
+		CLIArgs: func(name multiformatname.Name, _, prefix string, argIndex int) string {
+			return fmt.Sprintf(`%[1]v%[2]v, ok := math.NewIntFromString(args[%[3]v])
+					if !ok {
+						return fmt.Errorf("invalid integer %%s", args[%[3]v])
+					}`, prefix, name.UpperCamel, argIndex)
+		},
+		SimulationValue: func(string) string {
+			return "math.NewInt(r.Int63n(1000000))"
+		},
+		ValidateBasic: func(name multiformatname.Name, _ string) string {
+			return fmt.Sprintf(`if msg.%[1]v.IsNil() {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "%[2]v can't be empty")
+	}`, name.UpperCamel, name.LowerCamel)
+		},
+		GoCLIImports:        []GoImport{{Name: "cosmossdk.io/math"}, {Name: "fmt"}},
+		GoTypeImports:       []GoImport{{Name: "cosmossdk.io/math"}},
+		GoSimulationImports: []GoImport{{Name: "cosmossdk.io/math"}},
+		ProtoImports:        []string{"gogoproto/gogo.proto", "cosmos_proto/cosmos.proto"},
+		NonIndex:            true,
+		ToProtoField: func(_, name string, index int) *proto.NormalField {
+			return protoutil.NewField(name, "string", index, protoutil.WithFieldOptions(
+				protoutil.NewOption("cosmos_proto.scalar", "cosmos.Int", protoutil.Custom()),
+				protoutil.NewOption("gogoproto.customtype", "cosmossdk.io/math.Int", protoutil.Custom()),
+				protoutil.NewOption("gogoproto.nullable", "false", protoutil.Custom()),
+			))
+		},
+	}
+)
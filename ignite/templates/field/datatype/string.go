@@ -13,6 +13,7 @@ var (
 	// DataString is a string data type definition.
 	DataString = DataType{
 		DataType:          func(string) string { return "string" },
+		Equal:             equalOperator,
 		DefaultTestValue:  "xyz",
 		ValueLoop:         "strconv.Itoa(i)",
 		ValueIndex:        "strconv.Itoa(0)",
@@ -26,6 +27,9 @@ var (
 		CLIArgs: func(name multiformatname.Name, _, prefix string, argIndex int) string {
 			return fmt.Sprintf("%s%s := args[%d]", prefix, name.UpperCamel, argIndex)
 		},
+		SimulationValue: func(string) string {
+			return "simtypes.RandStringOfLength(r, 10)"
+		},
 		ToBytes: func(name string) string {
 			return fmt.Sprintf("%[1]vBytes := []byte(%[1]v)", name)
 		},
@@ -35,11 +39,13 @@ var (
 		ToProtoField: func(_, name string, index int) *proto.NormalField {
 			return protoutil.NewField(name, "string", index)
 		},
+		CollectionsKey: "collections.StringKey",
 	}
 
 	// DataStringSlice is a string array data type definition.
 	DataStringSlice = DataType{
 		DataType:         func(string) string { return "[]string" },
+		Equal:            equalSlices,
 		DefaultTestValue: "abc,xyz",
 		ProtoType: func(_, name string, index int) string {
 			return fmt.Sprintf("repeated string %s = %d", name, index)
@@ -51,8 +57,12 @@ var (
 			return fmt.Sprintf(`%[1]v%[2]v := strings.Split(args[%[3]v], listSeparator)`,
 				prefix, name.UpperCamel, argIndex)
 		},
-		GoCLIImports: []GoImport{{Name: "strings"}},
-		NonIndex:     true,
+		SimulationValue: func(string) string {
+			return "[]string{simtypes.RandStringOfLength(r, 10)}"
+		},
+		GoCLIImports:   []GoImport{{Name: "strings"}},
+		GoEqualImports: []GoImport{{Name: "slices"}},
+		NonIndex:       true,
 		ToProtoField: func(_, name string, index int) *proto.NormalField {
 			return protoutil.NewField(name, "string", index, protoutil.Repeated())
 		},
@@ -0,0 +1,79 @@
+package datatype
+
+import (
+	"fmt"
+
+	"github.com/emicklei/proto"
+
+	"github.com/ignite/cli/v29/ignite/pkg/multiformatname"
+	"github.com/ignite/cli/v29/ignite/pkg/protoanalysis/protoutil"
+)
+
+var (
+	// DataTimestamp is a timestamp data type definition.
+	DataTimestamp = DataType{
+		DataType:         func(string) string { return "time.Time" },
+		Equal:            equalMethod,
+		DefaultTestValue: "2024-01-01T00:00:00Z",
+		ProtoType: func(_, name string, index int) string {
+			return fmt.Sprintf("google.protobuf.Timestamp %s = %d [(gogoproto.stdtime) = true, (gogoproto.nullable) = false]",
+				name, index)
+		},
+		GenesisArgs: func(name multiformatname.Name, value int) string {
+			return fmt.Sprintf("%s: time.Unix(%d, 0).UTC(),\n", name.UpperCamel, value)
+		},
+		CLIArgs: func(name multiformatname.Name, _, prefix string, argIndex int) string {
+			return fmt.Sprintf(`%s%s, err := time.Parse(time.RFC3339, args[%d])
+					if err != nil {
+						return err
+					}`, prefix, name.UpperCamel, argIndex)
+		},
+		SimulationValue: func(string) string {
+			return "simtypes.RandTimestamp(r)"
+		},
+		GoCLIImports:  []GoImport{{Name: "time"}},
+		GoTypeImports: []GoImport{{Name: "time"}},
+		ProtoImports:  []string{"gogoproto/gogo.proto", "google/protobuf/timestamp.proto"},
+		NonIndex:      true,
+		ToProtoField: func(_, name string, index int) *proto.NormalField {
+			return protoutil.NewField(name, "google.protobuf.Timestamp", index, protoutil.WithFieldOptions(
+				protoutil.NewOption("gogoproto.stdtime", "true", protoutil.Custom()),
+				protoutil.NewOption("gogoproto.nullable", "false", protoutil.Custom()),
+			))
+		},
+	}
+
+	// DataDuration is a duration data type definition.
+	DataDuration = DataType{
+		DataType:         func(string) string { return "time.Duration" },
+		Equal:            equalOperator,
+		DefaultTestValue: "1h30m",
+		ProtoType: func(_, name string, index int) string {
+			return fmt.Sprintf("google.protobuf.Duration %s = %d [(gogoproto.stdduration) = true, (gogoproto.nullable) = false]",
+				name, index)
+		},
+		GenesisArgs: func(name multiformatname.Name, value int) string {
+			return fmt.Sprintf("%s: %d * time.Second,\n", name.UpperCamel, value)
+		},
+		CLIArgs: func(name multiformatname.Name, _, prefix string, argIndex int) string {
+			return fmt.Sprintf(`%s%s, err := time.ParseDuration(args[%d])
+					if err != nil {
+						return err
+					}`, prefix, name.UpperCamel, argIndex)
+		},
+		SimulationValue: func(string) string {
+			return "time.Duration(r.Int63n(int64(24 * time.Hour)))"
+		},
+		GoCLIImports:        []GoImport{{Name: "time"}},
+		GoTypeImports:       []GoImport{{Name: "time"}},
+		GoSimulationImports: []GoImport{{Name: "time"}},
+		ProtoImports:        []string{"gogoproto/gogo.proto", "google/protobuf/duration.proto"},
+		NonIndex:            true,
+		ToProtoField: func(_, name string, index int) *proto.NormalField {
+			return protoutil.NewField(name, "google.protobuf.Duration", index, protoutil.WithFieldOptions(
+				protoutil.NewOption("gogoproto.stdduration", "true", protoutil.Custom()),
+				protoutil.NewOption("gogoproto.nullable", "false", protoutil.Custom()),
+			))
+		},
+	}
+)
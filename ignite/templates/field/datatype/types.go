@@ -1,6 +1,8 @@
 package datatype
 
 import (
+	"fmt"
+
 	"github.com/emicklei/proto"
 
 	"github.com/ignite/cli/v29/ignite/pkg/multiformatname"
@@ -28,8 +30,26 @@ const (
 	Coin Name = "coin"
 	// Coins represents the coin array type name.
 	Coins Name = "array.coin"
+	// Address represents the account address type name.
+	Address Name = "address"
+	// Dec represents the decimal type name.
+	Dec Name = "dec"
+	// MathInt represents the arbitrary precision integer type name.
+	MathInt Name = "math.int"
+	// Timestamp represents the timestamp type name.
+	Timestamp Name = "timestamp"
+	// Duration represents the duration type name.
+	Duration Name = "duration"
+	// Bytes represents the bytes type name.
+	Bytes Name = "bytes"
+	// Enum represents the enum type name.
+	Enum Name = "enum"
 	// Custom represents the custom type name.
 	Custom Name = Name(TypeCustom)
+	// CustomSlice represents the custom type array type name.
+	CustomSlice Name = Name(TypeCustomSlice)
+	// CustomMap represents the custom type map type name.
+	CustomMap Name = Name(TypeCustomMap)
 
 	// StringSliceAlias represents the string array type name alias.
 	StringSliceAlias Name = "strings"
@@ -42,6 +62,13 @@ const (
 
 	// TypeCustom represents the string type name id.
 	TypeCustom = "customstarporttype"
+	// TypeCustomSlice represents the custom type array type name id.
+	TypeCustomSlice = "customslicestarporttype"
+	// TypeCustomMap represents the custom type map type name id.
+	TypeCustomMap = "custommapstarporttype"
+
+	// EnumValuesSeparator separates the values of an enum type, e.g. "enum:Active|Inactive".
+	EnumValuesSeparator = "|"
 )
 
 // supportedTypes all support data types and definitions.
@@ -59,7 +86,16 @@ var supportedTypes = map[Name]DataType{
 	Coin:             DataCoin,
 	Coins:            DataCoinSlice,
 	CoinSliceAlias:   DataCoinSlice,
+	Address:          DataAddress,
+	Dec:              DataDec,
+	MathInt:          DataMathInt,
+	Timestamp:        DataTimestamp,
+	Duration:         DataDuration,
+	Bytes:            DataBytes,
+	Enum:             DataEnum,
 	Custom:           DataCustom,
+	CustomSlice:      DataCustomSlice,
+	CustomMap:        DataCustomMap,
 }
 
 // Name represents the Alias Name for the data type.
@@ -67,20 +103,29 @@ type Name string
 
 // DataType represents the data types for code replacement.
 type DataType struct {
-	DataType          func(datatype string) string
-	ProtoType         func(datatype, name string, index int) string
-	GenesisArgs       func(name multiformatname.Name, value int) string
-	ProtoImports      []string
-	GoCLIImports      []GoImport
-	DefaultTestValue  string
-	ValueLoop         string
-	ValueIndex        string
-	ValueInvalidIndex string
-	ToBytes           func(name string) string
-	ToString          func(name string) string
-	ToProtoField      func(datatype, name string, index int) *proto.NormalField
-	CLIArgs           func(name multiformatname.Name, datatype, prefix string, argIndex int) string
-	NonIndex          bool
+	DataType            func(datatype string) string
+	ProtoType           func(datatype, name string, index int) string
+	GenesisArgs         func(name multiformatname.Name, value int) string
+	ProtoImports        []string
+	GoCLIImports        []GoImport
+	GoTypeImports       []GoImport
+	GoValidateImports   []GoImport
+	GoEqualImports      []GoImport
+	GoSimulationImports []GoImport
+	DefaultTestValue    string
+	ValueLoop           string
+	ValueIndex          string
+	ValueInvalidIndex   string
+	ToBytes             func(name string) string
+	ToString            func(name string) string
+	ToProtoField        func(datatype, name string, index int) *proto.NormalField
+	CLIArgs             func(name multiformatname.Name, datatype, prefix string, argIndex int) string
+	SimulationValue     func(datatype string) string
+	ValidateBasic       func(name multiformatname.Name, datatype string) string
+	ValidateNested      func(name multiformatname.Name, datatype string) string
+	Equal               func(a, b string) string
+	CollectionsKey      string
+	NonIndex            bool
 }
 
 // GoImport represents the go import repo name with the alias.
@@ -95,3 +140,35 @@ func IsSupportedType(typename Name) (dt DataType, ok bool) {
 	dt, ok = supportedTypes[typename]
 	return
 }
+
+// equalOperator compares two values with the equal operator.
+func equalOperator(a, b string) string {
+	return fmt.Sprintf("%s == %s", a, b)
+}
+
+// equalMethod compares two values with the Equal method of the type.
+func equalMethod(a, b string) string {
+	return fmt.Sprintf("%s.Equal(%s)", a, b)
+}
+
+// equalString compares two values with their string representation, which
+// is safe for the zero values of the math types.
+func equalString(a, b string) string {
+	return fmt.Sprintf("%s.String() == %s.String()", a, b)
+}
+
+// equalSlices compares two slices of comparable values.
+func equalSlices(a, b string) string {
+	return fmt.Sprintf("slices.Equal(%s, %s)", a, b)
+}
+
+// equalCoins compares two coin slices, which are generated as plain slices of
+// coins, with the comparison of sdk.Coins that ignores the order of the coins.
+func equalCoins(a, b string) string {
+	return fmt.Sprintf("sdk.Coins(%s).Equal(sdk.Coins(%s))", a, b)
+}
+
+// equalBytes compares two byte slices.
+func equalBytes(a, b string) string {
+	return fmt.Sprintf("bytes.Equal(%s, %s)", a, b)
+}
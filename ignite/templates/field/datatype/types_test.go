@@ -64,6 +64,16 @@ func TestIsSupportedType(t *testing.T) {
 			typename: datatype.Custom,
 			ok:       true,
 		},
+		{
+			name:     "custom slice",
+			typename: datatype.CustomSlice,
+			ok:       true,
+		},
+		{
+			name:     "custom map",
+			typename: datatype.CustomMap,
+			ok:       true,
+		},
 		{
 			name:     "string slice alias",
 			typename: datatype.StringSliceAlias,
@@ -84,6 +94,41 @@ func TestIsSupportedType(t *testing.T) {
 			typename: datatype.CoinSliceAlias,
 			ok:       true,
 		},
+		{
+			name:     "address",
+			typename: datatype.Address,
+			ok:       true,
+		},
+		{
+			name:     "dec",
+			typename: datatype.Dec,
+			ok:       true,
+		},
+		{
+			name:     "math int",
+			typename: datatype.MathInt,
+			ok:       true,
+		},
+		{
+			name:     "timestamp",
+			typename: datatype.Timestamp,
+			ok:       true,
+		},
+		{
+			name:     "duration",
+			typename: datatype.Duration,
+			ok:       true,
+		},
+		{
+			name:     "bytes",
+			typename: datatype.Bytes,
+			ok:       true,
+		},
+		{
+			name:     "enum",
+			typename: datatype.Enum,
+			ok:       true,
+		},
 		{
 			name:     "invalid type name",
 			typename: datatype.Name("invalid"),
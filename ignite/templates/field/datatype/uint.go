@@ -13,6 +13,7 @@ var (
 	// DataUint uint data type definition.
 	DataUint = DataType{
 		DataType:          func(string) string { return "uint64" },
+		Equal:             equalOperator,
 		DefaultTestValue:  "111",
 		ValueLoop:         "uint64(i)",
 		ValueIndex:        "0",
@@ -30,6 +31,9 @@ var (
             		}`,
 				prefix, name.UpperCamel, argIndex)
 		},
+		SimulationValue: func(string) string {
+			return "r.Uint64()"
+		},
 		ToBytes: func(name string) string {
 			return fmt.Sprintf(`%[1]vBytes := make([]byte, 8)
   					binary.BigEndian.PutUint64(%[1]vBytes, %[1]v)`, name)
@@ -40,12 +44,14 @@ var (
 		ToProtoField: func(_, name string, index int) *proto.NormalField {
 			return protoutil.NewField(name, "uint64", index)
 		},
-		GoCLIImports: []GoImport{{Name: "github.com/spf13/cast"}},
+		GoCLIImports:   []GoImport{{Name: "github.com/spf13/cast"}},
+		CollectionsKey: "collections.Uint64Key",
 	}
 
 	// DataUintSlice uint array data type definition.
 	DataUintSlice = DataType{
 		DataType:         func(string) string { return "[]uint64" },
+		Equal:            equalSlices,
 		DefaultTestValue: "1,2,3,4,5",
 		ProtoType: func(_, name string, index int) string {
 			return fmt.Sprintf("repeated uint64 %s = %d", name, index)
@@ -65,10 +71,14 @@ var (
 					}`,
 				prefix, name.UpperCamel, argIndex)
 		},
+		SimulationValue: func(string) string {
+			return "[]uint64{r.Uint64()}"
+		},
 		ToProtoField: func(_, name string, index int) *proto.NormalField {
 			return protoutil.NewField(name, "uint64", index, protoutil.Repeated())
 		},
-		GoCLIImports: []GoImport{{Name: "github.com/spf13/cast"}, {Name: "strings"}},
-		NonIndex:     true,
+		GoCLIImports:   []GoImport{{Name: "github.com/spf13/cast"}, {Name: "strings"}},
+		GoEqualImports: []GoImport{{Name: "slices"}},
+		NonIndex:       true,
 	}
 )
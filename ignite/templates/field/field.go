@@ -15,7 +15,32 @@ import (
 type Field struct {
 	Name         multiformatname.Name
 	DatatypeName datatype.Name
-	Datatype     string
+
+	// Datatype is the name of a custom type or the values of an enum type.
+	Datatype string
+
+	// Scope is the name of the type, message or packet owning the field.
+	// It prefixes the name of the enum types to keep them unique in the proto package.
+	Scope string
+}
+
+// datatype returns the type name used by the data type definition.
+// Enum types are named after the scope and the field.
+func (f Field) datatype() string {
+	if f.DatatypeName == datatype.Enum {
+		return f.enumName().UpperCamel
+	}
+	return f.Datatype
+}
+
+// enumName returns the name of the field enum type, e.g. PostStatus
+// for the status field of a post type.
+func (f Field) enumName() multiformatname.Name {
+	name, err := multiformatname.NewName(f.Scope + f.Name.UpperCamel)
+	if err != nil {
+		panic(err)
+	}
+	return name
 }
 
 // DataType returns the field Datatype.
@@ -24,7 +49,7 @@ func (f Field) DataType() string {
 	if !ok {
 		panic(fmt.Sprintf("unknown type %s", f.DatatypeName))
 	}
-	return dt.DataType(f.Datatype)
+	return dt.DataType(f.datatype())
 }
 
 // ProtoFieldName returns the field name used in proto.
@@ -38,7 +63,7 @@ func (f Field) ProtoType(index int) string {
 	if !ok {
 		panic(fmt.Sprintf("unknown type %s", f.DatatypeName))
 	}
-	return dt.ProtoType(f.Datatype, f.ProtoFieldName(), index)
+	return dt.ProtoType(f.datatype(), f.ProtoFieldName(), index)
 }
 
 // DefaultTestValue returns the Datatype value default.
@@ -101,7 +126,7 @@ func (f Field) CLIArgs(prefix string, argIndex int) string {
 	if !ok {
 		panic(fmt.Sprintf("unknown type %s", f.DatatypeName))
 	}
-	return dt.CLIArgs(f.Name, f.Datatype, prefix, argIndex)
+	return dt.CLIArgs(f.Name, f.datatype(), prefix, argIndex)
 }
 
 // ToBytes returns the Datatype byte array cast.
@@ -135,7 +160,83 @@ func (f Field) ToProtoField(index int) *proto.NormalField {
 	if !ok {
 		panic(fmt.Sprintf("unknown type %s", f.DatatypeName))
 	}
-	return dt.ToProtoField(f.Datatype, f.Name.LowerCamel, index)
+	return dt.ToProtoField(f.datatype(), f.Name.LowerCamel, index)
+}
+
+// SimulationValue returns the Datatype random value for simulations.
+// An empty string is returned when the Datatype has no simulation value.
+func (f Field) SimulationValue() string {
+	dt, ok := datatype.IsSupportedType(f.DatatypeName)
+	if !ok {
+		panic(fmt.Sprintf("unknown type %s", f.DatatypeName))
+	}
+	if dt.SimulationValue == nil {
+		return ""
+	}
+	return dt.SimulationValue(f.datatype())
+}
+
+// ValidateBasic returns the Datatype validation of the field of a message named msg.
+// An empty string is returned when the Datatype has no validation.
+func (f Field) ValidateBasic() string {
+	dt, ok := datatype.IsSupportedType(f.DatatypeName)
+	if !ok {
+		panic(fmt.Sprintf("unknown type %s", f.DatatypeName))
+	}
+	if dt.ValidateBasic == nil {
+		return ""
+	}
+	return dt.ValidateBasic(f.Name, f.datatype())
+}
+
+// ValidateNested returns the Datatype validation of the nested custom types of the field
+// in the Validate method of a type named m. An empty string is returned when the Datatype
+// has no nested types.
+func (f Field) ValidateNested() string {
+	dt, ok := datatype.IsSupportedType(f.DatatypeName)
+	if !ok {
+		panic(fmt.Sprintf("unknown type %s", f.DatatypeName))
+	}
+	if dt.ValidateNested == nil {
+		return ""
+	}
+	return dt.ValidateNested(f.Name, f.datatype())
+}
+
+// Equal returns the Datatype comparison of two values of the field named a and b.
+// The values are deeply compared when the Datatype has no comparison.
+func (f Field) Equal(a, b string) string {
+	dt, ok := datatype.IsSupportedType(f.DatatypeName)
+	if !ok {
+		panic(fmt.Sprintf("unknown type %s", f.DatatypeName))
+	}
+	if dt.Equal == nil {
+		return fmt.Sprintf("reflect.DeepEqual(%s, %s)", a, b)
+	}
+	return dt.Equal(a, b)
+}
+
+// CollectionsKey returns the key codec of the Datatype used by collections.
+// An empty string is returned when the Datatype can't be used as a collections key.
+func (f Field) CollectionsKey() string {
+	dt, ok := datatype.IsSupportedType(f.DatatypeName)
+	if !ok {
+		panic(fmt.Sprintf("unknown type %s", f.DatatypeName))
+	}
+	return dt.CollectionsKey
+}
+
+// ProtoEnum returns the proto declaration of the field enum type,
+// or nil when the field is not an enum.
+func (f Field) ProtoEnum() *proto.Enum {
+	if f.DatatypeName != datatype.Enum {
+		return nil
+	}
+	values, err := datatype.ParseEnumValues(f.Datatype)
+	if err != nil {
+		panic(err)
+	}
+	return datatype.NewProtoEnum(f.enumName(), values)
 }
 
 // GoCLIImports returns the Datatype imports for CLI package.
@@ -147,6 +248,47 @@ func (f Field) GoCLIImports() []datatype.GoImport {
 	return dt.GoCLIImports
 }
 
+// GoTypeImports returns the Datatype imports required by the Go type of the field.
+func (f Field) GoTypeImports() []datatype.GoImport {
+	dt, ok := datatype.IsSupportedType(f.DatatypeName)
+	if !ok {
+		panic(fmt.Sprintf("unknown type %s", f.DatatypeName))
+	}
+	return dt.GoTypeImports
+}
+
+// GoValidateImports returns the Datatype imports required by the validation of the field,
+// in addition to the errorsmod and sdkerrors packages.
+func (f Field) GoValidateImports() []datatype.GoImport {
+	dt, ok := datatype.IsSupportedType(f.DatatypeName)
+	if !ok {
+		panic(fmt.Sprintf("unknown type %s", f.DatatypeName))
+	}
+	return dt.GoValidateImports
+}
+
+// GoEqualImports returns the Datatype imports required by the comparison of the field values.
+func (f Field) GoEqualImports() []datatype.GoImport {
+	dt, ok := datatype.IsSupportedType(f.DatatypeName)
+	if !ok {
+		panic(fmt.Sprintf("unknown type %s", f.DatatypeName))
+	}
+	if dt.Equal == nil {
+		return []datatype.GoImport{{Name: "reflect"}}
+	}
+	return dt.GoEqualImports
+}
+
+// GoSimulationImports returns the Datatype imports required by the simulation value of the field.
+// The simulation code is expected to import the simtypes package.
+func (f Field) GoSimulationImports() []datatype.GoImport {
+	dt, ok := datatype.IsSupportedType(f.DatatypeName)
+	if !ok {
+		panic(fmt.Sprintf("unknown type %s", f.DatatypeName))
+	}
+	return dt.GoSimulationImports
+}
+
 // ProtoImports returns the Datatype imports for proto files.
 func (f Field) ProtoImports() []string {
 	dt, ok := datatype.IsSupportedType(f.DatatypeName)
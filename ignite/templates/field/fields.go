@@ -2,6 +2,9 @@ package field
 
 import (
 	"fmt"
+	"strings"
+
+	"github.com/emicklei/proto"
 
 	"github.com/ignite/cli/v29/ignite/pkg/multiformatname"
 	"github.com/ignite/cli/v29/ignite/templates/field/datatype"
@@ -12,10 +15,35 @@ type Fields []Field
 
 // GoCLIImports returns all go CLI imports.
 func (f Fields) GoCLIImports() []datatype.GoImport {
+	return f.goImports(Field.GoCLIImports)
+}
+
+// GoTypeImports returns all go imports required by the Go types of the fields.
+func (f Fields) GoTypeImports() []datatype.GoImport {
+	return f.goImports(Field.GoTypeImports)
+}
+
+// GoValidateImports returns all go imports required by the validation of the fields.
+func (f Fields) GoValidateImports() []datatype.GoImport {
+	return f.goImports(Field.GoValidateImports)
+}
+
+// GoEqualImports returns all go imports required to compare the values of the fields.
+func (f Fields) GoEqualImports() []datatype.GoImport {
+	return f.goImports(Field.GoEqualImports)
+}
+
+// GoSimulationImports returns all go imports required by the simulation values of the fields.
+func (f Fields) GoSimulationImports() []datatype.GoImport {
+	return f.goImports(Field.GoSimulationImports)
+}
+
+// goImports returns the go imports of the fields without duplicates.
+func (f Fields) goImports(imports func(Field) []datatype.GoImport) []datatype.GoImport {
 	allImports := make([]datatype.GoImport, 0)
 	exist := make(map[string]struct{})
-	for _, fields := range f {
-		for _, goImport := range fields.GoCLIImports() {
+	for _, field := range f {
+		for _, goImport := range imports(field) {
 			if _, ok := exist[goImport.Name]; ok {
 				continue
 			}
@@ -42,6 +70,62 @@ func (f Fields) ProtoImports() []string {
 	return allImports
 }
 
+// WithScope returns the fields with the scope used to name their enum types.
+func (f Fields) WithScope(scope string) Fields {
+	fields := make(Fields, len(f))
+	for i, field := range f {
+		field.Scope = scope
+		fields[i] = field
+	}
+	return fields
+}
+
+// ProtoEnums returns the proto declarations of the enum types of the fields.
+func (f Fields) ProtoEnums() []*proto.Enum {
+	enums := make([]*proto.Enum, 0)
+	for _, field := range f {
+		if enum := field.ProtoEnum(); enum != nil {
+			enums = append(enums, enum)
+		}
+	}
+	return enums
+}
+
+// Simulated returns the fields with a simulation value.
+func (f Fields) Simulated() Fields {
+	fields := make(Fields, 0)
+	for _, field := range f {
+		if field.SimulationValue() != "" {
+			fields = append(fields, field)
+		}
+	}
+	return fields
+}
+
+// ValidateBasic returns the validations of the fields in the ValidateBasic method of a message,
+// where the message receiver is named msg.
+func (f Fields) ValidateBasic() string {
+	var validations []string
+	for _, field := range f {
+		if v := field.ValidateBasic(); v != "" {
+			validations = append(validations, v)
+		}
+	}
+	return strings.Join(validations, "\n\n\t")
+}
+
+// ValidateNested returns the validations of the nested custom types of the fields
+// in the Validate method of a type, where the type receiver is named m.
+func (f Fields) ValidateNested() string {
+	var validations []string
+	for _, field := range f {
+		if v := field.ValidateNested(); v != "" {
+			validations = append(validations, v)
+		}
+	}
+	return strings.Join(validations, "\n\n\t")
+}
+
 // String returns all inline fields args for command usage.
 func (f Fields) String() string {
 	args := ""
@@ -52,16 +136,21 @@ func (f Fields) String() string {
 }
 
 // Custom return a list of custom fields.
+// The custom types are named after the proto file that defines them,
+// which is the file of the parent message for nested custom types.
 func (f Fields) Custom() []string {
 	fields := make([]string, 0)
 	for _, field := range f {
-		if field.DatatypeName == datatype.TypeCustom {
-			dataType, err := multiformatname.NewName(field.Datatype)
-			if err != nil {
-				panic(err)
-			}
-			fields = append(fields, dataType.Snake)
+		message := datatype.CustomMessage(field.DatatypeName, field.Datatype)
+		if message == "" {
+			continue
+		}
+		parent, _, _ := strings.Cut(message, ".")
+		dataType, err := multiformatname.NewName(parent)
+		if err != nil {
+			panic(err)
 		}
+		fields = append(fields, dataType.Snake)
 	}
 	return fields
 }
@@ -0,0 +1,94 @@
+package field_test
+
+import (
+	"testing"
+
+	"github.com/emicklei/proto"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/cli/v29/ignite/templates/field"
+	"github.com/ignite/cli/v29/ignite/templates/field/datatype"
+)
+
+func TestFieldsWithScope(t *testing.T) {
+	noCheck := func(string) error { return nil }
+
+	posts, err := field.ParseFields([]string{"title", "status:enum:draft|published"}, noCheck)
+	require.NoError(t, err)
+	comments, err := field.ParseFields([]string{"status:enum:pending|approved"}, noCheck)
+	require.NoError(t, err)
+
+	posts = posts.WithScope("Post")
+	comments = comments.WithScope("Comment")
+
+	require.Equal(t, "string", posts[0].DataType())
+	require.Equal(t, "PostStatus", posts[1].DataType())
+	require.Equal(t, "CommentStatus", comments[0].DataType())
+
+	postEnums := posts.ProtoEnums()
+	require.Len(t, postEnums, 1)
+	require.Equal(t, "PostStatus", postEnums[0].Name)
+
+	commentEnums := comments.ProtoEnums()
+	require.Len(t, commentEnums, 1)
+	require.Equal(t, "CommentStatus", commentEnums[0].Name)
+
+	// The enum values are prefixed by the scoped enum name
+	var values []string
+	for _, enums := range [][]*proto.Enum{postEnums, commentEnums} {
+		for _, e := range enums[0].Elements {
+			values = append(values, e.(*proto.EnumField).Name)
+		}
+	}
+	require.Equal(t, []string{
+		"POST_STATUS_UNSPECIFIED",
+		"POST_STATUS_DRAFT",
+		"POST_STATUS_PUBLISHED",
+		"COMMENT_STATUS_UNSPECIFIED",
+		"COMMENT_STATUS_PENDING",
+		"COMMENT_STATUS_APPROVED",
+	}, values)
+}
+
+func TestFieldsGoImports(t *testing.T) {
+	noCheck := func(string) error { return nil }
+
+	fields, err := field.ParseFields([]string{
+		"name",
+		"balance:array.coin",
+		"tags:array.string",
+		"ids:array.uint",
+		"checksum:bytes",
+		"price:dec",
+		"document:Document",
+	}, noCheck)
+	require.NoError(t, err)
+
+	sdk := datatype.GoImport{Name: "github.com/cosmos/cosmos-sdk/types", Alias: "sdk"}
+
+	require.Equal(t, "sdk.Coins(a.Balance).Equal(sdk.Coins(b.Balance))", fields[1].Equal("a.Balance", "b.Balance"))
+	require.Equal(t, []datatype.GoImport{
+		sdk,
+		{Name: "slices"},
+		{Name: "bytes"},
+		{Name: "reflect"},
+	}, fields.GoEqualImports())
+	require.Equal(t, []datatype.GoImport{
+		sdk,
+		{Name: "cosmossdk.io/math"},
+	}, fields.GoSimulationImports())
+
+	// The custom types have no simulation value
+	simulated := fields.Simulated()
+	require.Len(t, simulated, 6)
+	require.NotContains(t, simulated.GoEqualImports(), datatype.GoImport{Name: "reflect"})
+
+	fields, err = field.ParseFields([]string{"owner:address", "amount:math.int", "paidAt:timestamp", "fee:coin"}, noCheck)
+	require.NoError(t, err)
+	require.Equal(t, []datatype.GoImport{
+		{Name: "cosmossdk.io/math"},
+		{Name: "time"},
+		sdk,
+	}, fields.GoTypeImports())
+	require.Equal(t, []datatype.GoImport{sdk}, fields.GoValidateImports())
+}
@@ -9,29 +9,43 @@ import (
 )
 
 // validateField validates the field Name and type, and checks the name is not forbidden by Ignite CLI.
-func validateField(field string, isForbiddenField func(string) error) (multiformatname.Name, datatype.Name, error) {
-	fieldSplit := strings.Split(field, datatype.Separator)
-	if len(fieldSplit) > 2 {
-		return multiformatname.Name{}, "", errors.Errorf("invalid field format: %s, should be 'Name' or 'Name:type'", field)
+// The values of enum types are returned with the type name.
+func validateField(field string, isForbiddenField func(string) error) (multiformatname.Name, datatype.Name, string, error) {
+	fieldSplit := strings.SplitN(field, datatype.Separator, 3)
+	if len(fieldSplit) > 2 && datatype.Name(fieldSplit[1]) != datatype.Enum {
+		return multiformatname.Name{}, "", "", errors.Errorf("invalid field format: %s, should be 'Name' or 'Name:type'", field)
 	}
 
 	name, err := multiformatname.NewName(fieldSplit[0])
 	if err != nil {
-		return name, "", err
+		return name, "", "", err
 	}
 
 	// Ensure the field Name is not a Go reserved Name, it would generate an incorrect code
 	if err := isForbiddenField(name.LowerCamel); err != nil {
-		return name, "", errors.Errorf("%s can't be used as a field Name: %w", name, err)
+		return name, "", "", errors.Errorf("%s can't be used as a field Name: %w", name, err)
 	}
 
 	// Check if the object has an explicit type. The default is a string
 	dataTypeName := datatype.String
-	isTypeSpecified := len(fieldSplit) == 2
+	isTypeSpecified := len(fieldSplit) >= 2
 	if isTypeSpecified {
 		dataTypeName = datatype.Name(fieldSplit[1])
 	}
-	return name, dataTypeName, nil
+
+	// Enum types define their values after the type name
+	if dataTypeName != datatype.Enum {
+		return name, dataTypeName, "", nil
+	}
+
+	var values string
+	if len(fieldSplit) == 3 {
+		values = fieldSplit[2]
+	}
+	if _, err := datatype.ParseEnumValues(values); err != nil {
+		return name, "", "", errors.Errorf("invalid field %s: %w", name.Original, err)
+	}
+	return name, dataTypeName, values, nil
 }
 
 // ParseFields parses the provided fields, analyses the types
@@ -51,7 +65,7 @@ func ParseFields(
 
 	var parsedFields Fields
 	for _, field := range fields {
-		name, datatypeName, err := validateField(field, isForbiddenField)
+		name, datatypeName, enumValues, err := validateField(field, isForbiddenField)
 		if err != nil {
 			return parsedFields, err
 		}
@@ -62,6 +76,15 @@ func ParseFields(
 		}
 		existingFields[name.LowerCamel] = struct{}{}
 
+		if datatypeName == datatype.Enum {
+			parsedFields = append(parsedFields, Field{
+				Name:         name,
+				DatatypeName: datatypeName,
+				Datatype:     enumValues,
+			})
+			continue
+		}
+
 		// Check if is a static type
 		if _, ok := datatype.IsSupportedType(datatypeName); ok {
 			parsedFields = append(parsedFields, Field{
@@ -71,10 +94,15 @@ func ParseFields(
 			continue
 		}
 
+		// Otherwise it's a custom type, or an array or a map of custom types
+		customName, customType, err := datatype.ParseCustom(string(datatypeName))
+		if err != nil {
+			return parsedFields, errors.Errorf("invalid field %s: %w", name.Original, err)
+		}
 		parsedFields = append(parsedFields, Field{
 			Name:         name,
-			Datatype:     string(datatypeName),
-			DatatypeName: datatype.TypeCustom,
+			Datatype:     customType,
+			DatatypeName: customName,
 		})
 	}
 	return parsedFields, nil
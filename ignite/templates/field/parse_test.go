@@ -35,6 +35,34 @@ func TestForbiddenParseFields(t *testing.T) {
 	// invalid format
 	_, err = ParseFields([]string{"foo:int:int"}, alwaysInvalid)
 	require.Error(t, err)
+
+	// enum without values
+	_, err = ParseFields([]string{"foo:enum"}, noCheck)
+	require.Error(t, err)
+
+	// enum with invalid values
+	_, err = ParseFields([]string{"foo:enum:Active||Inactive"}, noCheck)
+	require.Error(t, err)
+
+	// enum with the reserved unspecified value
+	_, err = ParseFields([]string{"foo:enum:Active|Unspecified"}, noCheck)
+	require.Error(t, err)
+
+	// enum with duplicated values
+	_, err = ParseFields([]string{"foo:enum:Active|active"}, noCheck)
+	require.Error(t, err)
+
+	// invalid custom type
+	_, err = ParseFields([]string{"foo:[]"}, noCheck)
+	require.Error(t, err)
+
+	// invalid map key
+	_, err = ParseFields([]string{"foo:map<bool,Bla>"}, noCheck)
+	require.Error(t, err)
+
+	// invalid map format
+	_, err = ParseFields([]string{"foo:map<string>"}, noCheck)
+	require.Error(t, err)
 }
 
 func TestParseFields1(t *testing.T) {
@@ -165,6 +193,37 @@ func TestParseFields1(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "test nested and repeated custom types",
+			fields: []string{
+				name1.Original + ":[]Bla",
+				name2.Original + ":map<string,Test>",
+				name3.Original + ":Bla.Nested",
+				name4.Original + ":map<uint,Bla.Nested>",
+			},
+			want: Fields{
+				{
+					Name:         name1,
+					DatatypeName: datatype.CustomSlice,
+					Datatype:     "Bla",
+				},
+				{
+					Name:         name2,
+					DatatypeName: datatype.CustomMap,
+					Datatype:     "string,Test",
+				},
+				{
+					Name:         name3,
+					DatatypeName: datatype.Custom,
+					Datatype:     "Bla.Nested",
+				},
+				{
+					Name:         name4,
+					DatatypeName: datatype.CustomMap,
+					Datatype:     "uint,Bla.Nested",
+				},
+			},
+		},
 		{
 			name: "test sdk.Coin types",
 			fields: []string{
@@ -182,6 +241,56 @@ func TestParseFields1(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "test cosmos types",
+			fields: []string{
+				name1.Original + ":address",
+				name2.Original + ":dec",
+				name3.Original + ":math.int",
+				name4.Original + ":bytes",
+			},
+			want: Fields{
+				{
+					Name:         name1,
+					DatatypeName: datatype.Address,
+				},
+				{
+					Name:         name2,
+					DatatypeName: datatype.Dec,
+				},
+				{
+					Name:         name3,
+					DatatypeName: datatype.MathInt,
+				},
+				{
+					Name:         name4,
+					DatatypeName: datatype.Bytes,
+				},
+			},
+		},
+		{
+			name: "test time and enum types",
+			fields: []string{
+				name1.Original + ":timestamp",
+				name2.Original + ":duration",
+				name3.Original + ":enum:Active|Inactive",
+			},
+			want: Fields{
+				{
+					Name:         name1,
+					DatatypeName: datatype.Timestamp,
+				},
+				{
+					Name:         name2,
+					DatatypeName: datatype.Duration,
+				},
+				{
+					Name:         name3,
+					DatatypeName: datatype.Enum,
+					Datatype:     "Active|Inactive",
+				},
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -1,10 +1,13 @@
 package plushhelpers
 
 import (
+	"slices"
 	"strings"
 
+	"github.com/emicklei/proto"
 	"github.com/gobuffalo/plush/v4"
 
+	"github.com/ignite/cli/v29/ignite/pkg/protoanalysis/protoutil"
 	"github.com/ignite/cli/v29/ignite/pkg/xstrings"
 	"github.com/ignite/cli/v29/ignite/templates/field"
 	"github.com/ignite/cli/v29/ignite/templates/field/datatype"
@@ -13,8 +16,11 @@ import (
 // ExtendPlushContext sets available field helpers on the provided context.
 func ExtendPlushContext(ctx *plush.Context) {
 	ctx.Set("mergeGoImports", mergeGoImports)
+	ctx.Set("concatGoImports", concatGoImports)
+	ctx.Set("omitGoImports", omitGoImports)
 	ctx.Set("mergeProtoImports", mergeProtoImports)
 	ctx.Set("mergeCustomImports", mergeCustomImports)
+	ctx.Set("protoEnums", protoEnums)
 	ctx.Set("title", xstrings.Title)
 	ctx.Set("toLower", strings.ToLower)
 }
@@ -49,6 +55,33 @@ func mergeGoImports(fields ...field.Fields) []datatype.GoImport {
 	return allImports
 }
 
+// concatGoImports concatenates the go imports without duplicates.
+func concatGoImports(imports ...[]datatype.GoImport) []datatype.GoImport {
+	allImports := make([]datatype.GoImport, 0)
+	exist := make(map[string]struct{})
+	for _, imports := range imports {
+		for _, goImport := range imports {
+			if _, ok := exist[goImport.Name]; ok {
+				continue
+			}
+			exist[goImport.Name] = struct{}{}
+			allImports = append(allImports, goImport)
+		}
+	}
+	return allImports
+}
+
+// omitGoImports removes from the go imports the packages that are always imported by a template.
+func omitGoImports(imports []datatype.GoImport, names ...string) []datatype.GoImport {
+	filtered := make([]datatype.GoImport, 0)
+	for _, goImport := range imports {
+		if !slices.Contains(names, goImport.Name) {
+			filtered = append(filtered, goImport)
+		}
+	}
+	return filtered
+}
+
 func mergeProtoImports(fields ...field.Fields) []string {
 	allImports := make([]string, 0)
 	exist := make(map[string]struct{})
@@ -63,3 +96,24 @@ func mergeProtoImports(fields ...field.Fields) []string {
 	}
 	return allImports
 }
+
+// protoEnums returns the proto definitions of the enum types of the fields.
+func protoEnums(fields ...field.Fields) string {
+	var (
+		f     = &proto.Proto{}
+		exist = make(map[string]struct{})
+	)
+	for _, fields := range fields {
+		for _, enum := range fields.ProtoEnums() {
+			if _, ok := exist[enum.Name]; ok {
+				continue
+			}
+			exist[enum.Name] = struct{}{}
+			f.Elements = append(f.Elements, enum)
+		}
+	}
+	if len(f.Elements) == 0 {
+		return ""
+	}
+	return "\n" + protoutil.Print(f)
+}
@@ -242,6 +242,13 @@ func protoModify(opts *PacketOptions) genny.RunFn {
 		protoutil.AttachComment(packetAck, typenameUpper+"PacketAck defines a struct for the packet acknowledgment")
 		protoutil.Append(protoFile, packetData, packetAck)
 
+		// Declare the enum types that are not declared yet
+		for _, enum := range append(opts.Fields.ProtoEnums(), opts.AckFields.ProtoEnums()...) {
+			if !protoutil.HasEnum(protoFile, enum.Name) {
+				protoutil.Append(protoFile, enum)
+			}
+		}
+
 		// Add any custom imports.
 		var protoImports []*proto.Import
 		for _, imp := range append(opts.Fields.ProtoImports(), opts.AckFields.ProtoImports()...) {
@@ -344,6 +351,11 @@ func protoTxModify(opts *PacketOptions) genny.RunFn {
 			protopath := fmt.Sprintf("%[1]v/%[2]v/%[3]v.proto", opts.AppName, opts.ModuleName, f)
 			protoImports = append(protoImports, protoutil.NewImport(protopath))
 		}
+		// The enum types of the packet fields are declared in the packet file
+		if len(opts.Fields.ProtoEnums()) > 0 {
+			protopath := fmt.Sprintf("%[1]v/%[2]v/packet.proto", opts.AppName, opts.ModuleName)
+			protoImports = append(protoImports, protoutil.NewImport(protopath))
+		}
 		if err := protoutil.AddImports(protoFile, true, protoImports...); err != nil {
 			return errors.Errorf("error while processing %s: %w", path, err)
 		}
@@ -26,6 +26,9 @@ var (
 
 	//go:embed files/simapp/* files/simapp/**/*
 	fsSimapp embed.FS
+
+	//go:embed files/events/* files/events/**/*
+	fsEvents embed.FS
 )
 
 func Box(box packd.Walker, opts *Options, g *genny.Generator) error {
@@ -41,6 +44,7 @@ func Box(box packd.Walker, opts *Options, g *genny.Generator) error {
 	ctx.Set("ModulePath", opts.ModulePath)
 	ctx.Set("Fields", opts.Fields)
 	ctx.Set("ResFields", opts.ResFields)
+	ctx.Set("Events", opts.Events)
 
 	plushhelpers.ExtendPlushContext(ctx)
 	g.Transformer(xgenny.Transformer(ctx))
@@ -79,6 +83,17 @@ func NewGenerator(replacer placeholder.Replacer, opts *Options) (*genny.Generato
 			return nil, err
 		}
 	}
+
+	if opts.Events {
+		eventsTemplate := xgenny.NewEmbedWalker(
+			fsEvents,
+			"files/events",
+			opts.AppPath,
+		)
+		if err := Box(eventsTemplate, opts, g); err != nil {
+			return nil, err
+		}
+	}
 	return g, Box(template, opts, g)
 }
 
@@ -149,6 +164,22 @@ func protoTxMessageModify(opts *Options) genny.RunFn {
 		msgResp := protoutil.NewMessage("Msg"+typenameUpper+"Response", protoutil.WithFields(resFields...))
 		protoutil.Append(protoFile, msg, msgResp)
 
+		// The event emitted by the message has the same fields
+		if opts.Events {
+			eventFields := []*proto.NormalField{protoutil.NewField(opts.MsgSigner.LowerCamel, "string", 1)}
+			for i, field := range opts.Fields {
+				eventFields = append(eventFields, field.ToProtoField(i+2))
+			}
+			protoutil.Append(protoFile, protoutil.NewMessage("Event"+typenameUpper, protoutil.WithFields(eventFields...)))
+		}
+
+		// Declare the enum types that are not declared yet
+		for _, enum := range append(opts.ResFields.ProtoEnums(), opts.Fields.ProtoEnums()...) {
+			if !protoutil.HasEnum(protoFile, enum.Name) {
+				protoutil.Append(protoFile, enum)
+			}
+		}
+
 		// Ensure custom types are imported
 		var protoImports []*proto.Import
 		for _, imp := range append(opts.ResFields.ProtoImports(), opts.Fields.ProtoImports()...) {
@@ -18,6 +18,7 @@ type Options struct {
 	Fields       field.Fields
 	ResFields    field.Fields
 	NoSimulation bool
+	Events       bool
 }
 
 // Validate that options are usable.
@@ -131,6 +131,13 @@ func protoQueryModify(opts *Options) genny.RunFn {
 		responseMessage := protoutil.NewMessage("Query"+typenameUpper+"Response", protoutil.WithFields(resFields...))
 		protoutil.Append(protoFile, requestMessage, responseMessage)
 
+		// Declare the enum types that are not declared yet
+		for _, enum := range append(opts.ResFields.ProtoEnums(), opts.ReqFields.ProtoEnums()...) {
+			if !protoutil.HasEnum(protoFile, enum.Name) {
+				protoutil.Append(protoFile, enum)
+			}
+		}
+
 		// Ensure custom types are imported
 		var protoImports []*proto.Import
 		for _, imp := range append(opts.ResFields.ProtoImports(), opts.ReqFields.ProtoImports()...) {
@@ -22,5 +22,10 @@ func NewGenerator(opts *typed.Options) (*genny.Generator, error) {
 			opts.AppPath,
 		)
 	)
+
+	if err := typed.BoxValidate(opts, g); err != nil {
+		return nil, err
+	}
+
 	return g, typed.Box(template, opts, g)
 }
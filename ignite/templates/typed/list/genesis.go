@@ -99,14 +99,25 @@ for _, elem := range gs.%[3]vList {
 	if elem.Id >= %[2]vCount {
 		return fmt.Errorf("%[2]v id should be lower or equal than the last id")
 	}
-	%[2]vIdMap[elem.Id] = true
+	%[2]vIdMap[elem.Id] = true%[4]v
 }
 %[1]v`
+
+		// Validate the nested custom types of the elements
+		var validateNested string
+		if opts.Fields.ValidateNested() != "" {
+			validateNested = fmt.Sprintf(`
+	if err := elem.Validate(); err != nil {
+		return fmt.Errorf("invalid %[1]v %%d: %%w", elem.Id, err)
+	}`, opts.TypeName.LowerCamel)
+		}
+
 		replacementTypesValidate := fmt.Sprintf(
 			templateTypesValidate,
 			typed.PlaceholderGenesisTypesValidate,
 			opts.TypeName.LowerCamel,
 			opts.TypeName.UpperCamel,
+			validateNested,
 		)
 		content = replacer.Replace(content, typed.PlaceholderGenesisTypesValidate, replacementTypesValidate)
 
@@ -76,10 +76,19 @@ func NewGenerator(replacer placeholder.Replacer, opts *typed.Options) (*genny.Ge
 		if err := typed.Box(messagesTemplate, opts, g); err != nil {
 			return nil, err
 		}
+
+		// Rules of the messages
+		if err := typed.BoxRules(opts, g); err != nil {
+			return nil, err
+		}
 	}
 
 	g.RunFn(frontendSrcStoreAppModify(replacer, opts))
 
+	if err := typed.BoxValidate(opts, g); err != nil {
+		return nil, err
+	}
+
 	return g, typed.Box(componentTemplate, opts, g)
 }
 
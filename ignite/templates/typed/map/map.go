@@ -35,6 +35,9 @@ var (
 
 	//go:embed files/simapp/* files/simapp/**/*
 	fsSimapp embed.FS
+
+	//go:embed files/indexes/* files/indexes/**/*
+	fsIndexes embed.FS
 )
 
 // NewGenerator returns the generator to scaffold a new map type in a module.
@@ -76,6 +79,11 @@ func NewGenerator(replacer placeholder.Replacer, opts *typed.Options) (*genny.Ge
 			"files/simapp/",
 			opts.AppPath,
 		)
+		indexesTemplate = xgenny.NewEmbedWalker(
+			fsIndexes,
+			"files/indexes/",
+			opts.AppPath,
+		)
 	)
 
 	g.RunFn(protoRPCModify(opts))
@@ -107,6 +115,9 @@ func NewGenerator(replacer placeholder.Replacer, opts *typed.Options) (*genny.Ge
 				return nil, err
 			}
 		}
+		if err := typed.BoxRules(opts, g); err != nil {
+			return nil, err
+		}
 	}
 
 	if generateTest {
@@ -114,6 +125,19 @@ func NewGenerator(replacer placeholder.Replacer, opts *typed.Options) (*genny.Ge
 			return nil, err
 		}
 	}
+
+	// Modifications for secondary indexes
+	if len(opts.SecondaryIndexes) > 0 {
+		g.RunFn(keeperModify(replacer, opts))
+		if err := typed.Box(indexesTemplate, opts, g); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := typed.BoxValidate(opts, g); err != nil {
+		return nil, err
+	}
+
 	return g, typed.Box(componentTemplate, opts, g)
 }
 
@@ -187,6 +211,27 @@ func protoRPCModify(opts *typed.Options) genny.RunFn {
 		protoutil.AttachComment(rpcQueryGet, fmt.Sprintf("Queries a list of %v items.", typenameUpper))
 		protoutil.Append(serviceQuery, rpcQueryGet, rpcQueryAll)
 
+		for _, index := range opts.SecondaryIndexes {
+			rpcQueryBy := protoutil.NewRPC(
+				fmt.Sprintf("List%sBy%s", typenameUpper, index.Name.UpperCamel),
+				fmt.Sprintf("QueryList%sBy%sRequest", typenameUpper, index.Name.UpperCamel),
+				fmt.Sprintf("QueryList%sBy%sResponse", typenameUpper, index.Name.UpperCamel),
+				protoutil.WithRPCOptions(
+					protoutil.NewOption(
+						"google.api.http",
+						fmt.Sprintf(
+							"/%s/%s/%s/by-%s/{%s}",
+							appModulePath, opts.ModuleName, typenameSnake, index.Name.Kebab, index.ProtoFieldName(),
+						),
+						protoutil.Custom(),
+						protoutil.SetField("get"),
+					),
+				),
+			)
+			protoutil.AttachComment(rpcQueryBy, fmt.Sprintf("Queries a list of %v items by %v.", typenameUpper, index.Name.LowerCamel))
+			protoutil.Append(serviceQuery, rpcQueryBy)
+		}
+
 		//  Ensure custom types are imported
 		var protoImports []*proto.Import
 		for _, imp := range opts.Fields.ProtoImports() {
@@ -236,11 +281,114 @@ func protoRPCModify(opts *typed.Options) genny.RunFn {
 		)
 		protoutil.Append(protoFile, queryGetRequest, queryGetResponse, queryAllRequest, queryAllResponse)
 
+		for _, index := range opts.SecondaryIndexes {
+			queryByRequest := protoutil.NewMessage(
+				fmt.Sprintf("QueryList%sBy%sRequest", typenameUpper, index.Name.UpperCamel),
+				protoutil.WithFields(
+					index.ToProtoField(1),
+					protoutil.NewField(paginationName, paginationType+"Request", 2),
+				),
+			)
+			queryByResponse := protoutil.NewMessage(
+				fmt.Sprintf("QueryList%sBy%sResponse", typenameUpper, index.Name.UpperCamel),
+				protoutil.WithFields(
+					protoutil.NewField(
+						typenameLower,
+						typenameUpper,
+						1,
+						protoutil.Repeated(),
+						protoutil.WithFieldOptions(gogoOption),
+					),
+					protoutil.NewField(paginationName, fmt.Sprintf("%sResponse", paginationType), 2),
+				),
+			)
+			protoutil.Append(protoFile, queryByRequest, queryByResponse)
+		}
+
 		newFile := genny.NewFileS(path, protoutil.Print(protoFile))
 		return r.File(newFile)
 	}
 }
 
+// keeperModify modifies the keeper to add the indexed map of the type, which is used
+// to update the secondary indexes when a value is set or removed, and the key sets
+// used to paginate the values by secondary index.
+func keeperModify(replacer placeholder.Replacer, opts *typed.Options) genny.RunFn {
+	return func(r *genny.Runner) error {
+		path := filepath.Join(opts.AppPath, "x", opts.ModuleName, "keeper/keeper.go")
+		f, err := r.Disk.Find(path)
+		if err != nil {
+			return err
+		}
+
+		templateKeeperType := `%[2]v *collections.IndexedMap[[]byte, types.%[2]v, %[2]vIndexes]
+	%[1]v`
+		replacementModuleType := fmt.Sprintf(
+			templateKeeperType,
+			typed.PlaceholderCollectionType,
+			opts.TypeName.UpperCamel,
+		)
+		content := replacer.Replace(f.String(), typed.PlaceholderCollectionType, replacementModuleType)
+
+		// The indexed map uses the same prefix as the store of the type values
+		templateKeeperInstantiate := `%[2]v: collections.NewIndexedMap(
+		sb,
+		collections.NewPrefix(types.%[2]vKeyPrefix),
+		"%[3]v",
+		collections.BytesKey,
+		codec.CollValue[types.%[2]v](cdc),
+		New%[2]vIndexes(sb),
+	),
+	%[1]v`
+		replacementInstantiate := fmt.Sprintf(
+			templateKeeperInstantiate,
+			typed.PlaceholderCollectionInstantiate,
+			opts.TypeName.UpperCamel,
+			opts.TypeName.Snake,
+		)
+		content = replacer.Replace(content, typed.PlaceholderCollectionInstantiate, replacementInstantiate)
+
+		// The key sets of the secondary indexes paginate the values by index. They read
+		// the keys stored by the indexes, so they use their own schema builder to avoid
+		// registering the index prefixes twice in the keeper schema. Their prefixes are
+		// copied from byte slices so the raw iterations of the pagination don't share the
+		// prefix memory between the start and the end of the range.
+		for _, index := range opts.SecondaryIndexes {
+			templateKeySetType := `%[2]vBy%[3]vKeys collections.KeySet[collections.Pair[%[4]v, []byte]]
+	%[1]v`
+			replacementKeySetType := fmt.Sprintf(
+				templateKeySetType,
+				typed.PlaceholderCollectionType,
+				opts.TypeName.UpperCamel,
+				index.Name.UpperCamel,
+				index.DataType(),
+			)
+			content = replacer.Replace(content, typed.PlaceholderCollectionType, replacementKeySetType)
+
+			templateKeySetInstantiate := `%[2]vBy%[3]vKeys: collections.NewKeySet(
+		collections.NewSchemaBuilder(storeService),
+		collections.NewPrefix([]byte(types.%[2]vBy%[3]vKeyPrefix)),
+		"%[4]v_by_%[5]v",
+		collections.PairKeyCodec(%[6]v, collections.BytesKey),
+	),
+	%[1]v`
+			replacementKeySetInstantiate := fmt.Sprintf(
+				templateKeySetInstantiate,
+				typed.PlaceholderCollectionInstantiate,
+				opts.TypeName.UpperCamel,
+				index.Name.UpperCamel,
+				opts.TypeName.Snake,
+				index.Name.Snake,
+				index.CollectionsKey(),
+			)
+			content = replacer.Replace(content, typed.PlaceholderCollectionInstantiate, replacementKeySetInstantiate)
+		}
+
+		newFile := genny.NewFileS(path, content)
+		return r.File(newFile)
+	}
+}
+
 func clientCliQueryModify(replacer placeholder.Replacer, opts *typed.Options) genny.RunFn {
 	return func(r *genny.Runner) error {
 		path := filepath.Join(opts.AppPath, "x", opts.ModuleName, "module/autocli.go")
@@ -267,9 +415,30 @@ func clientCliQueryModify(replacer placeholder.Replacer, opts *typed.Options) ge
 			PositionalArgs: []*autocliv1.PositionalArgDescriptor{%[5]s},
 		},
 		%[1]v`
+
+		// The secondary indexes queries are added after the list and get queries
+		var secondaryIndexQueries string
+		for _, index := range opts.SecondaryIndexes {
+			secondaryIndexQueries += fmt.Sprintf(`{
+			RpcMethod: "List%[1]vBy%[2]v",
+			Use: "list-%[3]v-by-%[4]v [%[4]v]",
+			Short: "List all %[5]v by %[6]v",
+			PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "%[7]v"}},
+		},
+		`,
+				opts.TypeName.UpperCamel,
+				index.Name.UpperCamel,
+				opts.TypeName.Kebab,
+				index.Name.Kebab,
+				opts.TypeName.Original,
+				index.Name.Original,
+				index.ProtoFieldName(),
+			)
+		}
+
 		replacement := fmt.Sprintf(
 			template,
-			typed.PlaceholderAutoCLIQuery,
+			secondaryIndexQueries+typed.PlaceholderAutoCLIQuery,
 			opts.TypeName.UpperCamel,
 			opts.TypeName.Kebab,
 			opts.TypeName.Original,
@@ -358,15 +527,26 @@ for _, elem := range gs.%[3]vList {
 	if _, ok := %[2]vIndexMap[index]; ok {
 		return fmt.Errorf("duplicated index for %[2]v")
 	}
-	%[2]vIndexMap[index] = struct{}{}
+	%[2]vIndexMap[index] = struct{}{}%[5]v
 }
 %[1]v`
+
+		// Validate the nested custom types of the elements
+		var validateNested string
+		if opts.Fields.ValidateNested() != "" {
+			validateNested = fmt.Sprintf(`
+	if err := elem.Validate(); err != nil {
+		return fmt.Errorf("invalid %[1]v: %%w", err)
+	}`, opts.TypeName.LowerCamel)
+		}
+
 		replacementTypesValidate := fmt.Sprintf(
 			templateTypesValidate,
 			typed.PlaceholderGenesisTypesValidate,
 			opts.TypeName.LowerCamel,
 			opts.TypeName.UpperCamel,
 			fmt.Sprintf("string(%s)", keyCall),
+			validateNested,
 		)
 		content = replacer.Replace(content, typed.PlaceholderGenesisTypesValidate, replacementTypesValidate)
 
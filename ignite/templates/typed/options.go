@@ -13,18 +13,23 @@ import (
 
 // Options ...
 type Options struct {
-	AppName      string
-	AppPath      string
-	ProtoDir     string
-	ModuleName   string
-	ModulePath   string
-	TypeName     multiformatname.Name
-	MsgSigner    multiformatname.Name
-	Fields       field.Fields
-	Indexes      field.Fields
-	NoMessage    bool
-	NoSimulation bool
-	IsIBC        bool
+	AppName          string
+	AppPath          string
+	ProtoDir         string
+	ModuleName       string
+	ModulePath       string
+	TypeName         multiformatname.Name
+	MsgSigner        multiformatname.Name
+	Fields           field.Fields
+	Indexes          field.Fields
+	SecondaryIndexes field.Fields
+	Authority        string
+	ImmutableFields  field.Fields
+	Status           StatusField
+	Events           bool
+	NoMessage        bool
+	NoSimulation     bool
+	IsIBC            bool
 }
 
 // Validate that options are usable.
@@ -0,0 +1,149 @@
+package typed
+
+import (
+	"embed"
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/gobuffalo/genny/v2"
+
+	"github.com/ignite/cli/v29/ignite/pkg/errors"
+	"github.com/ignite/cli/v29/ignite/pkg/multiformatname"
+	"github.com/ignite/cli/v29/ignite/pkg/xgenny"
+	"github.com/ignite/cli/v29/ignite/templates/field"
+	"github.com/ignite/cli/v29/ignite/templates/field/datatype"
+)
+
+const (
+	// AuthorityCreator allows anyone to create a value, which can only be updated
+	// or deleted by its creator.
+	AuthorityCreator = "creator"
+
+	// AuthorityAnyone allows anyone to create, update or delete any value.
+	AuthorityAnyone = "anyone"
+
+	// AuthorityGov only allows the module authority, usually the gov module account,
+	// to create, update or delete values.
+	AuthorityGov = "gov"
+
+	// AuthorityModuleAdmin only allows the admin defined in the module params
+	// to create, update or delete values.
+	AuthorityModuleAdmin = "module-admin"
+
+	// ModuleAdminParam is the name of the module param that defines the module admin.
+	ModuleAdminParam = "admin"
+
+	// statusSeparator separates the states of a status field, e.g. "status:draft->active->closed".
+	statusSeparator = "->"
+)
+
+// Authorities are the supported authorities of the messages of a type.
+var Authorities = []string{AuthorityCreator, AuthorityAnyone, AuthorityGov, AuthorityModuleAdmin}
+
+//go:embed files/rules/* files/rules/**/*
+var fsRules embed.FS
+
+type (
+	// StatusField is a string field of a type that can only change from a state to the next one.
+	StatusField struct {
+		Field  field.Field
+		States []multiformatname.Name
+
+		// Initial is the state of the new values, which is the first state.
+		Initial multiformatname.Name
+	}
+
+	// StatusTransition is an allowed transition between two states of a status field.
+	StatusTransition struct {
+		From multiformatname.Name
+		To   multiformatname.Name
+	}
+)
+
+// ParseStatusField parses a status field with the format "name:state1->state2->state3".
+// At least two states are required and the first one is the state of the new values.
+func ParseStatusField(s string) (StatusField, error) {
+	name, states, ok := strings.Cut(s, datatype.Separator)
+	if !ok {
+		return StatusField{}, errors.Errorf("invalid status field %s, should be 'name:state1->state2'", s)
+	}
+
+	fieldName, err := multiformatname.NewName(name)
+	if err != nil {
+		return StatusField{}, err
+	}
+
+	status := StatusField{Field: field.Field{Name: fieldName, DatatypeName: datatype.String}}
+	exists := make(map[string]struct{})
+	for _, state := range strings.Split(states, statusSeparator) {
+		stateName, err := multiformatname.NewName(strings.TrimSpace(state))
+		if err != nil {
+			return StatusField{}, errors.Errorf("invalid state of status field %s: %w", name, err)
+		}
+		if _, ok := exists[stateName.Snake]; ok {
+			return StatusField{}, errors.Errorf("duplicated state %s in status field %s", state, name)
+		}
+		exists[stateName.Snake] = struct{}{}
+		status.States = append(status.States, stateName)
+	}
+	if len(status.States) < 2 {
+		return StatusField{}, errors.Errorf("status field %s must have at least two states", name)
+	}
+	status.Initial = status.States[0]
+
+	return status, nil
+}
+
+// Defined returns true when a status field is defined.
+func (s StatusField) Defined() bool {
+	return len(s.States) > 0
+}
+
+// Transitions returns the allowed transitions, which are from each state to the next one.
+func (s StatusField) Transitions() []StatusTransition {
+	var transitions []StatusTransition
+	for i := 1; i < len(s.States); i++ {
+		transitions = append(transitions, StatusTransition{From: s.States[i-1], To: s.States[i]})
+	}
+	return transitions
+}
+
+// BoxRules adds to the generator the validation of the immutable fields and the
+// status transitions of the type with their tests and errors. Nothing is added
+// if the type has no immutable fields nor status field.
+func BoxRules(opts *Options, g *genny.Generator) error {
+	if len(opts.ImmutableFields) == 0 && !opts.Status.Defined() {
+		return nil
+	}
+	g.RunFn(typesErrorsModify(opts))
+	template := xgenny.NewEmbedWalker(fsRules, "files/rules/", opts.AppPath)
+	return Box(template, opts, g)
+}
+
+// typesErrorsModify adds the errors of the type rules to the module errors,
+// unless they were added by another type.
+func typesErrorsModify(opts *Options) genny.RunFn {
+	return func(r *genny.Runner) error {
+		path := filepath.Join(opts.AppPath, "x", opts.ModuleName, "types/errors.go")
+		f, err := r.Disk.Find(path)
+		if err != nil {
+			return err
+		}
+
+		content := f.String()
+		if strings.Contains(content, "ErrImmutableField") {
+			return nil
+		}
+		content += fmt.Sprintf(`
+// x/%s module errors of the rules of the scaffolded types
+var (
+	ErrImmutableField          = sdkerrors.Register(ModuleName, 1200, "immutable field")
+	ErrInvalidStatusTransition = sdkerrors.Register(ModuleName, 1201, "invalid status transition")
+)
+`, opts.ModuleName)
+
+		newFile := genny.NewFileS(path, content)
+		return r.File(newFile)
+	}
+}
@@ -14,6 +14,7 @@ import (
 	"github.com/ignite/cli/v29/ignite/pkg/gomodulepath"
 	"github.com/ignite/cli/v29/ignite/pkg/placeholder"
 	"github.com/ignite/cli/v29/ignite/pkg/protoanalysis/protoutil"
+	"github.com/ignite/cli/v29/ignite/pkg/xast"
 	"github.com/ignite/cli/v29/ignite/pkg/xgenny"
 	"github.com/ignite/cli/v29/ignite/templates/module"
 	"github.com/ignite/cli/v29/ignite/templates/typed"
@@ -80,6 +81,10 @@ func NewGenerator(replacer placeholder.Replacer, opts *typed.Options) (*genny.Ge
 		}
 	}
 
+	if err := typed.BoxValidate(opts, g); err != nil {
+		return nil, err
+	}
+
 	return g, typed.Box(componentTemplate, opts, g)
 }
 
@@ -281,6 +286,25 @@ func genesisTypesModify(replacer placeholder.Replacer, opts *typed.Options) genn
 		)
 		content := replacer.Replace(f.String(), typed.PlaceholderGenesisTypesDefault, replacementTypesDefault)
 
+		// Validate the nested custom types of the singleton
+		if opts.Fields.ValidateNested() != "" {
+			templateTypesValidate := `if err := gs.%[2]v.Validate(); err != nil {
+	return fmt.Errorf("invalid %[3]v: %%w", err)
+}
+%[1]v`
+			replacementTypesValidate := fmt.Sprintf(
+				templateTypesValidate,
+				typed.PlaceholderGenesisTypesValidate,
+				opts.TypeName.UpperCamel,
+				opts.TypeName.LowerCamel,
+			)
+			content = replacer.Replace(content, typed.PlaceholderGenesisTypesValidate, replacementTypesValidate)
+
+			if content, err = xast.AppendImports(content, xast.WithLastImport("fmt")); err != nil {
+				return err
+			}
+		}
+
 		newFile := genny.NewFileS(path, content)
 		return r.File(newFile)
 	}
@@ -1,17 +1,23 @@
 package typed
 
 import (
+	"embed"
+
 	"github.com/gobuffalo/genny/v2"
 	"github.com/gobuffalo/packd"
 	"github.com/gobuffalo/plush/v4"
 
 	"github.com/ignite/cli/v29/ignite/pkg/gomodulepath"
 	"github.com/ignite/cli/v29/ignite/pkg/xgenny"
+	"github.com/ignite/cli/v29/ignite/templates/field"
 	"github.com/ignite/cli/v29/ignite/templates/field/plushhelpers"
 	"github.com/ignite/cli/v29/ignite/templates/module"
 	"github.com/ignite/cli/v29/ignite/templates/testutil"
 )
 
+//go:embed files/validate/* files/validate/**/*
+var fsValidate embed.FS
+
 func Box(box packd.Walker, opts *Options, g *genny.Generator) error {
 	if err := g.Box(box); err != nil {
 		return err
@@ -28,7 +34,27 @@ func Box(box packd.Walker, opts *Options, g *genny.Generator) error {
 	ctx.Set("MsgSigner", opts.MsgSigner)
 	ctx.Set("Fields", opts.Fields)
 	ctx.Set("Indexes", opts.Indexes)
+	ctx.Set("SecondaryIndexes", opts.SecondaryIndexes)
 	ctx.Set("NoMessage", opts.NoMessage)
+	authority := opts.Authority
+	if authority == "" {
+		authority = AuthorityCreator
+	}
+	ctx.Set("Authority", authority)
+	ctx.Set("ImmutableFields", opts.ImmutableFields)
+	ctx.Set("Status", opts.Status)
+	ctx.Set("Events", opts.Events)
+	ctx.Set("isImmutable", func(f field.Field) bool {
+		for _, immutable := range opts.ImmutableFields {
+			if immutable.Name.LowerCamel == f.Name.LowerCamel {
+				return true
+			}
+		}
+		return false
+	})
+	ctx.Set("isStatus", func(f field.Field) bool {
+		return opts.Status.Defined() && opts.Status.Field.Name.LowerCamel == f.Name.LowerCamel
+	})
 	ctx.Set("protoPkgName", module.ProtoPackageName(appModulePath, opts.ModuleName))
 	ctx.Set("strconv", func() bool {
 		strconv := false
@@ -50,3 +76,13 @@ func Box(box packd.Walker, opts *Options, g *genny.Generator) error {
 	// Create the 'testutil' package with the test helpers
 	return testutil.Register(g, opts.AppPath)
 }
+
+// BoxValidate adds to the generator the Validate method of the type, which validates
+// the nested custom types of its fields. Nothing is added if the type has no custom fields.
+func BoxValidate(opts *Options, g *genny.Generator) error {
+	if opts.Fields.ValidateNested() == "" {
+		return nil
+	}
+	template := xgenny.NewEmbedWalker(fsValidate, "files/validate/", opts.AppPath)
+	return Box(template, opts, g)
+}
@@ -0,0 +1,57 @@
+package upgrade
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+)
+
+type (
+	// Options represents the options to scaffold a chain upgrade.
+	Options struct {
+		AppPath      string
+		ModulePath   string
+		UpgradeName  string
+		AddStores    []string
+		DeleteStores []string
+		Migrations   []Migration
+
+		// True if the app already registers chain upgrades
+		HasUpgrades bool
+	}
+
+	// Migration represents the in-place store migration of a module to its next consensus version.
+	Migration struct {
+		ModuleName  string
+		FromVersion uint64
+
+		// True if the module keeper already defines a migrator
+		HasMigrator bool
+	}
+)
+
+// PackageName returns the name of the Go package of the upgrade.
+func (opts Options) PackageName() string {
+	name := strings.Map(func(r rune) rune {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			return unicode.ToLower(r)
+		}
+		return '_'
+	}, opts.UpgradeName)
+
+	// package names can't start with a digit
+	if name == "" || !unicode.IsLetter(rune(name[0])) {
+		name = "v" + name
+	}
+	return name
+}
+
+// ToVersion returns the consensus version of the module after the migration.
+func (m Migration) ToVersion() uint64 {
+	return m.FromVersion + 1
+}
+
+// FuncName returns the name of the migrator function of the migration.
+func (m Migration) FuncName() string {
+	return fmt.Sprintf("Migrate%dto%d", m.FromVersion, m.ToVersion())
+}
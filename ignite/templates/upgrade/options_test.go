@@ -0,0 +1,50 @@
+package upgrade
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestOptionsPackageName(t *testing.T) {
+	cases := []struct {
+		name        string
+		upgradeName string
+		want        string
+	}{
+		{
+			name:        "name",
+			upgradeName: "v2",
+			want:        "v2",
+		},
+		{
+			name:        "semantic version",
+			upgradeName: "v1.2.0",
+			want:        "v1_2_0",
+		},
+		{
+			name:        "uppercase with dash",
+			upgradeName: "Upgrade-V2",
+			want:        "upgrade_v2",
+		},
+		{
+			name:        "number prefix",
+			upgradeName: "2.0.0",
+			want:        "v2_0_0",
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			opts := Options{UpgradeName: tt.upgradeName}
+			require.Equal(t, tt.want, opts.PackageName())
+		})
+	}
+}
+
+func TestMigrationFuncName(t *testing.T) {
+	m := Migration{ModuleName: "blog", FromVersion: 2}
+
+	require.EqualValues(t, 3, m.ToVersion())
+	require.Equal(t, "Migrate2to3", m.FuncName())
+}
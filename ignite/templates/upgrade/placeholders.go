@@ -0,0 +1,3 @@
+package upgrade
+
+const PlaceholderAppUpgrades = "// this line is used by starport scaffolding # app/upgrades"
@@ -0,0 +1,294 @@
+package upgrade
+
+import (
+	"embed"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"path/filepath"
+	"strconv"
+
+	"github.com/gobuffalo/genny/v2"
+	"github.com/gobuffalo/plush/v4"
+
+	"github.com/ignite/cli/v29/ignite/pkg/errors"
+	"github.com/ignite/cli/v29/ignite/pkg/placeholder"
+	"github.com/ignite/cli/v29/ignite/pkg/xast"
+	"github.com/ignite/cli/v29/ignite/pkg/xgenny"
+	"github.com/ignite/cli/v29/ignite/templates/module"
+)
+
+const (
+	// PathAppUpgradesGo is the path of the file that registers the chain upgrades.
+	PathAppUpgradesGo = "app/upgrades.go"
+
+	// PathUpgradesDir is the path of the directory that contains the chain upgrades.
+	PathUpgradesDir = "app/upgrades"
+
+	// migratorTemplate is the content of the module migrator when it is created.
+	migratorTemplate = `package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Migrator is a struct for handling in-place store migrations.
+type Migrator struct {
+	keeper Keeper
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(keeper Keeper) Migrator {
+	return Migrator{keeper: keeper}
+}
+`
+)
+
+var (
+	//go:embed files/base/* files/base/**/*
+	fsBase embed.FS
+
+	//go:embed files/upgrade/* files/upgrade/**/*
+	fsUpgrade embed.FS
+)
+
+// NewGenerator returns the generator to scaffold a chain upgrade.
+func NewGenerator(opts *Options) (*genny.Generator, error) {
+	g := genny.New()
+
+	if !opts.HasUpgrades {
+		if err := g.Box(xgenny.NewEmbedWalker(fsBase, "files/base/", opts.AppPath)); err != nil {
+			return g, err
+		}
+	}
+	if err := g.Box(xgenny.NewEmbedWalker(fsUpgrade, "files/upgrade/", opts.AppPath)); err != nil {
+		return g, err
+	}
+
+	ctx := plush.NewContext()
+	ctx.Set("modulePath", opts.ModulePath)
+	ctx.Set("upgradeName", opts.UpgradeName)
+	ctx.Set("upgradePackage", opts.PackageName())
+	ctx.Set("addStores", opts.AddStores)
+	ctx.Set("deleteStores", opts.DeleteStores)
+
+	g.Transformer(xgenny.Transformer(ctx))
+	g.Transformer(genny.Replace("{{upgradePackage}}", opts.PackageName()))
+
+	return g, nil
+}
+
+// NewAppModify returns the generator with the modifications required to register
+// the upgrade in the app and to migrate the stores of the modules.
+func NewAppModify(replacer placeholder.Replacer, opts *Options) *genny.Generator {
+	g := genny.New()
+	if !opts.HasUpgrades {
+		g.RunFn(appModify(opts))
+	}
+	g.RunFn(appUpgradesModify(replacer, opts))
+	for _, m := range opts.Migrations {
+		g.RunFn(migratorModify(opts, m))
+		g.RunFn(moduleModify(opts, m))
+	}
+	return g
+}
+
+// appModify sets up the upgrade handlers in app.go before the app is loaded.
+func appModify(opts *Options) genny.RunFn {
+	return func(r *genny.Runner) error {
+		path := filepath.Join(opts.AppPath, module.PathAppGo)
+		f, err := r.Disk.Find(path)
+		if err != nil {
+			return err
+		}
+
+		line, err := appLoadLine(f.String())
+		if err != nil {
+			return err
+		}
+
+		content, err := xast.ModifyFunction(
+			f.String(),
+			"New",
+			xast.AppendFuncAtLine("app.setupUpgradeHandlers()", line),
+		)
+		if err != nil {
+			return err
+		}
+
+		return r.File(genny.NewFileS(path, content))
+	}
+}
+
+// appUpgradesModify adds the upgrade to the upgrades of the app.
+func appUpgradesModify(replacer placeholder.Replacer, opts *Options) genny.RunFn {
+	return func(r *genny.Runner) error {
+		path := filepath.Join(opts.AppPath, PathAppUpgradesGo)
+		f, err := r.Disk.Find(path)
+		if err != nil {
+			return err
+		}
+
+		content, err := xast.AppendImports(
+			f.String(),
+			xast.WithLastImport(fmt.Sprintf("%s/%s/%s", opts.ModulePath, PathUpgradesDir, opts.PackageName())),
+		)
+		if err != nil {
+			return err
+		}
+
+		template := `%[2]v.Upgrade,
+%[1]v`
+		replacement := fmt.Sprintf(template, PlaceholderAppUpgrades, opts.PackageName())
+		content = replacer.Replace(content, PlaceholderAppUpgrades, replacement)
+
+		return r.File(genny.NewFileS(path, content))
+	}
+}
+
+// migratorModify adds the migration function to the migrator of the module keeper.
+// The migrator is created when the module doesn't have one.
+func migratorModify(opts *Options, m Migration) genny.RunFn {
+	return func(r *genny.Runner) error {
+		path := filepath.Join(opts.AppPath, "x", m.ModuleName, "keeper/migrations.go")
+
+		content := migratorTemplate
+		if m.HasMigrator {
+			f, err := r.Disk.Find(path)
+			if err != nil {
+				return err
+			}
+
+			content, err = xast.AppendImports(
+				f.String(),
+				xast.WithLastNamedImport("sdk", "github.com/cosmos/cosmos-sdk/types"),
+			)
+			if err != nil {
+				return err
+			}
+		}
+
+		template := `func (m Migrator) %[1]v(ctx sdk.Context) error {
+	return nil
+}`
+		content, err := xast.AppendFunction(content, fmt.Sprintf(template, m.FuncName()))
+		if err != nil {
+			return err
+		}
+
+		return r.File(genny.NewFileS(path, content))
+	}
+}
+
+// moduleModify bumps the consensus version of the module and registers its store migration.
+func moduleModify(opts *Options, m Migration) genny.RunFn {
+	return func(r *genny.Runner) error {
+		path := filepath.Join(opts.AppPath, "x", m.ModuleName, "module/module.go")
+		f, err := r.Disk.Find(path)
+		if err != nil {
+			return err
+		}
+
+		version, err := consensusVersion(f.String())
+		if err != nil {
+			return errors.Errorf("%s module: %w", m.ModuleName, err)
+		}
+		if version != m.FromVersion {
+			return errors.Errorf(
+				"%s module consensus version is %d, it can't be migrated from version %d",
+				m.ModuleName,
+				version,
+				m.FromVersion,
+			)
+		}
+
+		content, err := xast.AppendImports(f.String(), xast.WithLastImport("fmt"))
+		if err != nil {
+			return err
+		}
+
+		content, err = xast.ModifyFunction(
+			content,
+			"ConsensusVersion",
+			xast.NewFuncReturn(strconv.FormatUint(m.ToVersion(), 10)),
+		)
+		if err != nil {
+			return err
+		}
+
+		template := `if err := cfg.RegisterMigration(types.ModuleName, %[2]v, keeper.NewMigrator(am.keeper).%[1]v); err != nil {
+	panic(fmt.Sprintf("failed to register the %%s module migration from version %[2]v to %[3]v: %%v", types.ModuleName, err))
+}`
+		content, err = xast.ModifyFunction(
+			content,
+			"RegisterServices",
+			xast.AppendFuncCode(fmt.Sprintf(template, m.FuncName(), m.FromVersion, m.ToVersion())),
+		)
+		if err != nil {
+			return err
+		}
+
+		return r.File(genny.NewFileS(path, content))
+	}
+}
+
+// appLoadLine returns the line of the app constructor body where the app is loaded.
+func appLoadLine(content string) (uint64, error) {
+	f, err := parser.ParseFile(token.NewFileSet(), "", content, 0)
+	if err != nil {
+		return 0, err
+	}
+
+	for _, decl := range f.Decls {
+		funcDecl, ok := decl.(*ast.FuncDecl)
+		if !ok || funcDecl.Name.Name != "New" || funcDecl.Recv != nil || funcDecl.Body == nil {
+			continue
+		}
+
+		for i, stmt := range funcDecl.Body.List {
+			var loaded bool
+			ast.Inspect(stmt, func(n ast.Node) bool {
+				sel, ok := n.(*ast.SelectorExpr)
+				if ok && sel.Sel.Name == "Load" {
+					loaded = true
+				}
+				return !loaded
+			})
+			if loaded {
+				return uint64(i), nil
+			}
+		}
+	}
+
+	return 0, errors.New("app constructor doesn't load the app")
+}
+
+// consensusVersion returns the consensus version returned by the module.
+func consensusVersion(content string) (uint64, error) {
+	f, err := parser.ParseFile(token.NewFileSet(), "", content, 0)
+	if err != nil {
+		return 0, err
+	}
+
+	for _, decl := range f.Decls {
+		funcDecl, ok := decl.(*ast.FuncDecl)
+		if !ok || funcDecl.Name.Name != "ConsensusVersion" || funcDecl.Body == nil || len(funcDecl.Body.List) != 1 {
+			continue
+		}
+
+		ret, ok := funcDecl.Body.List[0].(*ast.ReturnStmt)
+		if !ok || len(ret.Results) != 1 {
+			continue
+		}
+
+		lit, ok := ret.Results[0].(*ast.BasicLit)
+		if !ok || lit.Kind != token.INT {
+			continue
+		}
+
+		return strconv.ParseUint(lit.Value, 0, 64)
+	}
+
+	return 0, errors.New("consensus version not found")
+}
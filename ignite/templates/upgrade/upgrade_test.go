@@ -0,0 +1,122 @@
+package upgrade
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/gobuffalo/plush/v4"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/cli/v29/ignite/pkg/xgenny"
+	"github.com/ignite/cli/v29/ignite/templates/field/plushhelpers"
+	"github.com/ignite/cli/v29/ignite/templates/module"
+)
+
+const (
+	testModulePath = "github.com/test/mars"
+	testModuleName = "blog"
+)
+
+// scaffoldApp renders the app.go and the module.go templates into a new app directory.
+func scaffoldApp(t *testing.T) string {
+	t.Helper()
+
+	appPath := t.TempDir()
+
+	ctx := plush.NewContext()
+	plushhelpers.ExtendPlushContext(ctx)
+	ctx.Set("ModulePath", testModulePath)
+	ctx.Set("AddressPrefix", "cosmos")
+	ctx.Set("BinaryNamePrefix", "mars")
+	ctx.Set("modulePath", testModulePath)
+	ctx.Set("appName", "mars")
+	ctx.Set("moduleName", testModuleName)
+	ctx.Set("isIBC", false)
+	ctx.Set("dependencies", []interface{}{})
+
+	files := map[string]string{
+		"../app/files/app/app.go.plush":                                       module.PathAppGo,
+		"../module/create/files/base/x/{{moduleName}}/module/module.go.plush": filepath.Join("x", testModuleName, "module/module.go"),
+	}
+	for tmpl, path := range files {
+		b, err := os.ReadFile(tmpl)
+		require.NoError(t, err)
+
+		content, err := plush.Render(string(b), ctx)
+		require.NoError(t, err)
+
+		path = filepath.Join(appPath, path)
+		require.NoError(t, os.MkdirAll(filepath.Dir(path), 0o755))
+		require.NoError(t, os.WriteFile(path, []byte(content), 0o644))
+	}
+
+	return appPath
+}
+
+func runGenerators(t *testing.T, opts *Options) error {
+	t.Helper()
+
+	g, err := NewGenerator(opts)
+	require.NoError(t, err)
+
+	r := xgenny.NewRunner(context.Background(), opts.AppPath)
+	_, err = r.RunAndApply(g, NewAppModify(r.Tracer(), opts))
+	return err
+}
+
+func readFile(t *testing.T, path string) string {
+	t.Helper()
+
+	b, err := os.ReadFile(path)
+	require.NoError(t, err)
+	return string(b)
+}
+
+func TestNewAppModify(t *testing.T) {
+	opts := &Options{
+		AppPath:     scaffoldApp(t),
+		ModulePath:  testModulePath,
+		UpgradeName: "v2",
+		Migrations:  []Migration{{ModuleName: testModuleName, FromVersion: 1}},
+	}
+	require.NoError(t, runGenerators(t, opts))
+
+	// The upgrade handlers must be set up right before the app is loaded
+	appGo := readFile(t, filepath.Join(opts.AppPath, module.PathAppGo))
+	require.Equal(t, 1, strings.Count(appGo, "app.setupUpgradeHandlers()"))
+	setupIndex := strings.Index(appGo, "app.setupUpgradeHandlers()")
+	loadIndex := strings.Index(appGo, "app.Load(loadLatest)")
+	require.NotEqual(t, -1, loadIndex)
+	require.Less(t, setupIndex, loadIndex)
+	require.Greater(t, setupIndex, strings.Index(appGo, "app.sm.RegisterStoreDecoders()"))
+
+	upgradesGo := readFile(t, filepath.Join(opts.AppPath, PathAppUpgradesGo))
+	require.Contains(t, upgradesGo, `"github.com/test/mars/app/upgrades/v2"`)
+	require.Contains(t, upgradesGo, "v2.Upgrade,")
+	require.FileExists(t, filepath.Join(opts.AppPath, PathUpgradesDir, "v2/upgrade.go"))
+
+	// The module migration must be registered with the bumped consensus version
+	moduleGo := readFile(t, filepath.Join(opts.AppPath, "x", testModuleName, "module/module.go"))
+	version, err := consensusVersion(moduleGo)
+	require.NoError(t, err)
+	require.EqualValues(t, 2, version)
+	require.Contains(t, moduleGo, "cfg.RegisterMigration(types.ModuleName, 1, keeper.NewMigrator(am.keeper).Migrate1to2)")
+
+	migrationsGo := readFile(t, filepath.Join(opts.AppPath, "x", testModuleName, "keeper/migrations.go"))
+	require.Contains(t, migrationsGo, "func NewMigrator(keeper Keeper) Migrator")
+	require.Contains(t, migrationsGo, "func (m Migrator) Migrate1to2(ctx sdk.Context) error")
+}
+
+func TestNewAppModifyInvalidConsensusVersion(t *testing.T) {
+	opts := &Options{
+		AppPath:     scaffoldApp(t),
+		ModulePath:  testModulePath,
+		UpgradeName: "v2",
+		Migrations:  []Migration{{ModuleName: testModuleName, FromVersion: 2}},
+	}
+	err := runGenerators(t, opts)
+	require.ErrorContains(t, err, "blog module consensus version is 1, it can't be migrated from version 2")
+}
@@ -86,6 +86,34 @@ func TestGenerateAnAppWithListAndVerify(t *testing.T) {
 		)),
 	))
 
+	env.Must(env.Exec("create lists with enum fields of the same name",
+		step.NewSteps(
+			step.New(
+				step.Exec(envtest.IgniteApp, "s", "list", "--yes", "post", "status:enum:draft|published"),
+				step.Workdir(app.SourcePath()),
+			),
+			step.New(
+				step.Exec(envtest.IgniteApp, "s", "list", "--yes", "comment", "status:enum:pending|approved"),
+				step.Workdir(app.SourcePath()),
+			),
+		),
+	))
+
+	env.Must(env.Exec("create a message with an enum field of the same name",
+		step.NewSteps(step.New(
+			step.Exec(envtest.IgniteApp,
+				"s",
+				"message",
+				"--yes",
+				"review-post",
+				"status:enum:accepted|rejected",
+				"--response",
+				"status:enum:done|failed",
+			),
+			step.Workdir(app.SourcePath()),
+		)),
+	))
+
 	env.Must(env.Exec("create a list with custom field type",
 		step.NewSteps(step.New(
 			step.Exec(envtest.IgniteApp,
@@ -101,6 +129,47 @@ func TestGenerateAnAppWithListAndVerify(t *testing.T) {
 		)),
 	))
 
+	env.Must(env.Exec("create a list with address, decimal, integer, time and bytes fields",
+		step.NewSteps(step.New(
+			step.Exec(
+				envtest.IgniteApp,
+				"s",
+				"list",
+				"--yes",
+				"payment",
+				"owner:address",
+				"price:dec",
+				"amount:math.int",
+				"paidAt:timestamp",
+				"period:duration",
+				"checksum:bytes",
+				"--module",
+				"example",
+			),
+			step.Workdir(app.SourcePath()),
+		)),
+	))
+
+	env.Must(env.Exec("create a list with immutable slice fields",
+		step.NewSteps(step.New(
+			step.Exec(envtest.IgniteApp,
+				"s",
+				"list",
+				"--yes",
+				"vault",
+				"balance:array.coin",
+				"tags:array.string",
+				"checksum:bytes",
+				"document:Document",
+				"--module",
+				"example",
+				"--immutable-fields",
+				"balance,tags,checksum,document",
+			),
+			step.Workdir(app.SourcePath()),
+		)),
+	))
+
 	env.Must(env.Exec("should prevent creating a list with duplicated fields",
 		step.NewSteps(step.New(
 			step.Exec(envtest.IgniteApp, "s", "list", "--yes", "company", "name", "name"),
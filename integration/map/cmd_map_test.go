@@ -109,6 +109,46 @@ func TestCreateMap(t *testing.T) {
 		)),
 	))
 
+	env.Must(env.Exec("create a map with address, decimal, integer, time and bytes fields",
+		step.NewSteps(step.New(
+			step.Exec(
+				envtest.IgniteApp,
+				"s",
+				"map",
+				"--yes",
+				"invoice",
+				"owner:address",
+				"price:dec",
+				"amount:math.int",
+				"paidAt:timestamp",
+				"period:duration",
+				"checksum:bytes",
+				"--module",
+				"example",
+			),
+			step.Workdir(app.SourcePath()),
+		)),
+	))
+
+	env.Must(env.Exec("create a map with immutable Coin and []Coin",
+		step.NewSteps(step.New(
+			step.Exec(envtest.IgniteApp,
+				"s",
+				"map",
+				"--yes",
+				"reserve",
+				"deposit:coin",
+				"pool:array.coin",
+				"ids:array.uint",
+				"--module",
+				"example",
+				"--immutable-fields",
+				"deposit,pool,ids",
+			),
+			step.Workdir(app.SourcePath()),
+		)),
+	))
+
 	env.Must(env.Exec("create a map with index",
 		step.NewSteps(step.New(
 			step.Exec(
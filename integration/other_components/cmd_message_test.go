@@ -33,6 +33,27 @@ func TestGenerateAnAppWithMessage(t *testing.T) {
 		)),
 	))
 
+	env.Must(env.Exec("create a message with address, decimal, integer, time and bytes fields",
+		step.NewSteps(step.New(
+			step.Exec(
+				envtest.IgniteApp,
+				"s",
+				"message",
+				"--yes",
+				"pay-invoice",
+				"owner:address",
+				"price:dec",
+				"amount:math.int",
+				"paidAt:timestamp",
+				"period:duration",
+				"checksum:bytes",
+				"-r",
+				"receipt:bytes,settledAt:timestamp",
+			),
+			step.Workdir(app.SourcePath()),
+		)),
+	))
+
 	env.Must(env.Exec("create a message with custom path",
 		step.NewSteps(step.New(
 			step.Exec(
@@ -57,6 +57,27 @@ func TestCreateSingleton(t *testing.T) {
 		)),
 	))
 
+	env.Must(env.Exec("create a singleton type with address, decimal, integer, time and bytes fields",
+		step.NewSteps(step.New(
+			step.Exec(
+				envtest.IgniteApp,
+				"s",
+				"single",
+				"--yes",
+				"treasury",
+				"owner:address",
+				"price:dec",
+				"amount:math.int",
+				"paidAt:timestamp",
+				"period:duration",
+				"checksum:bytes",
+				"--module",
+				"example",
+			),
+			step.Workdir(app.SourcePath()),
+		)),
+	))
+
 	env.Must(env.Exec("should prevent creating an singleton type with a typename that already exist",
 		step.NewSteps(step.New(
 			step.Exec(envtest.IgniteApp, "s", "single", "--yes", "user", "email", "--module", "example"),